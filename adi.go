@@ -0,0 +1,295 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// adiMaxFieldLength bounds the length specifier accepted by ADIReader, so a corrupt file cannot
+// make it allocate an arbitrarily large buffer.
+const adiMaxFieldLength = 16 << 20
+
+// adiDefaultPreamble is written by ADIWriter when a header has no preamble, since an ADI header
+// must not start with '<'.
+const adiDefaultPreamble = "ADIF export"
+
+// ADIReader reads ADIF records from the ADI (tagged text) format one at a time, so logs of any size
+// can be processed without holding them in memory.
+//
+// Field lengths are byte counts, as ADIF 3.1 requires, so values containing multi-byte UTF-8 are
+// read exactly. Field names and data type indicators are upper-cased; text between fields is
+// ignored. A file whose header has no preamble (starting directly with a field) is still
+// recognised by its <EOH> tag.
+type ADIReader struct {
+	r       *bufio.Reader
+	offset  int64
+	started bool
+	header  ADIFHeader
+	pending *ADIFRecord // first record, read while looking for a header
+	err     error
+}
+
+// adiTag is one parsed tag: a field specifier or an <EOH>/<EOR> marker.
+type adiTag struct {
+	name   string
+	length int
+	typ    string
+	marker bool
+}
+
+// NewADIReader returns a reader that parses ADI data from r.
+func NewADIReader(r io.Reader) *ADIReader {
+	return &ADIReader{r: bufio.NewReader(r)}
+}
+
+// Header returns the file header, reading it if Next has not yet been called. A file without a
+// header gives an empty ADIFHeader.
+func (ar *ADIReader) Header() (ADIFHeader, error) {
+	if err := ar.start(); err != nil {
+		return ADIFHeader{}, err
+	}
+	return ar.header, nil
+}
+
+// Next returns the next record. It returns io.EOF when there are no more records.
+func (ar *ADIReader) Next() (ADIFRecord, error) {
+	if err := ar.start(); err != nil {
+		return ADIFRecord{}, err
+	}
+	if ar.pending != nil {
+		rec := *ar.pending
+		ar.pending = nil
+		return rec, nil
+	}
+	for {
+		rec, marker, err := ar.readFields()
+		if err != nil {
+			return ADIFRecord{}, err
+		}
+		if marker == "EOH" {
+			return ADIFRecord{}, ar.syntaxError("unexpected <EOH>")
+		}
+		if len(rec.Fields) > 0 {
+			return rec, nil
+		}
+	}
+}
+
+// start reads the header, if any, on first use.
+func (ar *ADIReader) start() error {
+	if ar.started {
+		return ar.err
+	}
+	ar.started = true
+
+	b, err := ar.r.Peek(1)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		ar.err = err
+		return err
+	}
+	if b[0] != '<' {
+		preamble, err := ar.r.ReadString('<')
+		if err != nil && !errors.Is(err, io.EOF) {
+			ar.err = err
+			return err
+		}
+		ar.offset += int64(len(preamble))
+		if err == nil {
+			_ = ar.r.UnreadByte()
+			ar.offset--
+			preamble = preamble[:len(preamble)-1]
+		}
+		ar.header.Preamble = strings.TrimSpace(preamble)
+	}
+
+	rec, marker, err := ar.readFields()
+	switch {
+	case errors.Is(err, io.EOF):
+		if ar.header.Preamble != emptyString {
+			ar.err = ar.syntaxError("header without <EOH>")
+		}
+		return ar.err
+	case err != nil:
+		ar.err = err
+		return err
+	case marker == "EOH":
+		for _, f := range rec.Fields {
+			if err := ar.header.addField(f); err != nil {
+				ar.err = err
+				return err
+			}
+		}
+	case ar.header.Preamble != emptyString:
+		ar.err = ar.syntaxError("header without <EOH>")
+		return ar.err
+	default:
+		ar.pending = &rec
+	}
+	return nil
+}
+
+// readFields reads fields up to and including the next <EOR> or <EOH>, returning the marker seen.
+// It returns io.EOF if the input ends cleanly before any field.
+func (ar *ADIReader) readFields() (ADIFRecord, string, error) {
+	var rec ADIFRecord
+	for {
+		tag, err := ar.readTag()
+		if errors.Is(err, io.EOF) {
+			if len(rec.Fields) > 0 {
+				return ADIFRecord{}, emptyString, ar.syntaxError("record without <EOR>")
+			}
+			return ADIFRecord{}, emptyString, io.EOF
+		}
+		if err != nil {
+			return ADIFRecord{}, emptyString, err
+		}
+		if tag.marker {
+			return rec, tag.name, nil
+		}
+		data := make([]byte, tag.length)
+		n, err := io.ReadFull(ar.r, data)
+		ar.offset += int64(n)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return ADIFRecord{}, emptyString, ar.syntaxError("data for " + tag.name + " is truncated")
+			}
+			return ADIFRecord{}, emptyString, err
+		}
+		rec.Fields = append(rec.Fields, ADIFField{Name: tag.name, Value: string(data), Type: tag.typ})
+	}
+}
+
+// readTag skips to the next '<' and parses the tag that follows.
+func (ar *ADIReader) readTag() (adiTag, error) {
+	skipped, err := ar.r.ReadString('<')
+	ar.offset += int64(len(skipped))
+	if err != nil {
+		return adiTag{}, err
+	}
+	spec, err := ar.r.ReadString('>')
+	ar.offset += int64(len(spec))
+	if errors.Is(err, io.EOF) {
+		return adiTag{}, ar.syntaxError("unterminated tag")
+	}
+	if err != nil {
+		return adiTag{}, err
+	}
+	spec = spec[:len(spec)-1]
+
+	parts := strings.Split(spec, ":")
+	tag := adiTag{name: strings.ToUpper(strings.TrimSpace(parts[0]))}
+	if len(parts) == 1 {
+		if tag.name != "EOR" && tag.name != "EOH" {
+			return adiTag{}, ar.syntaxError("tag <" + spec + "> has no length")
+		}
+		tag.marker = true
+		return tag, nil
+	}
+	if len(parts) > 3 || !validADIFFieldName(tag.name) {
+		return adiTag{}, ar.syntaxError("invalid tag <" + spec + ">")
+	}
+	tag.length, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || tag.length < 0 || tag.length > adiMaxFieldLength {
+		return adiTag{}, ar.syntaxError("invalid length in <" + spec + ">")
+	}
+	if len(parts) == 3 {
+		tag.typ = strings.ToUpper(strings.TrimSpace(parts[2]))
+	}
+	return tag, nil
+}
+
+func (ar *ADIReader) syntaxError(msg string) error {
+	return fmt.Errorf("%w at byte %d: %s", ErrADIFSyntax, ar.offset, msg)
+}
+
+// ADIWriter writes ADIF records in the ADI format. Length specifiers are byte counts of the UTF-8
+// encoded value. Output is buffered; call Flush when done.
+type ADIWriter struct {
+	w       *bufio.Writer
+	records bool
+}
+
+// NewADIWriter returns a writer that writes ADI data to w.
+func NewADIWriter(w io.Writer) *ADIWriter {
+	return &ADIWriter{w: bufio.NewWriter(w)}
+}
+
+// WriteHeader writes the header, which must come before any record. An empty preamble is replaced
+// by a short default, since an ADI header must not start with '<'.
+func (aw *ADIWriter) WriteHeader(h ADIFHeader) error {
+	if aw.records {
+		return fmt.Errorf("%w: header written after records", ErrADIFSyntax)
+	}
+	preamble := strings.TrimSpace(h.Preamble)
+	if preamble == emptyString {
+		preamble = adiDefaultPreamble
+	}
+	if strings.ContainsRune(preamble, '<') {
+		return fmt.Errorf("%w: header preamble contains '<'", ErrADIFSyntax)
+	}
+	if err := checkADIFFieldNames(h.Fields); err != nil {
+		return err
+	}
+	aw.w.WriteString(preamble)
+	aw.w.WriteByte('\n')
+	for _, f := range h.Fields {
+		if err := aw.writeField(f); err != nil {
+			return err
+		}
+		aw.w.WriteByte('\n')
+	}
+	for _, u := range h.UserDefs {
+		f := ADIFField{Name: "USERDEF" + strconv.Itoa(u.ID), Value: u.value(), Type: u.Type}
+		if err := aw.writeField(f); err != nil {
+			return err
+		}
+		aw.w.WriteByte('\n')
+	}
+	_, err := aw.w.WriteString("<EOH>\n")
+	return err
+}
+
+// Write writes one record followed by <EOR>. A record with an invalid field name is rejected
+// before any of it is written.
+func (aw *ADIWriter) Write(rec ADIFRecord) error {
+	if err := checkADIFFieldNames(rec.Fields); err != nil {
+		return err
+	}
+	aw.records = true
+	for i, f := range rec.Fields {
+		if i > 0 {
+			aw.w.WriteByte(' ')
+		}
+		if err := aw.writeField(f); err != nil {
+			return err
+		}
+	}
+	_, err := aw.w.WriteString("<EOR>\n")
+	return err
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (aw *ADIWriter) Flush() error {
+	return aw.w.Flush()
+}
+
+func (aw *ADIWriter) writeField(f ADIFField) error {
+	aw.w.WriteByte('<')
+	aw.w.WriteString(strings.ToUpper(f.Name))
+	aw.w.WriteByte(':')
+	aw.w.WriteString(strconv.Itoa(len(f.Value)))
+	if f.Type != emptyString {
+		aw.w.WriteByte(':')
+		aw.w.WriteString(strings.ToUpper(f.Type))
+	}
+	aw.w.WriteByte('>')
+	_, err := aw.w.WriteString(f.Value)
+	return err
+}
@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testADI = `Exported from a logger
+<ADIF_VER:5>3.1.4
+<PROGRAMID:4>Test
+<USERDEF1:8:N>QRP_ARCI
+<USERDEF2:19:E>SweaterSize,{S,M,L}
+<USERDEF3:15:N>ShoeSize,{5:20}
+<EOH>
+<call:4>W1AW <QSO_DATE:8:d>20240101 <TIME_ON:4>1200 <NAME_INTL:4>Zoë <sweatersize:1>M <EOR>
+comment text between records is ignored
+<CALL:5>G4ABC<BAND:3>20m<eor>
+`
+
+// testADIFRecord builds a record from alternating field names and values.
+func testADIFRecord(fields ...string) ADIFRecord {
+	var rec ADIFRecord
+	for i := 0; i+1 < len(fields); i += 2 {
+		rec.Set(fields[i], fields[i+1])
+	}
+	return rec
+}
+
+func readAllADI(t *testing.T, s string) (ADIFHeader, []ADIFRecord) {
+	t.Helper()
+	r := NewADIReader(strings.NewReader(s))
+	h, err := r.Header()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var recs []ADIFRecord
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+	return h, recs
+}
+
+func TestADIReader(t *testing.T) {
+	h, recs := readAllADI(t, testADI)
+
+	if h.Preamble != "Exported from a logger" {
+		t.Fatalf("unexpected preamble %q", h.Preamble)
+	}
+	if v, _ := h.Get("adif_ver"); v != "3.1.4" {
+		t.Fatalf("unexpected ADIF_VER %q", v)
+	}
+	if len(h.Fields) != 2 || len(h.UserDefs) != 3 {
+		t.Fatalf("unexpected header %+v", h)
+	}
+	want := []ADIFUserDef{
+		{ID: 1, Name: "QRP_ARCI", Type: "N"},
+		{ID: 2, Name: "SWEATERSIZE", Type: "E", Enum: []string{"S", "M", "L"}},
+		{ID: 3, Name: "SHOESIZE", Type: "N", HasRange: true, Min: 5, Max: 20},
+	}
+	if !reflect.DeepEqual(h.UserDefs, want) {
+		t.Fatalf("unexpected USERDEFs %+v", h.UserDefs)
+	}
+	if u, ok := h.UserDef("sweatersize"); !ok || u.ID != 2 {
+		t.Fatalf("unexpected %+v, %v", u, ok)
+	}
+
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(recs))
+	}
+	first := recs[0]
+	if first.Value("CALL") != "W1AW" || first.Value("name_intl") != "Zoë" || first.Value("SWEATERSIZE") != "M" {
+		t.Fatalf("unexpected record %+v", first)
+	}
+	if first.Fields[1] != (ADIFField{Name: "QSO_DATE", Value: "20240101", Type: "D"}) {
+		t.Fatalf("unexpected field %+v", first.Fields[1])
+	}
+	if recs[1].Value("BAND") != "20m" {
+		t.Fatalf("unexpected record %+v", recs[1])
+	}
+}
+
+func TestADIReader_NoHeader(t *testing.T) {
+	h, recs := readAllADI(t, "<CALL:4>W1AW<EOR><CALL:5>G4ABC<EOR>")
+	if h.Preamble != emptyString || len(h.Fields) != 0 || len(recs) != 2 || recs[0].Value("CALL") != "W1AW" {
+		t.Fatalf("unexpected %+v %+v", h, recs)
+	}
+
+	h, recs = readAllADI(t, "<ADIF_VER:5>3.1.4<EOH>\n<CALL:4>W1AW<EOR>\n")
+	if v, _ := h.Get("ADIF_VER"); v != "3.1.4" || len(recs) != 1 {
+		t.Fatalf("unexpected %+v %+v", h, recs)
+	}
+
+	// Next without Header still skips the header.
+	r := NewADIReader(strings.NewReader(testADI))
+	if rec, err := r.Next(); err != nil || rec.Value("CALL") != "W1AW" {
+		t.Fatalf("unexpected %+v, %v", rec, err)
+	}
+
+	if _, recs := readAllADI(t, ""); len(recs) != 0 {
+		t.Fatal("expected no records")
+	}
+}
+
+func TestADIReader_Errors(t *testing.T) {
+	bad := []string{
+		"<CALL:4>W1AW",
+		"<CALL:10>W1AW<EOR>",
+		"<CALL>W1AW<EOR>",
+		"<CALL:x>W1AW<EOR>",
+		"<CALL:4>W1AW<EOR><EOH>",
+		"preamble <ADIF_VER:5>3.1.4 <CALL:4>W1AW<EOR>",
+		"<CALL:4:N:X>W1AW<EOR>",
+		"<CALL:4>W1AW<EOR><CALL:4",
+		"header <USERDEF1:3>A,B<EOH>",
+		"header <USERDEFX:1>A<EOH>",
+	}
+	for _, in := range bad {
+		r := NewADIReader(strings.NewReader(in))
+		var err error
+		for err == nil {
+			_, err = r.Next()
+		}
+		if !errors.Is(err, ErrADIFSyntax) && !errors.Is(err, ErrADIFUserDef) {
+			t.Fatalf("%q: expected a syntax error, got %v", in, err)
+		}
+	}
+}
+
+func TestADIWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewADIWriter(&buf)
+	h := ADIFHeader{
+		Fields:   []ADIFField{{Name: "ADIF_VER", Value: "3.1.4"}},
+		UserDefs: []ADIFUserDef{{ID: 1, Name: "SHOESIZE", Type: "N", HasRange: true, Min: 5, Max: 20.5}},
+	}
+	if err := w.WriteHeader(h); err != nil {
+		t.Fatal(err)
+	}
+	rec := ADIFRecord{Fields: []ADIFField{
+		{Name: "call", Value: "W1AW"},
+		{Name: "NAME_INTL", Value: "Zoë 日本"},
+		{Name: "QSO_DATE", Value: "20240101", Type: "d"},
+	}}
+	if err := w.Write(rec); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := "ADIF export\n<ADIF_VER:5>3.1.4\n<USERDEF1:17:N>SHOESIZE,{5:20.5}\n<EOH>\n" +
+		"<CALL:4>W1AW <NAME_INTL:11>Zoë 日本 <QSO_DATE:8:D>20240101<EOR>\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected output\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	gotHeader, recs := readAllADI(t, buf.String())
+	if !reflect.DeepEqual(gotHeader.UserDefs, h.UserDefs) || recs[0].Value("NAME_INTL") != "Zoë 日本" {
+		t.Fatalf("round trip failed: %+v %+v", gotHeader, recs)
+	}
+
+	if err := w.WriteHeader(h); !errors.Is(err, ErrADIFSyntax) {
+		t.Fatalf("expected ErrADIFSyntax for a late header, got %v", err)
+	}
+	buf.Reset()
+	w = NewADIWriter(&buf)
+	bad := ADIFRecord{Fields: []ADIFField{{Name: "CALL", Value: "W1AW"}, {Name: "MY CALL", Value: "X"}}}
+	if err := w.Write(bad); !errors.Is(err, ErrADIFFieldName) {
+		t.Fatalf("expected ErrADIFFieldName, got %v", err)
+	}
+	if err := w.Write(ADIFRecord{Fields: []ADIFField{{Name: "CALL", Value: "K1ABC"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "<CALL:5>K1ABC<EOR>\n" {
+		t.Fatalf("rejected record was partly written: %q", buf.String())
+	}
+	if err := NewADIWriter(io.Discard).WriteHeader(ADIFHeader{Preamble: "a <b>"}); !errors.Is(err, ErrADIFSyntax) {
+		t.Fatalf("expected ErrADIFSyntax, got %v", err)
+	}
+}
+
+func TestADIFRecord(t *testing.T) {
+	var rec ADIFRecord
+	rec.Set("call", "W1AW")
+	rec.Set("BAND", "20M")
+	rec.Set("Call", "K1ABC")
+	if len(rec.Fields) != 2 || rec.Fields[0] != (ADIFField{Name: "CALL", Value: "K1ABC"}) {
+		t.Fatalf("unexpected %+v", rec)
+	}
+	if _, ok := rec.Get("MODE"); ok {
+		t.Fatal("MODE should be absent")
+	}
+	rec.Delete("call")
+	if rec.Value("CALL") != emptyString || len(rec.Fields) != 1 {
+		t.Fatalf("unexpected %+v", rec)
+	}
+}
@@ -0,0 +1,200 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrADIFSyntax    = errors.New("invalid ADIF syntax")
+	ErrADIFFieldName = errors.New("invalid ADIF field name")
+	ErrADIFUserDef   = errors.New("invalid ADIF USERDEF field")
+)
+
+// ADIFField is one field of an ADIF header or record. Name is upper-case. Type is the optional
+// data type indicator (e.g. "D" for Date, "N" for Number) and is empty when none was given.
+type ADIFField struct {
+	Name  string
+	Value string
+	Type  string
+}
+
+// ADIFRecord is one QSO record: its fields in file order. Field names are case-insensitive.
+type ADIFRecord struct {
+	Fields []ADIFField
+}
+
+// Get returns the value of the first field called name.
+func (r ADIFRecord) Get(name string) (string, bool) {
+	if i := r.index(name); i >= 0 {
+		return r.Fields[i].Value, true
+	}
+	return emptyString, false
+}
+
+// Value returns the value of the field called name, or "" if it is absent.
+func (r ADIFRecord) Value(name string) string {
+	v, _ := r.Get(name)
+	return v
+}
+
+// Set replaces the value of the field called name, keeping its position, or appends the field if
+// it is absent. Any data type indicator on an existing field is kept.
+func (r *ADIFRecord) Set(name, value string) {
+	if i := r.index(name); i >= 0 {
+		r.Fields[i].Value = value
+		return
+	}
+	r.Fields = append(r.Fields, ADIFField{Name: strings.ToUpper(name), Value: value})
+}
+
+// Delete removes every field called name.
+func (r *ADIFRecord) Delete(name string) {
+	kept := r.Fields[:0]
+	for _, f := range r.Fields {
+		if !strings.EqualFold(f.Name, name) {
+			kept = append(kept, f)
+		}
+	}
+	r.Fields = kept
+}
+
+// TimeOn returns the start of the QSO from QSO_DATE and TIME_ON.
+func (r ADIFRecord) TimeOn() (time.Time, error) {
+	return ParseADIFTimestamp(r.Value("QSO_DATE"), r.Value("TIME_ON"))
+}
+
+func (r ADIFRecord) index(name string) int {
+	for i, f := range r.Fields {
+		if strings.EqualFold(f.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ADIFUserDef is a header USERDEFn field declaring a user-defined field. Enum lists the allowed
+// values of an enumeration ("{S,M,L}") and HasRange reports a numeric range ("{5:20}").
+type ADIFUserDef struct {
+	ID       int
+	Name     string
+	Type     string
+	Enum     []string
+	HasRange bool
+	Min      float64
+	Max      float64
+}
+
+// ADIFHeader is the optional header of an ADIF file. Preamble is the free text before the first
+// header field. Fields holds the header fields other than USERDEFn, which are parsed into UserDefs.
+type ADIFHeader struct {
+	Preamble string
+	Fields   []ADIFField
+	UserDefs []ADIFUserDef
+}
+
+// Get returns the value of the header field called name, such as ADIF_VER or PROGRAMID.
+func (h ADIFHeader) Get(name string) (string, bool) {
+	return ADIFRecord{Fields: h.Fields}.Get(name)
+}
+
+// UserDef returns the declaration of the user-defined field called name.
+func (h ADIFHeader) UserDef(name string) (ADIFUserDef, bool) {
+	for _, u := range h.UserDefs {
+		if strings.EqualFold(u.Name, name) {
+			return u, true
+		}
+	}
+	return ADIFUserDef{}, false
+}
+
+// addField adds a header field, parsing USERDEFn fields into UserDefs.
+func (h *ADIFHeader) addField(f ADIFField) error {
+	if !strings.HasPrefix(f.Name, "USERDEF") {
+		h.Fields = append(h.Fields, f)
+		return nil
+	}
+	id, err := strconv.Atoi(f.Name[len("USERDEF"):])
+	if err != nil || id < 1 {
+		return fmt.Errorf("%w: %s", ErrADIFUserDef, f.Name)
+	}
+	u, err := parseADIFUserDef(id, f.Type, f.Value)
+	if err != nil {
+		return err
+	}
+	h.UserDefs = append(h.UserDefs, u)
+	return nil
+}
+
+// parseADIFUserDef parses the value of a USERDEFn field: a field name optionally followed by a
+// comma and either an enumeration "{A,B,C}" or a range "{min:max}".
+func parseADIFUserDef(id int, typ, value string) (ADIFUserDef, error) {
+	u := ADIFUserDef{ID: id, Type: strings.ToUpper(typ)}
+	name, spec, hasSpec := strings.Cut(value, ",")
+	u.Name = strings.ToUpper(strings.TrimSpace(name))
+	if u.Name == emptyString || strings.ContainsAny(u.Name, ",:<>{}") {
+		return ADIFUserDef{}, fmt.Errorf("%w: USERDEF%d name %q", ErrADIFUserDef, id, name)
+	}
+	if !hasSpec {
+		return u, nil
+	}
+	spec = strings.TrimSpace(spec)
+	if !strings.HasPrefix(spec, "{") || !strings.HasSuffix(spec, "}") {
+		return ADIFUserDef{}, fmt.Errorf("%w: USERDEF%d %q", ErrADIFUserDef, id, value)
+	}
+	spec = spec[1 : len(spec)-1]
+	if lo, hi, isRange := strings.Cut(spec, ":"); isRange {
+		lower, err1 := strconv.ParseFloat(strings.TrimSpace(lo), 64)
+		upper, err2 := strconv.ParseFloat(strings.TrimSpace(hi), 64)
+		if err1 != nil || err2 != nil || lower > upper {
+			return ADIFUserDef{}, fmt.Errorf("%w: USERDEF%d range %q", ErrADIFUserDef, id, spec)
+		}
+		u.HasRange, u.Min, u.Max = true, lower, upper
+		return u, nil
+	}
+	for _, v := range strings.Split(spec, ",") {
+		u.Enum = append(u.Enum, strings.TrimSpace(v))
+	}
+	return u, nil
+}
+
+// value returns the USERDEFn field value describing u.
+func (u ADIFUserDef) value() string {
+	switch {
+	case u.HasRange:
+		return fmt.Sprintf("%s,{%s:%s}", u.Name, strconv.FormatFloat(u.Min, 'f', -1, 64), strconv.FormatFloat(u.Max, 'f', -1, 64))
+	case len(u.Enum) > 0:
+		return u.Name + ",{" + strings.Join(u.Enum, ",") + "}"
+	default:
+		return u.Name
+	}
+}
+
+// validADIFFieldName reports whether name may be written as an ADIF field name: non-empty, ASCII
+// printable and free of the characters ADIF reserves (, : < > { } and space).
+func validADIFFieldName(name string) bool {
+	if name == emptyString {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c <= ' ' || c > '~' || strings.IndexByte(",:<>{}", c) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// checkADIFFieldNames returns an ErrADIFFieldName error for the first field whose name cannot be
+// written, so that writers can reject a record before writing any of it.
+func checkADIFFieldNames(fields []ADIFField) error {
+	for _, f := range fields {
+		if !validADIFFieldName(strings.ToUpper(f.Name)) {
+			return fmt.Errorf("%w: %q", ErrADIFFieldName, f.Name)
+		}
+	}
+	return nil
+}
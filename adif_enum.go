@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrADIFEnumUnknown     = errors.New("value is not in the ADIF enumeration")
+	ErrADIFEnumDeprecated  = errors.New("value is deprecated (import-only) in the ADIF enumeration")
+	ErrADIFSubmodeMismatch = errors.New("submode does not belong to mode")
+)
+
+// ADIFEnumeration names one of the ADIF 3.1 enumerations handled by this package.
+type ADIFEnumeration string
+
+const (
+	EnumMode            ADIFEnumeration = "Mode"
+	EnumSubmode         ADIFEnumeration = "Submode"
+	EnumPropagationMode ADIFEnumeration = "Propagation_Mode"
+	EnumQSLRcvd         ADIFEnumeration = "QSL_Rcvd"
+	EnumQSLSent         ADIFEnumeration = "QSL_Sent"
+	EnumQSLVia          ADIFEnumeration = "QSL_Via"
+	EnumAntPath         ADIFEnumeration = "Ant_Path"
+	EnumContestID       ADIFEnumeration = "Contest_ID"
+	EnumContinent       ADIFEnumeration = "Continent"
+	EnumARRLSection     ADIFEnumeration = "ARRL_Section"
+	EnumRegion          ADIFEnumeration = "Region"
+	EnumAwardSponsor    ADIFEnumeration = "Award_Sponsor"
+	EnumBand            ADIFEnumeration = "Band"
+	EnumQSOUploadStatus ADIFEnumeration = "QSO_Upload_Status"
+	EnumQSOComplete     ADIFEnumeration = "QSO_Complete"
+	EnumCredit          ADIFEnumeration = "Credit"
+	EnumQSLMedium       ADIFEnumeration = "QSL_Medium"
+)
+
+// adifEnum holds the values of one enumeration, keyed by upper-case value.
+type adifEnum struct {
+	values     []string          // current values in specification order
+	canonical  map[string]string // upper-case value -> canonical spelling
+	deprecated map[string]string // upper-case import-only value -> replacement ("" if none)
+}
+
+// ValidateADIFEnum reports whether value is a current member of the enumeration. Matching is
+// case-insensitive, as in the ADIF specification. Import-only values return ErrADIFEnumDeprecated
+// and anything else ErrADIFEnumUnknown.
+func ValidateADIFEnum(enum ADIFEnumeration, value string) error {
+	e, ok := adifEnums[enum]
+	if !ok {
+		return ErrADIFEnumUnknown
+	}
+	key := strings.ToUpper(strings.TrimSpace(value))
+	if _, ok := e.canonical[key]; ok {
+		return nil
+	}
+	if _, ok := e.deprecated[key]; ok {
+		return ErrADIFEnumDeprecated
+	}
+	return ErrADIFEnumUnknown
+}
+
+// NormalizeADIFEnum returns the canonical spelling of value (e.g. "cq-ww-cw" becomes "CQ-WW-CW"),
+// replacing an import-only value with its current equivalent where there is one (a QSL_Rcvd of "V"
+// becomes "Y"). Deprecated values with no replacement return ErrADIFEnumDeprecated. Use
+// NormalizeModeSubmode for modes, where a deprecated mode becomes a mode and submode pair.
+func NormalizeADIFEnum(enum ADIFEnumeration, value string) (string, error) {
+	e, ok := adifEnums[enum]
+	if !ok {
+		return emptyString, ErrADIFEnumUnknown
+	}
+	key := strings.ToUpper(strings.TrimSpace(value))
+	if c, ok := e.canonical[key]; ok {
+		return c, nil
+	}
+	if r, ok := e.deprecated[key]; ok {
+		if r == emptyString {
+			return emptyString, ErrADIFEnumDeprecated
+		}
+		return r, nil
+	}
+	return emptyString, ErrADIFEnumUnknown
+}
+
+// ADIFEnumValues returns the current values of an enumeration in specification order.
+func ADIFEnumValues(enum ADIFEnumeration) []string {
+	e, ok := adifEnums[enum]
+	if !ok {
+		return nil
+	}
+	out := make([]string, len(e.values))
+	copy(out, e.values)
+	return out
+}
+
+// SubmodeParent returns the mode a submode belongs to, e.g. "SSB" for "USB" or "MFSK" for "FT4".
+func SubmodeParent(submode string) (string, bool) {
+	m, ok := submodeParents[strings.ToUpper(strings.TrimSpace(submode))]
+	return m, ok
+}
+
+// ADIFSubmodes returns the submodes of a mode, or nil if it has none.
+func ADIFSubmodes(mode string) []string {
+	key := strings.ToUpper(strings.TrimSpace(mode))
+	for _, m := range adifModes {
+		if m.mode == key {
+			out := make([]string, len(m.submodes))
+			copy(out, m.submodes)
+			return out
+		}
+	}
+	return nil
+}
+
+// NormalizeModeSubmode validates and normalises an ADIF MODE and SUBMODE pair:
+//   - case is normalised to the specification's spelling;
+//   - a deprecated or submode value given as the mode is migrated ("USB" becomes SSB/USB,
+//     "PSK31" becomes PSK/PSK31), unless it conflicts with a different submode already given;
+//   - an empty mode is filled in from the submode's parent;
+//   - a submode that does not belong to the mode returns ErrADIFSubmodeMismatch.
+func NormalizeModeSubmode(mode, submode string) (string, string, error) {
+	mode = strings.ToUpper(strings.TrimSpace(mode))
+	submode = strings.TrimSpace(submode)
+
+	if submode != emptyString {
+		sm, ok := adifEnums[EnumSubmode].canonical[strings.ToUpper(submode)]
+		if !ok {
+			return emptyString, emptyString, ErrADIFEnumUnknown
+		}
+		submode = sm
+	}
+
+	if mode != emptyString {
+		if _, ok := adifEnums[EnumMode].canonical[mode]; !ok {
+			parent, isSubmode := submodeParents[mode]
+			if !isSubmode {
+				return emptyString, emptyString, ErrADIFEnumUnknown
+			}
+			if submode != emptyString && submode != adifEnums[EnumSubmode].canonical[mode] {
+				return emptyString, emptyString, ErrADIFSubmodeMismatch
+			}
+			mode, submode = parent, adifEnums[EnumSubmode].canonical[mode]
+		}
+	}
+
+	if submode == emptyString {
+		return mode, submode, nil
+	}
+	parent := submodeParents[strings.ToUpper(submode)]
+	if mode == emptyString {
+		return parent, submode, nil
+	}
+	if parent != mode {
+		return emptyString, emptyString, ErrADIFSubmodeMismatch
+	}
+	return mode, submode, nil
+}
+
+var (
+	adifEnums      map[ADIFEnumeration]*adifEnum
+	submodeParents map[string]string
+)
+
+func init() {
+	adifEnums = make(map[ADIFEnumeration]*adifEnum)
+	for enum, values := range adifEnumValues {
+		adifEnums[enum] = newADIFEnum(values, adifEnumDeprecated[enum])
+	}
+
+	var modes, submodes []string
+	submodeParents = make(map[string]string)
+	for _, m := range adifModes {
+		modes = append(modes, m.mode)
+		for _, s := range m.submodes {
+			submodes = append(submodes, s)
+			submodeParents[strings.ToUpper(s)] = m.mode
+		}
+	}
+	deprecatedModes := make(map[string]string, len(adifImportOnlyModes))
+	for _, m := range adifImportOnlyModes {
+		deprecatedModes[m] = emptyString
+	}
+	adifEnums[EnumMode] = newADIFEnum(modes, deprecatedModes)
+	adifEnums[EnumSubmode] = newADIFEnum(submodes, nil)
+}
+
+func newADIFEnum(values []string, deprecated map[string]string) *adifEnum {
+	e := &adifEnum{values: values, canonical: make(map[string]string, len(values)), deprecated: make(map[string]string)}
+	for _, v := range values {
+		e.canonical[strings.ToUpper(v)] = v
+	}
+	for old, replacement := range deprecated {
+		e.deprecated[strings.ToUpper(old)] = replacement
+	}
+	return e
+}
@@ -0,0 +1,170 @@
+package utils
+
+// ADIF 3.1 enumerations. Values are given in the specification's spelling; matching is
+// case-insensitive.
+
+// adifModes lists each ADIF mode with its submodes.
+var adifModes = []struct {
+	mode     string
+	submodes []string
+}{
+	{"AM", nil},
+	{"ARDOP", nil},
+	{"ATV", nil},
+	{"CHIP", []string{"CHIP64", "CHIP128"}},
+	{"CLO", nil},
+	{"CONTESTI", nil},
+	{"CW", []string{"PCW"}},
+	{"DIGITALVOICE", []string{"C4FM", "DMR", "DSTAR", "FREEDV", "M17"}},
+	{"DOMINO", []string{"DOM-M", "DOM4", "DOM5", "DOM8", "DOM11", "DOM16", "DOM22", "DOM44", "DOM88", "DOMINOEX", "DOMINOF"}},
+	{"DYNAMIC", []string{"VARA HF", "VARA SATELLITE", "VARA FM 1200", "VARA FM 9600"}},
+	{"FAX", nil},
+	{"FM", nil},
+	{"FSK441", nil},
+	{"FT8", nil},
+	{"HELL", []string{"FMHELL", "FSKH105", "FSKH245", "FSKHELL", "HELL80", "HELLX5", "HELLX9", "HFSK", "PSKHELL", "SLOWHELL"}},
+	{"ISCAT", []string{"ISCAT-A", "ISCAT-B"}},
+	{"JT4", []string{"JT4A", "JT4B", "JT4C", "JT4D", "JT4E", "JT4F", "JT4G"}},
+	{"JT6M", nil},
+	{"JT9", []string{"JT9-1", "JT9-2", "JT9-5", "JT9-10", "JT9-30", "JT9A", "JT9B", "JT9C", "JT9D", "JT9E", "JT9E FAST", "JT9F", "JT9F FAST", "JT9G", "JT9G FAST", "JT9H", "JT9H FAST"}},
+	{"JT44", nil},
+	{"JT65", []string{"JT65A", "JT65B", "JT65B2", "JT65C", "JT65C2"}},
+	{"MFSK", []string{"FSQCALL", "FST4", "FST4W", "FT4", "JS8", "JTMS", "MFSK4", "MFSK8", "MFSK11", "MFSK16", "MFSK22", "MFSK31", "MFSK32", "MFSK64", "MFSK64L", "MFSK128", "MFSK128L", "Q65"}},
+	{"MSK144", nil},
+	{"MT63", nil},
+	{"OLIVIA", []string{"OLIVIA 4/125", "OLIVIA 4/250", "OLIVIA 8/250", "OLIVIA 8/500", "OLIVIA 16/500", "OLIVIA 16/1000", "OLIVIA 32/1000"}},
+	{"OPERA", []string{"OPERA-BEACON", "OPERA-QSO"}},
+	{"PAC", []string{"PAC2", "PAC3", "PAC4"}},
+	{"PAX", []string{"PAX2"}},
+	{"PKT", nil},
+	{"PSK", []string{
+		"8PSK125", "8PSK125F", "8PSK125FL", "8PSK250", "8PSK250F", "8PSK250FL", "8PSK500", "8PSK500F",
+		"8PSK1000", "8PSK1000F", "8PSK1200F", "FSK31", "PSK10", "PSK31", "PSK63", "PSK63F", "PSK63RC4",
+		"PSK63RC5", "PSK63RC10", "PSK63RC20", "PSK63RC32", "PSK125", "PSK125C12", "PSK125R", "PSK125RC10",
+		"PSK125RC12", "PSK125RC16", "PSK125RC4", "PSK125RC5", "PSK250", "PSK250C6", "PSK250R", "PSK250RC2",
+		"PSK250RC3", "PSK250RC5", "PSK250RC6", "PSK250RC7", "PSK500", "PSK500C2", "PSK500C4", "PSK500R",
+		"PSK500RC2", "PSK500RC3", "PSK500RC4", "PSK800C2", "PSK800RC2", "PSK1000", "PSK1000C2", "PSK1000R",
+		"PSK1000RC2", "PSKAM10", "PSKAM31", "PSKAM50", "PSKFEC31", "QPSK31", "QPSK63", "QPSK125", "QPSK250",
+		"QPSK500", "SIM31",
+	}},
+	{"PSK2K", nil},
+	{"Q15", nil},
+	{"QRA64", []string{"QRA64A", "QRA64B", "QRA64C", "QRA64D", "QRA64E"}},
+	{"ROS", []string{"ROS-EME", "ROS-HF", "ROS-MF"}},
+	{"RTTY", []string{"ASCI"}},
+	{"RTTYM", nil},
+	{"SSB", []string{"LSB", "USB"}},
+	{"SSTV", nil},
+	{"T10", nil},
+	{"THOR", []string{"THOR-M", "THOR4", "THOR5", "THOR8", "THOR11", "THOR16", "THOR22", "THOR25X4", "THOR50X1", "THOR50X2", "THOR100"}},
+	{"THRB", []string{"THRBX", "THRBX1", "THRBX2", "THRBX4", "THROB1", "THROB2", "THROB4"}},
+	{"TOR", []string{"AMTORFEC", "GTOR", "NAVTEX", "SITORB"}},
+	{"V4", nil},
+	{"VOI", nil},
+	{"WINMOR", nil},
+	{"WSPR", nil},
+}
+
+// adifImportOnlyModes are values that earlier ADIF versions allowed in MODE and that are now
+// submodes. NormalizeModeSubmode migrates them to their mode and submode.
+var adifImportOnlyModes = []string{
+	"AMTORFEC", "ASCI", "C4FM", "CHIP64", "CHIP128", "DOMINOF", "DSTAR", "FMHELL", "FSK31", "GTOR",
+	"HELL80", "HFSK", "JT4A", "JT4B", "JT4C", "JT4D", "JT4E", "JT4F", "JT4G", "JT65A", "JT65B", "JT65C",
+	"LSB", "MFSK8", "MFSK16", "PAC2", "PAC3", "PCW", "PSK10", "PSK31", "PSK63", "PSK63F", "PSK125",
+	"PSKAM10", "PSKAM31", "PSKAM50", "PSKFEC31", "PSKHELL", "QPSK31", "QPSK63", "QPSK125", "THRBX",
+	"USB",
+}
+
+// adifEnumValues lists the current values of the enumerations other than Mode and Submode.
+var adifEnumValues = map[ADIFEnumeration][]string{
+	EnumPropagationMode: {
+		"AS", "AUE", "AUR", "BS", "ECH", "EME", "ES", "F2", "FAI", "GWAVE", "INTERNET", "ION", "IRL",
+		"LOS", "MS", "RPT", "RS", "SAT", "TEP", "TR",
+	},
+	EnumQSLRcvd:   {"Y", "N", "R", "I"},
+	EnumQSLSent:   {"Y", "N", "R", "Q", "I"},
+	EnumQSLVia:    {"B", "D", "E"},
+	EnumAntPath:   {"G", "O", "S", "L"},
+	EnumContinent: {"NA", "SA", "EU", "AF", "OC", "AS", "AN"},
+	EnumRegion:    {"NONE", "IV", "AI", "SY", "BI", "SI", "KO", "ET"},
+	EnumBand: {
+		"2190m", "630m", "560m", "160m", "80m", "60m", "40m", "30m", "20m", "17m", "15m", "12m", "10m",
+		"8m", "6m", "5m", "4m", "2m", "1.25m", "70cm", "33cm", "23cm", "13cm", "9cm", "6cm", "3cm",
+		"1.25cm", "6mm", "4mm", "2.5mm", "2mm", "1mm", "submm",
+	},
+	EnumQSOUploadStatus: {"Y", "N", "M"},
+	EnumQSOComplete:     {"Y", "N", "NIL", "?"},
+	EnumQSLMedium:       {"CARD", "EQSL", "LOTW"},
+	EnumCredit: {
+		"CQDX", "CQDX_BAND", "CQDX_MODE", "CQDX_MOBILE", "CQDX_QRP", "CQDX_SATELLITE", "CQDXFIELD",
+		"CQDXFIELD_BAND", "CQDXFIELD_MODE", "CQDXFIELD_MOBILE", "CQDXFIELD_QRP", "CQDXFIELD_SATELLITE",
+		"CQWAZ_MIXED", "CQWAZ_BAND", "CQWAZ_MODE", "CQWAZ_SATELLITE", "CQWAZ_EME", "CQWAZ_MOBILE",
+		"CQWAZ_QRP", "CQWPX", "CQWPX_BAND", "CQWPX_MODE", "DXCC", "DXCC_BAND", "DXCC_MODE",
+		"DXCC_SATELLITE", "EAUSTRALIA", "ECANADA", "ECOUNTY_STATE", "EDX", "EDX100", "EDX100_BAND",
+		"EDX100_MODE", "EECHOLINK50", "EGRID_BAND", "EGRID_SATELLITE", "EPFX300", "EPFX300_MODE", "EWAS",
+		"EWAS_BAND", "EWAS_MODE", "EWAS_SATELLITE", "EZ40", "EZ40_MODE", "FFMA", "IOTA", "IOTA_BASIC",
+		"IOTA_CONT", "IOTA_GROUP", "RDA", "USACA", "VUCC_BAND", "VUCC_SATELLITE", "WAB", "WAC",
+		"WAC_BAND", "WAE", "WAE_BAND", "WAE_MODE", "WAIP", "WAIP_BAND", "WAIP_MODE", "WAS", "WAS_BAND",
+		"WAS_EME", "WAS_MODE", "WAS_NOVICE", "WAS_QRP", "WAS_SATELLITE", "WITUZ", "WITUZ_BAND",
+	},
+	EnumAwardSponsor: {
+		"ADIF_", "ARI_", "ARRL_", "CQ_", "DARC_", "EQSL_", "IARU_", "JARL_", "RSGB_", "TAG_", "WABAG_",
+	},
+	EnumARRLSection: {
+		// United States
+		"AL", "AK", "AZ", "AR", "CO", "CT", "DE", "EB", "EMA", "ENY", "EPA", "EWA", "GA", "IA", "ID",
+		"IL", "IN", "KS", "KY", "LA", "LAX", "MDC", "ME", "MI", "MN", "MO", "MS", "MT", "NC", "ND", "NE",
+		"NFL", "NH", "NLI", "NM", "NNJ", "NNY", "NTX", "NV", "OH", "OK", "OR", "ORG", "PAC", "PR", "SB",
+		"SC", "SCV", "SD", "SDG", "SF", "SFL", "SJV", "SNJ", "STX", "SV", "TN", "UT", "VA", "VI", "VT",
+		"WCF", "WI", "WMA", "WNY", "WPA", "WTX", "WV", "WWA", "WY",
+		// Canada
+		"AB", "BC", "GH", "MB", "NB", "NL", "NS", "ONE", "ONN", "ONS", "PE", "QC", "SK", "TER",
+	},
+	EnumContestID: {
+		"070-160M-SPRINT", "070-3-DAY", "070-31-FLAVORS", "070-40M-SPRINT", "070-80M-SPRINT",
+		"070-PSKFEST", "070-ST-PATS-DAY", "070-VALENTINE-SPRINT", "10-RTTY", "1010-OPEN-SEASON",
+		"7QP", "AL-QSO-PARTY", "ALL-ASIAN-DX-CW", "ALL-ASIAN-DX-PHONE", "ANARTS-RTTY", "ANATOLIAN-RTTY",
+		"AP-SPRINT", "AR-QSO-PARTY", "ARI-DX", "ARRL-10", "ARRL-10-GHZ", "ARRL-160", "ARRL-222",
+		"ARRL-DIGI", "ARRL-DX-CW", "ARRL-DX-SSB", "ARRL-EME", "ARRL-FIELD-DAY", "ARRL-RR-CW",
+		"ARRL-RR-DIG", "ARRL-RR-SSB", "ARRL-RTTY", "ARRL-SCR", "ARRL-SS-CW", "ARRL-SS-SSB",
+		"ARRL-UHF-AUG", "ARRL-VHF-JAN", "ARRL-VHF-JUN", "ARRL-VHF-SEP", "AZ-QSO-PARTY", "BARTG-RTTY",
+		"BARTG-SPRINT", "BC-QSO-PARTY", "CA-QSO-PARTY", "CO-QSO-PARTY", "CQ-160-CW", "CQ-160-SSB",
+		"CQ-M", "CQ-VHF", "CQ-WPX-CW", "CQ-WPX-RTTY", "CQ-WPX-SSB", "CQ-WW-CW", "CQ-WW-RTTY",
+		"CQ-WW-SSB", "CWOPS-CW-OPEN", "CWOPS-CWT", "DARC-WAEDC-CW", "DARC-WAEDC-RTTY",
+		"DARC-WAEDC-SSB", "DARC-WAG", "DL-DX-RTTY", "DMC-RTTY", "EA-CNCW", "EA-DME", "EA-MAJESTAD-CW",
+		"EA-MAJESTAD-SSB", "EA-PSK63", "EA-RTTY", "EA-SMRE-CW", "EA-SMRE-SSB", "EA-VHF-ATLANTIC",
+		"EA-VHF-COM", "EA-VHF-COSTA-SOL", "EA-VHF-EA", "EA-VHF-EA1RCS", "EA-VHF-OZONO",
+		"EA-VHF-PARACUELLOS", "EA-VHF-SEGOVIA", "EA-WW-RTTY", "EPC-PSK63", "EU SPRINT", "EU-HF",
+		"EU-PSK-DX", "EUCW160M", "FALL SPRINT", "FL-QSO-PARTY", "GA-QSO-PARTY", "HA-DX", "HELVETIA",
+		"HI-QSO-PARTY", "HOLYLAND", "IA-QSO-PARTY", "IARU-FIELD-DAY", "IARU-HF", "ICWC-MST",
+		"ID-QSO-PARTY", "IL QSO Party", "IN-QSO-PARTY", "JARTS-WW-RTTY", "JIDX-CW", "JIDX-SSB",
+		"JT-DX-RTTY", "K1USN-SSO", "K1USN-SST", "KS-QSO-PARTY", "KY-QSO-PARTY", "LA-QSO-PARTY",
+		"LDC-RTTY", "LZ DX", "MAR-QSO-PARTY", "MD-QSO-PARTY", "ME-QSO-PARTY", "MI-QSO-PARTY",
+		"MIDATLANTIC-QSO-PARTY", "MN-QSO-PARTY", "MO-QSO-PARTY", "MS-QSO-PARTY", "MT-QSO-PARTY",
+		"NA-SPRINT-CW", "NA-SPRINT-RTTY", "NA-SPRINT-SSB", "NAQP-CW", "NAQP-RTTY", "NAQP-SSB",
+		"NC-QSO-PARTY", "ND-QSO-PARTY", "NE-QSO-PARTY", "NEQP", "NH-QSO-PARTY", "NJ-QSO-PARTY",
+		"NM-QSO-PARTY", "NRAU-BALTIC-CW", "NRAU-BALTIC-SSB", "NV-QSO-PARTY", "NY-QSO-PARTY",
+		"OCEANIA-DX-CW", "OCEANIA-DX-SSB", "OH-QSO-PARTY", "OK-DX-RTTY", "OK-OM-DX", "OK-QSO-PARTY",
+		"OMISS-QSO-PARTY", "ON-QSO-PARTY", "OR-QSO-PARTY", "PA-QSO-PARTY", "PACC", "PCC", "POTA",
+		"QC-QSO-PARTY", "RAC", "RAC-CANADA-DAY", "RAC-CANADA-WINTER", "RDAC", "RDXC", "REF-160M",
+		"REF-CW", "REF-SSB", "REP-PORTUGAL-DAY-HF", "RSGB-160", "RSGB-21/28-CW", "RSGB-21/28-SSB",
+		"RSGB-80M-AFS", "RSGB-AFS-CW", "RSGB-AFS-SSB", "RSGB-CLUB-CALLS", "RSGB-COMMONWEALTH",
+		"RSGB-IOTA", "RSGB-LOW-POWER", "RSGB-NFD", "RSGB-ROPOCO", "RSGB-SSB-FD", "RUSSIAN-RTTY",
+		"SAC-CW", "SAC-SSB", "SARTG-RTTY", "SC-QSO-PARTY", "SCC-RTTY", "SD-QSO-PARTY",
+		"SMP-AUG", "SMP-MAY", "SP-DX-RTTY", "SPAR-WINTER-FD", "SPDXContest", "SPRING SPRINT",
+		"SR-MARATHON", "STEW-PERRY", "SUMMER SPRINT", "TARA-GRID-DIP", "TARA-RTTY", "TARA-RTTY-SPRINT",
+		"TN-QSO-PARTY", "TX-QSO-PARTY", "UBA-DX-CW", "UBA-DX-SSB", "UK-DX-BPSK63", "UK-DX-RTTY",
+		"UKRAINIAN DX", "UKR-CHAMP-RTTY", "UKSMG-6M-MARATHON", "UKSMG-SUMMER-ES", "URE-DX",
+		"US-COUNTIES-QSO", "UT-QSO-PARTY", "VA-QSO-PARTY", "VENEZ-IND-DAY",
+		"VOLTA-RTTY", "WA-QSO-PARTY", "WI-QSO-PARTY", "WV-QSO-PARTY", "WW-DIGI", "WY-QSO-PARTY",
+		"XE-INTL-RTTY", "YOHFDX", "YUDXC",
+	},
+}
+
+// adifEnumDeprecated maps import-only values to their replacement, or "" if there is none.
+var adifEnumDeprecated = map[ADIFEnumeration]map[string]string{
+	EnumQSLRcvd:     {"V": "Y"},
+	EnumQSLVia:      {"M": ""},
+	EnumARRLSection: {"NWT": "TER", "NT": "TER", "MAR": ""},
+	EnumContestID:   {"VIRGINIA QSO PARTY": "VA-QSO-PARTY"},
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateADIFEnum(t *testing.T) {
+	valid := []struct {
+		enum  ADIFEnumeration
+		value string
+	}{
+		{EnumMode, "FT8"},
+		{EnumMode, "ssb"},
+		{EnumSubmode, "usb"},
+		{EnumPropagationMode, "EME"},
+		{EnumQSLRcvd, "Y"},
+		{EnumQSLSent, "q"},
+		{EnumQSLVia, "B"},
+		{EnumAntPath, "L"},
+		{EnumContestID, "cq-ww-cw"},
+		{EnumContinent, "EU"},
+		{EnumARRLSection, "ENY"},
+		{EnumRegion, "KO"},
+		{EnumAwardSponsor, "ARRL_"},
+	}
+	for _, c := range valid {
+		if err := ValidateADIFEnum(c.enum, c.value); err != nil {
+			t.Fatalf("ValidateADIFEnum(%s, %q): %v", c.enum, c.value, err)
+		}
+	}
+
+	if err := ValidateADIFEnum(EnumMode, "USB"); !errors.Is(err, ErrADIFEnumDeprecated) {
+		t.Fatalf("expected ErrADIFEnumDeprecated for mode USB, got %v", err)
+	}
+	if err := ValidateADIFEnum(EnumQSLRcvd, "V"); !errors.Is(err, ErrADIFEnumDeprecated) {
+		t.Fatalf("expected ErrADIFEnumDeprecated for QSL_Rcvd V, got %v", err)
+	}
+	if err := ValidateADIFEnum(EnumMode, "XYZ"); !errors.Is(err, ErrADIFEnumUnknown) {
+		t.Fatalf("expected ErrADIFEnumUnknown, got %v", err)
+	}
+	if err := ValidateADIFEnum("Nonsense", "Y"); !errors.Is(err, ErrADIFEnumUnknown) {
+		t.Fatalf("expected ErrADIFEnumUnknown for unknown enumeration, got %v", err)
+	}
+}
+
+func TestNormalizeADIFEnum(t *testing.T) {
+	cases := []struct {
+		enum  ADIFEnumeration
+		in    string
+		want  string
+		error error
+	}{
+		{EnumContestID, "cq-ww-cw", "CQ-WW-CW", nil},
+		{EnumContestID, "il qso party", "IL QSO Party", nil},
+		{EnumContestID, "virginia qso party", "VA-QSO-PARTY", nil},
+		{EnumQSLRcvd, "v", "Y", nil},
+		{EnumARRLSection, "NWT", "TER", nil},
+		{EnumQSLVia, "M", "", ErrADIFEnumDeprecated},
+		{EnumContinent, "XX", "", ErrADIFEnumUnknown},
+	}
+	for _, c := range cases {
+		got, err := NormalizeADIFEnum(c.enum, c.in)
+		if !errors.Is(err, c.error) || got != c.want {
+			t.Fatalf("NormalizeADIFEnum(%s, %q) = %q, %v; want %q, %v", c.enum, c.in, got, err, c.want, c.error)
+		}
+	}
+}
+
+func TestNormalizeModeSubmode(t *testing.T) {
+	cases := []struct {
+		mode, submode   string
+		wantMode, wantS string
+	}{
+		{"usb", "", "SSB", "USB"},
+		{"PSK31", "", "PSK", "PSK31"},
+		{"", "FT4", "MFSK", "FT4"},
+		{"ssb", "lsb", "SSB", "LSB"},
+		{"FT8", "", "FT8", ""},
+		{"DSTAR", "dstar", "DIGITALVOICE", "DSTAR"},
+		{"olivia", "olivia 8/250", "OLIVIA", "OLIVIA 8/250"},
+	}
+	for _, c := range cases {
+		m, s, err := NormalizeModeSubmode(c.mode, c.submode)
+		if err != nil || m != c.wantMode || s != c.wantS {
+			t.Fatalf("NormalizeModeSubmode(%q, %q) = %q, %q, %v", c.mode, c.submode, m, s, err)
+		}
+	}
+
+	if _, _, err := NormalizeModeSubmode("SSB", "FT4"); !errors.Is(err, ErrADIFSubmodeMismatch) {
+		t.Fatalf("expected ErrADIFSubmodeMismatch, got %v", err)
+	}
+	if _, _, err := NormalizeModeSubmode("USB", "LSB"); !errors.Is(err, ErrADIFSubmodeMismatch) {
+		t.Fatalf("expected ErrADIFSubmodeMismatch, got %v", err)
+	}
+	if _, _, err := NormalizeModeSubmode("XYZ", ""); !errors.Is(err, ErrADIFEnumUnknown) {
+		t.Fatalf("expected ErrADIFEnumUnknown, got %v", err)
+	}
+	if _, _, err := NormalizeModeSubmode("SSB", "XYZ"); !errors.Is(err, ErrADIFEnumUnknown) {
+		t.Fatalf("expected ErrADIFEnumUnknown, got %v", err)
+	}
+}
+
+func TestADIFSubmodes(t *testing.T) {
+	if s := ADIFSubmodes("ssb"); len(s) != 2 || s[0] != "LSB" || s[1] != "USB" {
+		t.Fatalf("unexpected SSB submodes %v", s)
+	}
+	if s := ADIFSubmodes("FT8"); len(s) != 0 {
+		t.Fatalf("expected no FT8 submodes, got %v", s)
+	}
+	if p, ok := SubmodeParent("ft4"); !ok || p != "MFSK" {
+		t.Fatalf("SubmodeParent(FT4) = %q, %v", p, ok)
+	}
+	if _, ok := SubmodeParent("SSB"); ok {
+		t.Fatal("SSB is not a submode")
+	}
+}
+
+func TestADIFEnumData(t *testing.T) {
+	for _, m := range adifImportOnlyModes {
+		if _, ok := SubmodeParent(m); !ok {
+			t.Fatalf("import-only mode %s is not a submode", m)
+		}
+		if err := ValidateADIFEnum(EnumMode, m); !errors.Is(err, ErrADIFEnumDeprecated) {
+			t.Fatalf("import-only mode %s: %v", m, err)
+		}
+	}
+	for enum, deprecated := range adifEnumDeprecated {
+		for old, replacement := range deprecated {
+			if err := ValidateADIFEnum(enum, old); !errors.Is(err, ErrADIFEnumDeprecated) {
+				t.Fatalf("%s %q should be deprecated, got %v", enum, old, err)
+			}
+			if replacement != emptyString {
+				if err := ValidateADIFEnum(enum, replacement); err != nil {
+					t.Fatalf("%s replacement %q: %v", enum, replacement, err)
+				}
+			}
+		}
+	}
+	if v := ADIFEnumValues(EnumQSLSent); len(v) != 5 || v[0] != "Y" {
+		t.Fatalf("unexpected QSL_Sent values %v", v)
+	}
+}
@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ADIFChange is one repair made by NormalizeADIFRecord. Old is empty for an added field and New is
+// empty for a removed one.
+type ADIFChange struct {
+	Field  string
+	Old    string
+	New    string
+	Reason string
+}
+
+// adifCallsignFields are upper-cased by NormalizeADIFRecord.
+var adifCallsignFields = map[string]bool{
+	"CALL": true, "STATION_CALLSIGN": true, "OPERATOR": true, "OWNER_CALLSIGN": true,
+	"CONTACTED_OP": true, "EQ_CALL": true,
+}
+
+// NormalizeADIFRecord repairs the problems commonly found in records imported from other loggers
+// and returns the repaired record with a report of each change. rec is not modified.
+//   - surrounding whitespace is removed, and fields left empty are dropped;
+//   - callsigns are upper-cased;
+//   - dates ("2024-01-31") and times ("12:34") are converted with SanitizeDateToYYYYMMDD and
+//     SanitizeTimeToADIF;
+//   - FREQ and FREQ_RX are converted to MHz from dotted ("14.074.000"), comma-decimal ("14,074")
+//     and whole kHz or Hz forms; a whole number below 1000000 that is already in a band as MHz
+//     is kept;
+//   - a missing BAND or BAND_RX is derived from the frequency with BandForMHz, which reads FREQ as
+//     the MHz ADIF defines rather than guessing the unit as FrequencyToBand does;
+//   - decimal LAT/LON values become XDDD MM.MMM with ConvertToXDDDMMM, as do DMS and DDM text;
+//   - MODE and SUBMODE are migrated with NormalizeModeSubmode ("USB" becomes SSB/USB), and other
+//     enumeration values are normalised with NormalizeADIFEnum ("v" becomes "Y" in QSL_RCVD).
+//
+// Values that cannot be repaired are left as they are; check the result with ValidateADIFRecord.
+func NormalizeADIFRecord(rec ADIFRecord) (ADIFRecord, []ADIFChange) {
+	var out ADIFRecord
+	var changes []ADIFChange
+	for _, f := range rec.Fields {
+		name := strings.ToUpper(f.Name)
+		typ, enum, _ := ADIFFieldType(name)
+		value, reason := f.Value, emptyString
+		if typ != ADIFMultilineString && typ != ADIFIntlMultilineString {
+			if value = strings.TrimSpace(f.Value); value != f.Value {
+				reason = "surrounding whitespace removed"
+			}
+		}
+		if value == emptyString {
+			changes = append(changes, ADIFChange{Field: name, Old: f.Value, Reason: "empty field removed"})
+			continue
+		}
+		if repaired, why := normalizeADIFValue(name, typ, enum, value); repaired != value {
+			value, reason = repaired, why
+		}
+		if value != f.Value {
+			changes = append(changes, ADIFChange{Field: name, Old: f.Value, New: value, Reason: reason})
+		}
+		out.Fields = append(out.Fields, ADIFField{Name: name, Value: value, Type: f.Type})
+	}
+
+	mode, submode := out.Value("MODE"), out.Value("SUBMODE")
+	if m, s, err := NormalizeModeSubmode(mode, submode); err == nil {
+		changes = normalizeADIFSet(&out, changes, "MODE", mode, m, "mode migrated to ADIF 3.1 mode and submode")
+		changes = normalizeADIFSet(&out, changes, "SUBMODE", submode, s, "mode migrated to ADIF 3.1 mode and submode")
+	}
+
+	for _, p := range [][2]string{{"FREQ", "BAND"}, {"FREQ_RX", "BAND_RX"}} {
+		freq := out.Value(p[0])
+		if _, ok := out.Get(p[1]); ok || freq == emptyString {
+			continue
+		}
+		mhz, err := strconv.ParseFloat(freq, 64)
+		if err != nil {
+			continue
+		}
+		if band, ok := BandForMHz(mhz); ok {
+			out.Set(p[1], band)
+			changes = append(changes, ADIFChange{Field: p[1], New: band, Reason: "derived from " + p[0]})
+		}
+	}
+	return out, changes
+}
+
+// normalizeADIFSet sets name to value if it differs from old, recording the change. An empty value
+// removes the field.
+func normalizeADIFSet(rec *ADIFRecord, changes []ADIFChange, name, old, value, reason string) []ADIFChange {
+	if value == old {
+		return changes
+	}
+	if value == emptyString {
+		rec.Delete(name)
+	} else {
+		rec.Set(name, value)
+	}
+	return append(changes, ADIFChange{Field: name, Old: old, New: value, Reason: reason})
+}
+
+// normalizeADIFValue returns the repaired form of one trimmed, non-empty value and the reason for
+// the repair. It returns value unchanged if it needs no repair or cannot be repaired.
+func normalizeADIFValue(name string, typ ADIFDataType, enum ADIFEnumeration, value string) (string, string) {
+	switch {
+	case adifCallsignFields[name]:
+		return strings.ToUpper(value), "callsign upper-cased"
+	case name == "FREQ" || name == "FREQ_RX":
+		return normalizeADIFFrequency(value), "frequency converted to MHz"
+	case name == "MODE" || name == "SUBMODE" || enum == adifEnumDXCC:
+		// MODE and SUBMODE are normalised as a pair by NormalizeADIFRecord.
+		return value, emptyString
+	}
+
+	switch typ {
+	case ADIFDate:
+		if d := SanitizeDateToYYYYMMDD(value); d != emptyString {
+			return d, "date converted to YYYYMMDD"
+		}
+	case ADIFTime:
+		if t := SanitizeTimeToADIF(value); t != emptyString {
+			return t, "time converted to HHMM or HHMMSS"
+		}
+	case ADIFLocation:
+		axis := AxisLatitude
+		if strings.HasSuffix(name, "LON") {
+			axis = AxisLongitude
+		}
+		return normalizeADIFLocation(value, axis), "position converted to XDDD MM.MMM"
+	case ADIFEnumerationType:
+		if v, err := NormalizeADIFEnum(enum, value); err == nil {
+			return v, "normalised to the " + string(enum) + " enumeration"
+		}
+	}
+	return value, emptyString
+}
+
+// normalizeADIFFrequency converts a frequency to ADIF MHz. A whole number below 1000000 is kept if
+// it lies in an ADIF band as MHz ("144", "10100"), since that is what ADIF means by it; larger ones
+// are HF and VHF frequencies in Hz far more often than submm ones in MHz. Otherwise it is taken as
+// Hz ("14074000") or kHz ("14074") if that gives a band (see wholeFrequencyMHz), and is left as it
+// is if neither does. The result is formatted with formatFrequencyMHz rather than
+// FormatFrequencyToMhz, which drops the Hz of a dotted frequency.
+func normalizeADIFFrequency(value string) string {
+	if strings.Count(value, ",") == 1 && !strings.Contains(value, dotString) {
+		value = strings.Replace(value, ",", dotString, 1)
+	}
+	switch strings.Count(value, dotString) {
+	case 0:
+	case 1:
+		return value
+	default:
+		if mhz, err := ParseFrequencyMHz(value); err == nil {
+			return formatFrequencyMHz(mhz)
+		}
+		return value
+	}
+
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	if _, ok := BandForMHz(float64(n)); ok && n < 1e6 {
+		return value
+	}
+	if mhz, ok := wholeFrequencyMHz(n); ok {
+		return formatFrequencyMHz(mhz)
+	}
+	return value
+}
+
+// formatFrequencyMHz formats a frequency in MHz to Hz precision with at least three decimals, as
+// in "14.074" and "7.000".
+func formatFrequencyMHz(mhz float64) string {
+	s := strings.TrimRight(strconv.FormatFloat(mhz, 'f', 6, 64), "0")
+	if i := strings.IndexByte(s, '.'); len(s)-i-1 < 3 {
+		s += strings.Repeat("0", 3-(len(s)-i-1))
+	}
+	return s
+}
+
+// normalizeADIFLocation converts a decimal or DMS/DDM position to an ADIF Location.
+func normalizeADIFLocation(value string, axis Axis) string {
+	if _, err := ParseADIFLocation(value); err == nil {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		if loc, err := ConvertToXDDDMMM(value, axis); err == nil {
+			return loc
+		}
+		return value
+	}
+	if c, err := ParseCoordinate(value, axis); err == nil {
+		if loc, err := c.ADIFLocation(); err == nil {
+			return loc
+		}
+	}
+	return value
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeADIFRecord(t *testing.T) {
+	in := ADIFRecord{Fields: []ADIFField{
+		{Name: "call", Value: " w1aw/p "},
+		{Name: "QSO_DATE", Value: "2024-01-31"},
+		{Name: "TIME_ON", Value: "12:34"},
+		{Name: "FREQ", Value: "14.074.000"},
+		{Name: "MODE", Value: "usb"},
+		{Name: "LAT", Value: "51.5"},
+		{Name: "LON", Value: "-0.25"},
+		{Name: "QSL_RCVD", Value: "v"},
+		{Name: "COMMENT", Value: "  "},
+		{Name: "NOTES", Value: " keep\r\n "},
+		{Name: "RST_SENT", Value: "59"},
+	}}
+	orig := append([]ADIFField(nil), in.Fields...)
+
+	out, changes := NormalizeADIFRecord(in)
+	want := []ADIFField{
+		{Name: "CALL", Value: "W1AW/P"},
+		{Name: "QSO_DATE", Value: "20240131"},
+		{Name: "TIME_ON", Value: "1234"},
+		{Name: "FREQ", Value: "14.074"},
+		{Name: "MODE", Value: "SSB"},
+		{Name: "LAT", Value: "N051 30.000"},
+		{Name: "LON", Value: "W000 15.000"},
+		{Name: "QSL_RCVD", Value: "Y"},
+		{Name: "NOTES", Value: " keep\r\n "},
+		{Name: "RST_SENT", Value: "59"},
+		{Name: "SUBMODE", Value: "USB"},
+		{Name: "BAND", Value: "20m"},
+	}
+	if !reflect.DeepEqual(out.Fields, want) {
+		t.Fatalf("unexpected record\n%+v\nwant\n%+v", out.Fields, want)
+	}
+	if !reflect.DeepEqual(in.Fields, orig) {
+		t.Fatal("input record was modified")
+	}
+
+	fields := map[string]ADIFChange{}
+	for _, c := range changes {
+		fields[c.Field] = c
+	}
+	if len(changes) != 11 || len(fields) != 11 {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+	if c := fields["BAND"]; c.Old != emptyString || c.New != "20m" || c.Reason != "derived from FREQ" {
+		t.Fatalf("unexpected BAND change %+v", c)
+	}
+	if c := fields["COMMENT"]; c.New != emptyString || c.Reason != "empty field removed" {
+		t.Fatalf("unexpected COMMENT change %+v", c)
+	}
+	if c := fields["TIME_ON"]; c.Old != "12:34" || c.New != "1234" {
+		t.Fatalf("unexpected TIME_ON change %+v", c)
+	}
+	if errs := ValidateADIFRecord(out); errs != nil {
+		t.Fatalf("normalised record is invalid: %v", errs)
+	}
+}
+
+func TestNormalizeADIFRecord_Frequency(t *testing.T) {
+	tests := map[string]string{
+		"14.074":     "14.074",
+		"14,074":     "14.074",
+		"7":          "7",
+		"14074":      "14.074",
+		"3573000":    "3.573",
+		"50313000":   "50.313",
+		"14.074.500": "14.0745",
+		"7.000.000":  "7.000",
+		"abc":        "abc",
+		"999999":     "999999",
+		"10100":      "10100",
+		"3500":       "3500",
+	}
+	for in, want := range tests {
+		out, _ := NormalizeADIFRecord(ADIFRecord{Fields: []ADIFField{{Name: "FREQ", Value: in}}})
+		if got := out.Value("FREQ"); got != want {
+			t.Fatalf("FREQ %q became %q, want %q", in, got, want)
+		}
+	}
+	bands := map[string]string{"10100": "3cm", "3500": "9cm", "14074": "20m", "144": "2m"}
+	for in, want := range bands {
+		out, _ := NormalizeADIFRecord(ADIFRecord{Fields: []ADIFField{{Name: "FREQ", Value: in}}})
+		if got := out.Value("BAND"); got != want {
+			t.Fatalf("FREQ %q gave BAND %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalizeADIFRecord_NoChanges(t *testing.T) {
+	in := ADIFRecord{Fields: []ADIFField{
+		{Name: "CALL", Value: "G4ABC"},
+		{Name: "BAND", Value: "2m"},
+		{Name: "FREQ", Value: "144.300"},
+		{Name: "MODE", Value: "FT8"},
+		{Name: "LAT", Value: "N051 30.000"},
+		{Name: "UNPARSEABLE_TIME", Value: "noon"},
+		{Name: "TIME_OFF", Value: "noon"},
+	}}
+	out, changes := NormalizeADIFRecord(in)
+	if changes != nil || !reflect.DeepEqual(out, in) {
+		t.Fatalf("unexpected changes %+v to %+v", changes, out)
+	}
+}
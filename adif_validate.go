@@ -0,0 +1,420 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrADIFFieldValue = errors.New("invalid ADIF field value")
+
+// ADIFDataType names an ADIF 3.1 data type.
+type ADIFDataType string
+
+const (
+	ADIFBoolean                  ADIFDataType = "Boolean"
+	ADIFCharacter                ADIFDataType = "Character"
+	ADIFIntlCharacter            ADIFDataType = "IntlCharacter"
+	ADIFDate                     ADIFDataType = "Date"
+	ADIFTime                     ADIFDataType = "Time"
+	ADIFDigit                    ADIFDataType = "Digit"
+	ADIFInteger                  ADIFDataType = "Integer"
+	ADIFNumber                   ADIFDataType = "Number"
+	ADIFPositiveInteger          ADIFDataType = "PositiveInteger"
+	ADIFString                   ADIFDataType = "String"
+	ADIFIntlString               ADIFDataType = "IntlString"
+	ADIFMultilineString          ADIFDataType = "MultilineString"
+	ADIFIntlMultilineString      ADIFDataType = "IntlMultilineString"
+	ADIFEnumerationType          ADIFDataType = "Enumeration"
+	ADIFGridSquare               ADIFDataType = "GridSquare"
+	ADIFGridSquareExt            ADIFDataType = "GridSquareExt"
+	ADIFGridSquareList           ADIFDataType = "GridSquareList"
+	ADIFLocation                 ADIFDataType = "Location"
+	ADIFSponsoredAward           ADIFDataType = "SponsoredAward"
+	ADIFSponsoredAwardList       ADIFDataType = "SponsoredAwardList"
+	ADIFSOTARef                  ADIFDataType = "SOTARef"
+	ADIFPOTARef                  ADIFDataType = "POTARef"
+	ADIFPOTARefList              ADIFDataType = "POTARefList"
+	ADIFWWFFRef                  ADIFDataType = "WWFFRef"
+	ADIFIOTARefNo                ADIFDataType = "IOTARefNo"
+	ADIFCreditList               ADIFDataType = "CreditList"
+	ADIFSecondarySubdivisionList ADIFDataType = "SecondarySubdivisionList"
+)
+
+// adifTypeIndicators maps the data type indicators allowed in ADI field specifiers and USERDEF
+// declarations to their data type.
+var adifTypeIndicators = map[string]ADIFDataType{
+	"B": ADIFBoolean,
+	"N": ADIFNumber,
+	"D": ADIFDate,
+	"T": ADIFTime,
+	"S": ADIFString,
+	"I": ADIFIntlString,
+	"M": ADIFMultilineString,
+	"G": ADIFIntlMultilineString,
+	"E": ADIFEnumerationType,
+	"L": ADIFLocation,
+}
+
+// ADIFDataTypeForIndicator returns the data type of an ADIF data type indicator, e.g. ADIFDate
+// for "D".
+func ADIFDataTypeForIndicator(indicator string) (ADIFDataType, bool) {
+	t, ok := adifTypeIndicators[strings.ToUpper(strings.TrimSpace(indicator))]
+	return t, ok
+}
+
+// ADIFFieldError reports why one field value is invalid. Field is empty for errors from
+// ValidateADIFValue. Err is ErrADIFFieldValue, or ErrADIFEnumUnknown or ErrADIFEnumDeprecated for
+// enumeration fields, so callers can tell a deprecated value (which NormalizeADIFEnum may fix)
+// from an invalid one.
+type ADIFFieldError struct {
+	Field  string
+	Value  string
+	Type   ADIFDataType
+	Reason string
+	Err    error
+}
+
+func (e *ADIFFieldError) Error() string {
+	if e.Field == emptyString {
+		return fmt.Sprintf("invalid %s %q: %s", e.Type, e.Value, e.Reason)
+	}
+	return fmt.Sprintf("%s: invalid %s %q: %s", e.Field, e.Type, e.Value, e.Reason)
+}
+
+func (e *ADIFFieldError) Unwrap() error {
+	return e.Err
+}
+
+// ADIFFieldType returns the data type of a field defined by the ADIF specification, and for
+// Enumeration fields the enumeration it draws from ("DXCC_Entity_Code" for DXCC and MY_DXCC,
+// which are checked against the DXCC entity table). Enumerations that depend on other fields
+// (STATE and CNTY depend on DXCC) are typed as String; see ValidatePrimarySubdivision.
+func ADIFFieldType(name string) (ADIFDataType, ADIFEnumeration, bool) {
+	spec, ok := adifFieldSpecs[strings.ToUpper(strings.TrimSpace(name))]
+	return spec.typ, spec.enum, ok
+}
+
+// ValidateADIFValue checks value against an ADIF data type, returning an *ADIFFieldError giving
+// the reason if it does not conform. An empty value is valid, since ADIF treats a zero-length field
+// as absent. Enumeration values are only checked as Strings here; use ValidateADIFEnum.
+func ValidateADIFValue(t ADIFDataType, value string) error {
+	if value == emptyString {
+		return nil
+	}
+	if reason := checkADIFValue(t, value); reason != emptyString {
+		return &ADIFFieldError{Value: value, Type: t, Reason: reason, Err: ErrADIFFieldValue}
+	}
+	return nil
+}
+
+// ValidateADIFField checks a field against its type, returning an *ADIFFieldError if it is
+// invalid. The type is taken from the ADIF specification for its fields, from the matching
+// declaration in userDefs for user-defined fields, and otherwise from the field's data type
+// indicator. Fields with none of these, such as APP_ fields without an indicator, are not checked.
+func ValidateADIFField(f ADIFField, userDefs ...ADIFUserDef) error {
+	if f.Value == emptyString {
+		return nil
+	}
+	name := strings.ToUpper(f.Name)
+	fail := func(t ADIFDataType, err error, format string, args ...any) error {
+		return &ADIFFieldError{Field: name, Value: f.Value, Type: t, Reason: fmt.Sprintf(format, args...), Err: err}
+	}
+
+	if spec, ok := adifFieldSpecs[name]; ok {
+		return spec.validate(name, f.Value)
+	}
+
+	for _, u := range userDefs {
+		if !strings.EqualFold(u.Name, name) {
+			continue
+		}
+		t, ok := adifTypeIndicators[u.Type]
+		if !ok {
+			t = ADIFString
+		}
+		if len(u.Enum) > 0 {
+			for _, v := range u.Enum {
+				if strings.EqualFold(v, f.Value) {
+					return nil
+				}
+			}
+			return fail(t, ErrADIFEnumUnknown, "not one of {%s}", strings.Join(u.Enum, ","))
+		}
+		if t != ADIFEnumerationType {
+			if reason := checkADIFValue(t, f.Value); reason != emptyString {
+				return fail(t, ErrADIFFieldValue, "%s", reason)
+			}
+		}
+		if u.HasRange {
+			n, err := strconv.ParseFloat(f.Value, 64)
+			if err != nil || n < u.Min || n > u.Max {
+				return fail(t, ErrADIFFieldValue, "outside the declared range %v to %v", u.Min, u.Max)
+			}
+		}
+		return nil
+	}
+
+	if t, ok := adifTypeIndicators[strings.ToUpper(f.Type)]; ok && t != ADIFEnumerationType {
+		if reason := checkADIFValue(t, f.Value); reason != emptyString {
+			return fail(t, ErrADIFFieldValue, "%s", reason)
+		}
+	}
+	return nil
+}
+
+// ValidateADIFRecord checks every field of rec with ValidateADIFField and returns the errors in
+// field order, or nil if the record is valid. Pass the header's UserDefs to check user-defined
+// fields.
+func ValidateADIFRecord(rec ADIFRecord, userDefs ...ADIFUserDef) []*ADIFFieldError {
+	var errs []*ADIFFieldError
+	for _, f := range rec.Fields {
+		if err := ValidateADIFField(f, userDefs...); err != nil {
+			var fe *ADIFFieldError
+			errors.As(err, &fe)
+			errs = append(errs, fe)
+		}
+	}
+	return errs
+}
+
+// adifFieldSpec is the definition of one ADIF field: its type, its enumeration for Enumeration
+// fields, and its range for numeric fields the specification bounds.
+type adifFieldSpec struct {
+	typ      ADIFDataType
+	enum     ADIFEnumeration
+	hasRange bool
+	min, max float64
+}
+
+func (s adifFieldSpec) validate(name, value string) error {
+	fail := func(err error, format string, args ...any) error {
+		return &ADIFFieldError{Field: name, Value: value, Type: s.typ, Reason: fmt.Sprintf(format, args...), Err: err}
+	}
+	if s.typ == ADIFEnumerationType {
+		switch {
+		case s.enum == adifEnumDXCC:
+			if value != "0" {
+				if _, ok := LookupDXCCString(value); !ok || strings.TrimSpace(value) != value {
+					return fail(ErrADIFEnumUnknown, "not a DXCC entity code")
+				}
+			}
+		case s.enum != emptyString:
+			switch err := ValidateADIFEnum(s.enum, value); {
+			case errors.Is(err, ErrADIFEnumDeprecated):
+				return fail(err, "import-only %s value", s.enum)
+			case err != nil:
+				return fail(err, "not a %s value", s.enum)
+			}
+		}
+		return nil
+	}
+	if reason := checkADIFValue(s.typ, value); reason != emptyString {
+		return fail(ErrADIFFieldValue, "%s", reason)
+	}
+	if s.hasRange {
+		// Only numeric types carry a range, and checkADIFValue has already parsed them.
+		n, _ := strconv.ParseFloat(value, 64)
+		switch {
+		case math.IsInf(s.max, 1) && n < s.min:
+			return fail(ErrADIFFieldValue, "must not be less than %v", s.min)
+		case n < s.min || n > s.max:
+			return fail(ErrADIFFieldValue, "outside the range %v to %v", s.min, s.max)
+		}
+	}
+	return nil
+}
+
+var (
+	adifIntegerRe        = regexp.MustCompile(`^-?[0-9]+$`)
+	adifNumberRe         = regexp.MustCompile(`^-?([0-9]+\.?[0-9]*|\.[0-9]+)$`)
+	adifIOTARefNoRe      = regexp.MustCompile(`^(?i:AF|AN|AS|EU|NA|OC|SA)-[0-9]{3}$`)
+	adifGridSquareExtRe  = regexp.MustCompile(`^[A-Xa-x]{2}([0-9]{2})?$`)
+	adifSponsoredAwardRe = regexp.MustCompile(`^([A-Za-z]+_)[^,]+$`)
+)
+
+// checkADIFValue returns why value does not conform to t, or "" if it does.
+func checkADIFValue(t ADIFDataType, value string) string {
+	switch t {
+	case ADIFBoolean:
+		if !strings.EqualFold(value, "Y") && !strings.EqualFold(value, "N") {
+			return "must be Y or N"
+		}
+	case ADIFCharacter:
+		if len(value) != 1 || !isADIFChar(rune(value[0])) {
+			return "must be a single ASCII character"
+		}
+	case ADIFIntlCharacter:
+		r, size := utf8.DecodeRuneInString(value)
+		if size != len(value) || r == utf8.RuneError || r == '\r' || r == '\n' {
+			return "must be a single character"
+		}
+	case ADIFDate:
+		switch {
+		case len(value) != 8 || !IsValidDateYYYYMMDD(value):
+			return "must be a calendar date as YYYYMMDD"
+		case value < "1930":
+			return "must not be before 1930"
+		}
+	case ADIFTime:
+		if !IsValidTimeADIF(value) || strings.TrimSpace(value) != value {
+			return "must be a UTC time as HHMM or HHMMSS"
+		}
+	case ADIFDigit:
+		if len(value) != 1 || !isDigit(value[0]) {
+			return "must be a single digit"
+		}
+	case ADIFInteger:
+		if !adifIntegerRe.MatchString(value) {
+			return "must be a whole number"
+		}
+	case ADIFPositiveInteger:
+		if !adifIntegerRe.MatchString(value) || value[0] == '-' || strings.Trim(value, "0") == emptyString {
+			return "must be a whole number greater than zero"
+		}
+	case ADIFNumber:
+		if !adifNumberRe.MatchString(value) {
+			return "must be a decimal number without exponent"
+		}
+	case ADIFString, ADIFEnumerationType:
+		for _, r := range value {
+			if !isADIFChar(r) {
+				return fmt.Sprintf("contains %q; only printable ASCII is allowed", r)
+			}
+		}
+	case ADIFIntlString:
+		if !utf8.ValidString(value) {
+			return "is not valid UTF-8"
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return "must not contain line breaks"
+		}
+	case ADIFMultilineString, ADIFIntlMultilineString:
+		if t == ADIFIntlMultilineString && !utf8.ValidString(value) {
+			return "is not valid UTF-8"
+		}
+		for i, r := range value {
+			switch {
+			case r == '\r':
+				if i+1 == len(value) || value[i+1] != '\n' {
+					return "line breaks must be CR LF pairs"
+				}
+			case r == '\n':
+				if i == 0 || value[i-1] != '\r' {
+					return "line breaks must be CR LF pairs"
+				}
+			case t == ADIFMultilineString && !isADIFChar(r):
+				return fmt.Sprintf("contains %q; only printable ASCII is allowed", r)
+			}
+		}
+	case ADIFGridSquare:
+		if !IsValidGridSquare(value) || strings.TrimSpace(value) != value {
+			return "must be a 2, 4, 6 or 8 character Maidenhead locator"
+		}
+	case ADIFGridSquareExt:
+		if !adifGridSquareExtRe.MatchString(value) {
+			return "must be the 2 or 4 characters extending an 8 character locator"
+		}
+	case ADIFGridSquareList:
+		for _, g := range strings.Split(value, ",") {
+			if !IsValidGridSquare(g) || strings.TrimSpace(g) != g {
+				return fmt.Sprintf("%q is not a Maidenhead locator", g)
+			}
+		}
+	case ADIFLocation:
+		if _, err := ParseADIFLocation(value); err != nil {
+			return "must be a position as XDDD MM.MMM"
+		}
+	case ADIFSponsoredAward:
+		return checkSponsoredAward(value)
+	case ADIFSponsoredAwardList:
+		for _, a := range strings.Split(value, ",") {
+			if reason := checkSponsoredAward(a); reason != emptyString {
+				return reason
+			}
+		}
+	case ADIFCreditList:
+		for _, c := range strings.Split(value, ",") {
+			if reason := checkADIFCredit(c); reason != emptyString {
+				return reason
+			}
+		}
+	case ADIFSecondarySubdivisionList:
+		for _, c := range strings.Split(value, ":") {
+			primary, name, ok := strings.Cut(c, ",")
+			if !ok || strings.TrimSpace(primary) == emptyString || strings.TrimSpace(name) == emptyString {
+				return fmt.Sprintf("%q is not a secondary subdivision such as MA,Franklin", c)
+			}
+			if reason := checkADIFValue(ADIFString, c); reason != emptyString {
+				return reason
+			}
+		}
+	case ADIFSOTARef:
+		if _, err := ParseAwardReference(AwardSOTA, value); err != nil || strings.TrimSpace(value) != value {
+			return "must be a SOTA summit reference such as G/LD-001"
+		}
+	case ADIFPOTARef:
+		if _, err := ParseAwardReference(AwardPOTA, value); err != nil || strings.TrimSpace(value) != value {
+			return "must be a POTA park reference such as US-1234"
+		}
+	case ADIFPOTARefList:
+		for _, ref := range strings.Split(value, ",") {
+			if _, err := ParseAwardReference(AwardPOTA, ref); err != nil || strings.TrimSpace(ref) != ref {
+				return fmt.Sprintf("%q is not a POTA park reference", ref)
+			}
+		}
+	case ADIFWWFFRef:
+		if _, err := ParseAwardReference(AwardWWFF, value); err != nil || strings.TrimSpace(value) != value {
+			return "must be a WWFF reference such as GFF-0001"
+		}
+	case ADIFIOTARefNo:
+		if _, err := ParseAwardReference(AwardIOTA, value); err != nil || !adifIOTARefNoRe.MatchString(value) {
+			return "must be an IOTA reference such as EU-005"
+		}
+	default:
+		return "unknown data type"
+	}
+	return emptyString
+}
+
+// checkSponsoredAward checks one SponsoredAward value: an Award_Sponsor prefix such as "ARRL_"
+// followed by the award name.
+func checkSponsoredAward(value string) string {
+	m := adifSponsoredAwardRe.FindStringSubmatch(value)
+	if m == nil || ValidateADIFEnum(EnumAwardSponsor, m[1]) != nil {
+		return fmt.Sprintf("%q does not start with an award sponsor such as ARRL_", value)
+	}
+	for _, r := range value {
+		if !isADIFChar(r) {
+			return fmt.Sprintf("%q contains %q; only printable ASCII is allowed", value, r)
+		}
+	}
+	return emptyString
+}
+
+// checkADIFCredit checks one CreditList item: a Credit such as "IOTA", optionally followed by the
+// QSL media it was confirmed by, as in "DXCC:CARD&LOTW".
+func checkADIFCredit(value string) string {
+	credit, media, hasMedia := strings.Cut(value, ":")
+	if ValidateADIFEnum(EnumCredit, credit) != nil || strings.TrimSpace(credit) != credit {
+		return fmt.Sprintf("%q is not an ADIF credit such as DXCC", credit)
+	}
+	if !hasMedia {
+		return emptyString
+	}
+	for _, m := range strings.Split(media, "&") {
+		if ValidateADIFEnum(EnumQSLMedium, m) != nil || strings.TrimSpace(m) != m {
+			return fmt.Sprintf("%q is not a QSL medium (CARD, EQSL or LOTW)", m)
+		}
+	}
+	return emptyString
+}
+
+// isADIFChar reports whether r is an ADIF Character: printable ASCII, space included.
+func isADIFChar(r rune) bool {
+	return r >= ' ' && r <= '~'
+}
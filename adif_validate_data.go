@@ -0,0 +1,193 @@
+package utils
+
+import "math"
+
+// adifEnumDXCC is the DXCC Entity Code enumeration, checked against the DXCC entity table rather
+// than adifEnums.
+const adifEnumDXCC ADIFEnumeration = "DXCC_Entity_Code"
+
+// adifFieldSpecs defines the ADIF 3.1 QSO fields by name. Ranges are those the specification gives for the field.
+var adifFieldSpecs = map[string]adifFieldSpec{
+	"ADDRESS":                    adifFieldOfType(ADIFMultilineString),
+	"ADDRESS_INTL":               adifFieldOfType(ADIFIntlMultilineString),
+	"AGE":                        adifFieldInRange(ADIFNumber, 0, 120),
+	"ALTITUDE":                   adifFieldOfType(ADIFNumber),
+	"ANT_AZ":                     adifFieldInRange(ADIFNumber, 0, 360),
+	"ANT_EL":                     adifFieldInRange(ADIFNumber, -90, 90),
+	"ANT_PATH":                   adifFieldOfEnum(EnumAntPath),
+	"ARRL_SECT":                  adifFieldOfEnum(EnumARRLSection),
+	"AWARD_GRANTED":              adifFieldOfType(ADIFSponsoredAwardList),
+	"AWARD_SUBMITTED":            adifFieldOfType(ADIFSponsoredAwardList),
+	"A_INDEX":                    adifFieldInRange(ADIFNumber, 0, 400),
+	"BAND":                       adifFieldOfEnum(EnumBand),
+	"BAND_RX":                    adifFieldOfEnum(EnumBand),
+	"CALL":                       adifFieldOfType(ADIFString),
+	"CHECK":                      adifFieldOfType(ADIFString),
+	"CLASS":                      adifFieldOfType(ADIFString),
+	"CLUBLOG_QSO_UPLOAD_DATE":    adifFieldOfType(ADIFDate),
+	"CLUBLOG_QSO_UPLOAD_STATUS":  adifFieldOfEnum(EnumQSOUploadStatus),
+	"CNTY":                       adifFieldOfType(ADIFString),
+	"COMMENT":                    adifFieldOfType(ADIFString),
+	"COMMENT_INTL":               adifFieldOfType(ADIFIntlString),
+	"CONT":                       adifFieldOfEnum(EnumContinent),
+	"CONTACTED_OP":               adifFieldOfType(ADIFString),
+	"CONTEST_ID":                 adifFieldOfType(ADIFString),
+	"COUNTRY":                    adifFieldOfType(ADIFString),
+	"COUNTRY_INTL":               adifFieldOfType(ADIFIntlString),
+	"CQZ":                        adifFieldInRange(ADIFPositiveInteger, 1, 40),
+	"CREDIT_GRANTED":             adifFieldOfType(ADIFCreditList),
+	"CREDIT_SUBMITTED":           adifFieldOfType(ADIFCreditList),
+	"DARC_DOK":                   adifFieldOfType(ADIFString),
+	"DISTANCE":                   adifFieldNonNegative(ADIFNumber),
+	"DXCC":                       adifFieldOfEnum(adifEnumDXCC),
+	"EMAIL":                      adifFieldOfType(ADIFString),
+	"EQ_CALL":                    adifFieldOfType(ADIFString),
+	"EQSL_QSLRDATE":              adifFieldOfType(ADIFDate),
+	"EQSL_QSLSDATE":              adifFieldOfType(ADIFDate),
+	"EQSL_QSL_RCVD":              adifFieldOfEnum(EnumQSLRcvd),
+	"EQSL_QSL_SENT":              adifFieldOfEnum(EnumQSLSent),
+	"FISTS":                      adifFieldOfType(ADIFPositiveInteger),
+	"FISTS_CC":                   adifFieldOfType(ADIFPositiveInteger),
+	"FORCE_INIT":                 adifFieldOfType(ADIFBoolean),
+	"FREQ":                       adifFieldOfType(ADIFNumber),
+	"FREQ_RX":                    adifFieldOfType(ADIFNumber),
+	"GRIDSQUARE":                 adifFieldOfType(ADIFGridSquare),
+	"GRIDSQUARE_EXT":             adifFieldOfType(ADIFGridSquareExt),
+	"HAMLOGEU_QSO_UPLOAD_DATE":   adifFieldOfType(ADIFDate),
+	"HAMLOGEU_QSO_UPLOAD_STATUS": adifFieldOfEnum(EnumQSOUploadStatus),
+	"HAMQTH_QSO_UPLOAD_DATE":     adifFieldOfType(ADIFDate),
+	"HAMQTH_QSO_UPLOAD_STATUS":   adifFieldOfEnum(EnumQSOUploadStatus),
+	"HRDLOG_QSO_UPLOAD_DATE":     adifFieldOfType(ADIFDate),
+	"HRDLOG_QSO_UPLOAD_STATUS":   adifFieldOfEnum(EnumQSOUploadStatus),
+	"IOTA":                       adifFieldOfType(ADIFIOTARefNo),
+	"IOTA_ISLAND_ID":             adifFieldOfType(ADIFPositiveInteger),
+	"ITUZ":                       adifFieldInRange(ADIFPositiveInteger, 1, 90),
+	"K_INDEX":                    adifFieldInRange(ADIFInteger, 0, 9),
+	"LAT":                        adifFieldOfType(ADIFLocation),
+	"LON":                        adifFieldOfType(ADIFLocation),
+	"LOTW_QSLRDATE":              adifFieldOfType(ADIFDate),
+	"LOTW_QSLSDATE":              adifFieldOfType(ADIFDate),
+	"LOTW_QSL_RCVD":              adifFieldOfEnum(EnumQSLRcvd),
+	"LOTW_QSL_SENT":              adifFieldOfEnum(EnumQSLSent),
+	"MAX_BURSTS":                 adifFieldNonNegative(ADIFNumber),
+	"MODE":                       adifFieldOfEnum(EnumMode),
+	"MS_SHOWER":                  adifFieldOfType(ADIFString),
+	"MY_ALTITUDE":                adifFieldOfType(ADIFNumber),
+	"MY_ANTENNA":                 adifFieldOfType(ADIFString),
+	"MY_ANTENNA_INTL":            adifFieldOfType(ADIFIntlString),
+	"MY_ARRL_SECT":               adifFieldOfEnum(EnumARRLSection),
+	"MY_CITY":                    adifFieldOfType(ADIFString),
+	"MY_CITY_INTL":               adifFieldOfType(ADIFIntlString),
+	"MY_CNTY":                    adifFieldOfType(ADIFString),
+	"MY_COUNTRY":                 adifFieldOfType(ADIFString),
+	"MY_COUNTRY_INTL":            adifFieldOfType(ADIFIntlString),
+	"MY_CQ_ZONE":                 adifFieldInRange(ADIFPositiveInteger, 1, 40),
+	"MY_DXCC":                    adifFieldOfEnum(adifEnumDXCC),
+	"MY_FISTS":                   adifFieldOfType(ADIFPositiveInteger),
+	"MY_GRIDSQUARE":              adifFieldOfType(ADIFGridSquare),
+	"MY_GRIDSQUARE_EXT":          adifFieldOfType(ADIFGridSquareExt),
+	"MY_IOTA":                    adifFieldOfType(ADIFIOTARefNo),
+	"MY_IOTA_ISLAND_ID":          adifFieldOfType(ADIFPositiveInteger),
+	"MY_ITU_ZONE":                adifFieldInRange(ADIFPositiveInteger, 1, 90),
+	"MY_LAT":                     adifFieldOfType(ADIFLocation),
+	"MY_LON":                     adifFieldOfType(ADIFLocation),
+	"MY_NAME":                    adifFieldOfType(ADIFString),
+	"MY_NAME_INTL":               adifFieldOfType(ADIFIntlString),
+	"MY_POSTAL_CODE":             adifFieldOfType(ADIFString),
+	"MY_POSTAL_CODE_INTL":        adifFieldOfType(ADIFIntlString),
+	"MY_POTA_REF":                adifFieldOfType(ADIFPOTARefList),
+	"MY_RIG":                     adifFieldOfType(ADIFString),
+	"MY_RIG_INTL":                adifFieldOfType(ADIFIntlString),
+	"MY_SIG":                     adifFieldOfType(ADIFString),
+	"MY_SIG_INTL":                adifFieldOfType(ADIFIntlString),
+	"MY_SIG_INFO":                adifFieldOfType(ADIFString),
+	"MY_SIG_INFO_INTL":           adifFieldOfType(ADIFIntlString),
+	"MY_SOTA_REF":                adifFieldOfType(ADIFSOTARef),
+	"MY_STATE":                   adifFieldOfType(ADIFString),
+	"MY_STREET":                  adifFieldOfType(ADIFString),
+	"MY_STREET_INTL":             adifFieldOfType(ADIFIntlString),
+	"MY_USACA_COUNTIES":          adifFieldOfType(ADIFSecondarySubdivisionList),
+	"MY_VUCC_GRIDS":              adifFieldOfType(ADIFGridSquareList),
+	"MY_WWFF_REF":                adifFieldOfType(ADIFWWFFRef),
+	"NAME":                       adifFieldOfType(ADIFString),
+	"NAME_INTL":                  adifFieldOfType(ADIFIntlString),
+	"NOTES":                      adifFieldOfType(ADIFMultilineString),
+	"NOTES_INTL":                 adifFieldOfType(ADIFIntlMultilineString),
+	"NR_BURSTS":                  adifFieldNonNegative(ADIFInteger),
+	"NR_PINGS":                   adifFieldNonNegative(ADIFInteger),
+	"OPERATOR":                   adifFieldOfType(ADIFString),
+	"OWNER_CALLSIGN":             adifFieldOfType(ADIFString),
+	"PFX":                        adifFieldOfType(ADIFString),
+	"POTA_REF":                   adifFieldOfType(ADIFPOTARefList),
+	"PRECEDENCE":                 adifFieldOfType(ADIFString),
+	"PROP_MODE":                  adifFieldOfEnum(EnumPropagationMode),
+	"PUBLIC_KEY":                 adifFieldOfType(ADIFString),
+	"QRZCOM_QSO_UPLOAD_DATE":     adifFieldOfType(ADIFDate),
+	"QRZCOM_QSO_UPLOAD_STATUS":   adifFieldOfEnum(EnumQSOUploadStatus),
+	"QSLMSG":                     adifFieldOfType(ADIFMultilineString),
+	"QSLMSG_INTL":                adifFieldOfType(ADIFIntlMultilineString),
+	"QSLMSG_RCVD":                adifFieldOfType(ADIFMultilineString),
+	"QSLRDATE":                   adifFieldOfType(ADIFDate),
+	"QSLSDATE":                   adifFieldOfType(ADIFDate),
+	"QSL_RCVD":                   adifFieldOfEnum(EnumQSLRcvd),
+	"QSL_RCVD_VIA":               adifFieldOfEnum(EnumQSLVia),
+	"QSL_SENT":                   adifFieldOfEnum(EnumQSLSent),
+	"QSL_SENT_VIA":               adifFieldOfEnum(EnumQSLVia),
+	"QSL_VIA":                    adifFieldOfType(ADIFString),
+	"QSO_COMPLETE":               adifFieldOfEnum(EnumQSOComplete),
+	"QSO_DATE":                   adifFieldOfType(ADIFDate),
+	"QSO_DATE_OFF":               adifFieldOfType(ADIFDate),
+	"QSO_RANDOM":                 adifFieldOfType(ADIFBoolean),
+	"QTH":                        adifFieldOfType(ADIFString),
+	"QTH_INTL":                   adifFieldOfType(ADIFIntlString),
+	"REGION":                     adifFieldOfEnum(EnumRegion),
+	"RIG":                        adifFieldOfType(ADIFMultilineString),
+	"RIG_INTL":                   adifFieldOfType(ADIFIntlMultilineString),
+	"RST_RCVD":                   adifFieldOfType(ADIFString),
+	"RST_SENT":                   adifFieldOfType(ADIFString),
+	"RX_PWR":                     adifFieldNonNegative(ADIFNumber),
+	"SAT_MODE":                   adifFieldOfType(ADIFString),
+	"SAT_NAME":                   adifFieldOfType(ADIFString),
+	"SFI":                        adifFieldInRange(ADIFInteger, 0, 300),
+	"SIG":                        adifFieldOfType(ADIFString),
+	"SIG_INTL":                   adifFieldOfType(ADIFIntlString),
+	"SIG_INFO":                   adifFieldOfType(ADIFString),
+	"SIG_INFO_INTL":              adifFieldOfType(ADIFIntlString),
+	"SILENT_KEY":                 adifFieldOfType(ADIFBoolean),
+	"SKCC":                       adifFieldOfType(ADIFString),
+	"SOTA_REF":                   adifFieldOfType(ADIFSOTARef),
+	"SRX":                        adifFieldNonNegative(ADIFInteger),
+	"SRX_STRING":                 adifFieldOfType(ADIFString),
+	"STATE":                      adifFieldOfType(ADIFString),
+	"STATION_CALLSIGN":           adifFieldOfType(ADIFString),
+	"STX":                        adifFieldNonNegative(ADIFInteger),
+	"STX_STRING":                 adifFieldOfType(ADIFString),
+	"SUBMODE":                    adifFieldOfEnum(EnumSubmode),
+	"SWL":                        adifFieldOfType(ADIFBoolean),
+	"TEN_TEN":                    adifFieldOfType(ADIFPositiveInteger),
+	"TIME_OFF":                   adifFieldOfType(ADIFTime),
+	"TIME_ON":                    adifFieldOfType(ADIFTime),
+	"TX_PWR":                     adifFieldNonNegative(ADIFNumber),
+	"UKSMG":                      adifFieldOfType(ADIFPositiveInteger),
+	"USACA_COUNTIES":             adifFieldOfType(ADIFSecondarySubdivisionList),
+	"VUCC_GRIDS":                 adifFieldOfType(ADIFGridSquareList),
+	"WEB":                        adifFieldOfType(ADIFString),
+	"WWFF_REF":                   adifFieldOfType(ADIFWWFFRef),
+}
+
+func adifFieldOfType(t ADIFDataType) adifFieldSpec {
+	return adifFieldSpec{typ: t}
+}
+
+func adifFieldOfEnum(enum ADIFEnumeration) adifFieldSpec {
+	return adifFieldSpec{typ: ADIFEnumerationType, enum: enum}
+}
+
+func adifFieldInRange(t ADIFDataType, lower, upper float64) adifFieldSpec {
+	return adifFieldSpec{typ: t, hasRange: true, min: lower, max: upper}
+}
+
+// adifFieldNonNegative is a numeric field that must not be negative.
+func adifFieldNonNegative(t ADIFDataType) adifFieldSpec {
+	return adifFieldInRange(t, 0, math.Inf(1))
+}
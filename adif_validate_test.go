@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateADIFValue(t *testing.T) {
+	valid := map[ADIFDataType][]string{
+		ADIFBoolean:                  {"Y", "n"},
+		ADIFCharacter:                {"A", " "},
+		ADIFIntlCharacter:            {"ë", "日"},
+		ADIFDate:                     {"19300101", "20240229"},
+		ADIFTime:                     {"0000", "235959"},
+		ADIFDigit:                    {"0", "9"},
+		ADIFInteger:                  {"0", "-12", "0042"},
+		ADIFNumber:                   {"14.074", "-0.5", ".5", "7.", "100"},
+		ADIFPositiveInteger:          {"1", "0040"},
+		ADIFString:                   {"W1AW", "a ~ b"},
+		ADIFIntlString:               {"Zoë & 日本"},
+		ADIFMultilineString:          {"line 1\r\nline 2"},
+		ADIFIntlMultilineString:      {"Zoë\r\n日本"},
+		ADIFEnumerationType:          {"ANYTHING"},
+		ADIFGridSquare:               {"IO", "IO91", "io91wm", "IO91WM45"},
+		ADIFGridSquareExt:            {"AX", "ax09"},
+		ADIFGridSquareList:           {"FN31,FN32", "FN31pr"},
+		ADIFLocation:                 {"N051 30.000", "W071 30.500"},
+		ADIFSponsoredAward:           {"ARRL_DXCC", "CQ_WAZ_MIXED"},
+		ADIFSponsoredAwardList:       {"ARRL_DXCC,ADIF_CENTURY_BASIC"},
+		ADIFSOTARef:                  {"G/LD-001", "W7W/KG-114"},
+		ADIFPOTARef:                  {"US-1234", "K-0001", "GB-0001@GB-ENG"},
+		ADIFPOTARefList:              {"US-1234,US-1235"},
+		ADIFWWFFRef:                  {"GFF-0001"},
+		ADIFIOTARefNo:                {"EU-005", "na-001"},
+		ADIFCreditList:               {"IOTA", "dxcc_band,WAS:LOTW&card", "CQWAZ_MIXED:EQSL"},
+		ADIFSecondarySubdivisionList: {"MA,Franklin", "MA,Franklin:MA,Hampshire"},
+	}
+	for typ, values := range valid {
+		for _, v := range values {
+			if err := ValidateADIFValue(typ, v); err != nil {
+				t.Fatalf("%s %q: unexpected error %v", typ, v, err)
+			}
+		}
+	}
+
+	invalid := map[ADIFDataType][]string{
+		ADIFBoolean:                  {"T", "yes"},
+		ADIFCharacter:                {"AB", "ë", "\n"},
+		ADIFIntlCharacter:            {"ab", "\n"},
+		ADIFDate:                     {"19291231", "20230229", "2024-01-01", " 20240101"},
+		ADIFTime:                     {"2400", "12:00", "120", " 1200"},
+		ADIFDigit:                    {"10", "a"},
+		ADIFInteger:                  {"1.5", "+1", "1e3", "-"},
+		ADIFNumber:                   {"1e6", "+1", "1,5", ".", "-"},
+		ADIFPositiveInteger:          {"0", "000", "-1", "1.0"},
+		ADIFString:                   {"Zoë", "a\r\nb", "tab\t"},
+		ADIFIntlString:               {"a\nb", "\xff"},
+		ADIFMultilineString:          {"a\nb", "a\rb", "a\r", "Zoë"},
+		ADIFIntlMultilineString:      {"a\n", "\xff"},
+		ADIFGridSquare:               {"IO9", "SS00", "IO91WM4A"},
+		ADIFGridSquareExt:            {"A", "YY", "AX0"},
+		ADIFGridSquareList:           {"FN31,", "FN31, FN32"},
+		ADIFLocation:                 {"51.5", "N091 00.000", "N051 30.0"},
+		ADIFSponsoredAward:           {"DXCC", "FOO_DXCC", "ARRL_"},
+		ADIFSponsoredAwardList:       {"ARRL_DXCC,DXCC"},
+		ADIFSOTARef:                  {"G/LD-1", "GLD001"},
+		ADIFPOTARef:                  {"US-12", "US-1234,US-1235"},
+		ADIFPOTARefList:              {"US-1234, US-1235", "US-1234,"},
+		ADIFWWFFRef:                  {"G-0001"},
+		ADIFIOTARefNo:                {"EU5", "EU-5", "XX-001", "EU-000"},
+		ADIFCreditList:               {"DXCC,", "ARRL_DXCC", "DXCC:QSL", "DXCC:CARD&", "DXCC, WAS"},
+		ADIFSecondarySubdivisionList: {"Franklin", "MA,Franklin:", "MA,", "MA,Zoë"},
+		ADIFDataType("Bogus"):        {"x"},
+	}
+	for typ, values := range invalid {
+		for _, v := range values {
+			err := ValidateADIFValue(typ, v)
+			var fe *ADIFFieldError
+			if !errors.As(err, &fe) || !errors.Is(err, ErrADIFFieldValue) || fe.Reason == emptyString {
+				t.Fatalf("%s %q: expected an *ADIFFieldError, got %v", typ, v, err)
+			}
+		}
+	}
+
+	if err := ValidateADIFValue(ADIFDate, emptyString); err != nil {
+		t.Fatalf("empty value: unexpected error %v", err)
+	}
+}
+
+func TestValidateADIFField(t *testing.T) {
+	valid := []ADIFField{
+		{Name: "call", Value: "W1AW"},
+		{Name: "BAND", Value: "20M"},
+		{Name: "MODE", Value: "ft8"},
+		{Name: "CQZ", Value: "40"},
+		{Name: "DXCC", Value: "291"},
+		{Name: "DXCC", Value: "0"},
+		{Name: "SRX", Value: "0"},
+		{Name: "QSO_DATE", Value: emptyString},
+		{Name: "APP_LOGGER_X", Value: "anything"},
+		{Name: "APP_LOGGER_N", Value: "12.5", Type: "N"},
+	}
+	for _, f := range valid {
+		if err := ValidateADIFField(f); err != nil {
+			t.Fatalf("%+v: unexpected error %v", f, err)
+		}
+	}
+
+	tests := []struct {
+		field ADIFField
+		want  error
+	}{
+		{ADIFField{Name: "BAND", Value: "21m"}, ErrADIFEnumUnknown},
+		{ADIFField{Name: "QSL_RCVD", Value: "V"}, ErrADIFEnumDeprecated},
+		{ADIFField{Name: "DXCC", Value: "999"}, ErrADIFEnumUnknown},
+		{ADIFField{Name: "CQZ", Value: "41"}, ErrADIFFieldValue},
+		{ADIFField{Name: "ANT_EL", Value: "-91"}, ErrADIFFieldValue},
+		{ADIFField{Name: "TX_PWR", Value: "-5"}, ErrADIFFieldValue},
+		{ADIFField{Name: "TIME_ON", Value: "12:00"}, ErrADIFFieldValue},
+		{ADIFField{Name: "APP_LOGGER_N", Value: "x", Type: "N"}, ErrADIFFieldValue},
+	}
+	for _, tt := range tests {
+		err := ValidateADIFField(tt.field)
+		var fe *ADIFFieldError
+		if !errors.Is(err, tt.want) || !errors.As(err, &fe) || fe.Field != tt.field.Name {
+			t.Fatalf("%+v: expected %v, got %v", tt.field, tt.want, err)
+		}
+	}
+
+	err := ValidateADIFField(ADIFField{Name: "cqz", Value: "41"})
+	if err == nil || err.Error() != `CQZ: invalid PositiveInteger "41": outside the range 1 to 40` {
+		t.Fatalf("unexpected message %v", err)
+	}
+}
+
+func TestValidateADIFField_UserDefs(t *testing.T) {
+	defs := []ADIFUserDef{
+		{ID: 1, Name: "SWEATERSIZE", Type: "E", Enum: []string{"S", "M", "L"}},
+		{ID: 2, Name: "SHOESIZE", Type: "N", HasRange: true, Min: 5, Max: 20},
+		{ID: 3, Name: "EPC", Type: "N"},
+	}
+	for _, f := range []ADIFField{{Name: "SweaterSize", Value: "m"}, {Name: "SHOESIZE", Value: "9.5"}, {Name: "EPC", Value: "12"}} {
+		if err := ValidateADIFField(f, defs...); err != nil {
+			t.Fatalf("%+v: unexpected error %v", f, err)
+		}
+	}
+	for _, f := range []ADIFField{{Name: "SWEATERSIZE", Value: "XL"}, {Name: "SHOESIZE", Value: "21"}, {Name: "EPC", Value: "twelve"}} {
+		if err := ValidateADIFField(f, defs...); err == nil {
+			t.Fatalf("%+v: expected an error", f)
+		}
+	}
+}
+
+func TestValidateADIFRecord(t *testing.T) {
+	rec := ADIFRecord{Fields: []ADIFField{
+		{Name: "CALL", Value: "W1AW"},
+		{Name: "QSO_DATE", Value: "20241301"},
+		{Name: "TIME_ON", Value: "1200"},
+		{Name: "GRIDSQUARE", Value: "FN3"},
+	}}
+	errs := ValidateADIFRecord(rec)
+	if len(errs) != 2 || errs[0].Field != "QSO_DATE" || errs[1].Field != "GRIDSQUARE" || errs[1].Type != ADIFGridSquare {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+	if errs := ValidateADIFRecord(ADIFRecord{Fields: rec.Fields[:1]}); errs != nil {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
+
+func TestADIFFieldType(t *testing.T) {
+	if typ, enum, ok := ADIFFieldType("qsl_sent_via"); !ok || typ != ADIFEnumerationType || enum != EnumQSLVia {
+		t.Fatalf("unexpected %v %v %v", typ, enum, ok)
+	}
+	if typ, _, ok := ADIFFieldType("POTA_REF"); !ok || typ != ADIFPOTARefList {
+		t.Fatalf("unexpected %v %v", typ, ok)
+	}
+	if _, _, ok := ADIFFieldType("APP_X"); ok {
+		t.Fatal("APP_X should not be defined")
+	}
+	for _, f := range []ADIFField{{Name: "CREDIT_GRANTED", Value: "DXCC:POSTCARD"}, {Name: "MY_USACA_COUNTIES", Value: "Franklin"}} {
+		if err := ValidateADIFField(f); !errors.Is(err, ErrADIFFieldValue) {
+			t.Fatalf("%s %q: expected ErrADIFFieldValue, got %v", f.Name, f.Value, err)
+		}
+	}
+	if err := ValidateADIFField(ADIFField{Name: "USACA_COUNTIES", Value: "MA,Franklin:MA,Hampshire"}); err != nil {
+		t.Fatal(err)
+	}
+	if typ, ok := ADIFDataTypeForIndicator("g"); !ok || typ != ADIFIntlMultilineString {
+		t.Fatalf("unexpected %v %v", typ, ok)
+	}
+}
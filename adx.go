@@ -0,0 +1,424 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrADXStructure = errors.New("invalid ADX document structure")
+
+// ADXReader reads ADIF records from an ADX (ADIF XML) document one at a time, mapping them to the
+// same model as ADIReader:
+//   - <APP PROGRAMID="P" FIELDNAME="F" TYPE="T"> becomes field APP_P_F with data type T;
+//   - a record's <USERDEF FIELDNAME="F"> becomes field F;
+//   - header <USERDEF FIELDID="n" TYPE="T" ENUM=".." RANGE=".."> entries become ADIFHeader.UserDefs.
+//
+// Field names are upper-cased. The document structure is checked as it is read: an ADX root
+// holding an optional HEADER followed by RECORDS, whose RECORD elements hold only text-valued
+// field elements.
+type ADXReader struct {
+	d       *xml.Decoder
+	started bool
+	done    bool
+	header  ADIFHeader
+	err     error
+}
+
+// NewADXReader returns a reader that parses an ADX document from r.
+func NewADXReader(r io.Reader) *ADXReader {
+	return &ADXReader{d: xml.NewDecoder(r)}
+}
+
+// Header returns the document header, which is empty if the document has none.
+func (ar *ADXReader) Header() (ADIFHeader, error) {
+	if err := ar.start(); err != nil {
+		return ADIFHeader{}, err
+	}
+	return ar.header, nil
+}
+
+// Next returns the next record. It returns io.EOF after the last record.
+func (ar *ADXReader) Next() (ADIFRecord, error) {
+	if err := ar.start(); err != nil {
+		return ADIFRecord{}, err
+	}
+	if ar.done {
+		return ADIFRecord{}, io.EOF
+	}
+	tok, err := ar.nextElement()
+	if err != nil {
+		return ADIFRecord{}, ar.fail(err)
+	}
+	switch t := tok.(type) {
+	case xml.StartElement:
+		if !strings.EqualFold(t.Name.Local, "RECORD") {
+			return ADIFRecord{}, ar.fail(ar.structureError("unexpected <%s> in RECORDS", t.Name.Local))
+		}
+		var rec ADIFRecord
+		if err := ar.readFields(&rec.Fields, false, nil); err != nil {
+			return ADIFRecord{}, ar.fail(err)
+		}
+		return rec, nil
+	case xml.EndElement:
+		// </RECORDS>; the document must then close.
+		if err := ar.expectEnd("ADX"); err != nil {
+			return ADIFRecord{}, ar.fail(err)
+		}
+		ar.done = true
+		return ADIFRecord{}, io.EOF
+	}
+	return ADIFRecord{}, ar.fail(ar.structureError("unexpected token"))
+}
+
+func (ar *ADXReader) fail(err error) error {
+	ar.err = err
+	return err
+}
+
+// start reads up to and including the opening RECORDS tag, parsing the header on the way.
+func (ar *ADXReader) start() error {
+	if ar.started {
+		return ar.err
+	}
+	ar.started = true
+
+	tok, err := ar.nextElement()
+	if err != nil {
+		return ar.fail(err)
+	}
+	if t, ok := tok.(xml.StartElement); !ok || !strings.EqualFold(t.Name.Local, "ADX") {
+		return ar.fail(ar.structureError("root element is not ADX"))
+	}
+
+	tok, err = ar.nextElement()
+	if err != nil {
+		return ar.fail(err)
+	}
+	if _, ok := tok.(xml.EndElement); ok {
+		ar.done = true
+		return nil
+	}
+	t := tok.(xml.StartElement)
+	if strings.EqualFold(t.Name.Local, "HEADER") {
+		if err := ar.readFields(&ar.header.Fields, true, &ar.header.UserDefs); err != nil {
+			return ar.fail(err)
+		}
+		if tok, err = ar.nextElement(); err != nil {
+			return ar.fail(err)
+		}
+		if _, ok := tok.(xml.EndElement); ok {
+			ar.done = true
+			return nil
+		}
+		t = tok.(xml.StartElement)
+	}
+	if !strings.EqualFold(t.Name.Local, "RECORDS") {
+		return ar.fail(ar.structureError("unexpected <%s> in ADX", t.Name.Local))
+	}
+	return nil
+}
+
+// readFields reads the field elements of a HEADER or RECORD up to its closing tag.
+func (ar *ADXReader) readFields(fields *[]ADIFField, header bool, userDefs *[]ADIFUserDef) error {
+	for {
+		tok, err := ar.nextElement()
+		if err != nil {
+			return err
+		}
+		el, ok := tok.(xml.StartElement)
+		if !ok {
+			return nil
+		}
+		value, err := ar.readText(el.Name.Local)
+		if err != nil {
+			return err
+		}
+		name := strings.ToUpper(el.Name.Local)
+		attr := func(n string) string {
+			for _, a := range el.Attr {
+				if strings.EqualFold(a.Name.Local, n) {
+					return a.Value
+				}
+			}
+			return emptyString
+		}
+
+		f := ADIFField{Name: name, Value: value}
+		switch name {
+		case "APP":
+			program, field := attr("PROGRAMID"), attr("FIELDNAME")
+			if program == emptyString || field == emptyString {
+				return ar.structureError("APP without PROGRAMID or FIELDNAME")
+			}
+			f.Name = strings.ToUpper("APP_" + program + "_" + field)
+			f.Type = strings.ToUpper(attr("TYPE"))
+		case "USERDEF":
+			if header {
+				u, err := ar.headerUserDef(value, attr)
+				if err != nil {
+					return err
+				}
+				*userDefs = append(*userDefs, u)
+				continue
+			}
+			if f.Name = strings.ToUpper(attr("FIELDNAME")); f.Name == emptyString {
+				return ar.structureError("USERDEF without FIELDNAME")
+			}
+		}
+		if !validADIFFieldName(f.Name) {
+			return fmt.Errorf("%w: %q", ErrADIFFieldName, f.Name)
+		}
+		*fields = append(*fields, f)
+	}
+}
+
+func (ar *ADXReader) headerUserDef(name string, attr func(string) string) (ADIFUserDef, error) {
+	id, err := strconv.Atoi(attr("FIELDID"))
+	if err != nil || id < 1 {
+		return ADIFUserDef{}, fmt.Errorf("%w: FIELDID %q", ErrADIFUserDef, attr("FIELDID"))
+	}
+	value := name
+	if spec := attr("ENUM") + attr("RANGE"); spec != emptyString {
+		value += "," + spec
+	}
+	return parseADIFUserDef(id, attr("TYPE"), value)
+}
+
+// readText returns the character data of a field element, which must not contain elements.
+func (ar *ADXReader) readText(name string) (string, error) {
+	var sb strings.Builder
+	for {
+		tok, err := ar.d.Token()
+		if err != nil {
+			return emptyString, ar.tokenError(err)
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			sb.Write(t)
+		case xml.StartElement:
+			return emptyString, ar.structureError("element <%s> inside field <%s>", t.Name.Local, name)
+		case xml.EndElement:
+			return sb.String(), nil
+		}
+	}
+}
+
+// nextElement returns the next start or end element, skipping whitespace, comments and processing
+// instructions. Other character data is a structure error.
+func (ar *ADXReader) nextElement() (xml.Token, error) {
+	for {
+		tok, err := ar.d.Token()
+		if err != nil {
+			return nil, ar.tokenError(err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement, xml.EndElement:
+			return t, nil
+		case xml.CharData:
+			if strings.TrimSpace(string(t)) != emptyString {
+				return nil, ar.structureError("unexpected text %q", strings.TrimSpace(string(t)))
+			}
+		}
+	}
+}
+
+func (ar *ADXReader) expectEnd(name string) error {
+	tok, err := ar.nextElement()
+	if err != nil {
+		return err
+	}
+	if t, ok := tok.(xml.EndElement); !ok || !strings.EqualFold(t.Name.Local, name) {
+		return ar.structureError("expected </%s>", name)
+	}
+	return nil
+}
+
+func (ar *ADXReader) tokenError(err error) error {
+	if errors.Is(err, io.EOF) {
+		return ar.structureError("unexpected end of document")
+	}
+	var se *xml.SyntaxError
+	if errors.As(err, &se) {
+		return fmt.Errorf("%w: line %d: %s", ErrADXStructure, se.Line, se.Msg)
+	}
+	return err
+}
+
+func (ar *ADXReader) structureError(format string, args ...any) error {
+	line, _ := ar.d.InputPos()
+	return fmt.Errorf("%w: line %d: %s", ErrADXStructure, line, fmt.Sprintf(format, args...))
+}
+
+// ADXWriter writes ADIF records as an ADX document. Fields named APP_PROGRAMID_FIELDNAME are
+// written as APP elements and fields declared by a header USERDEF as USERDEF elements. Data type
+// indicators are only kept on APP fields, since ADX has no other place for them, and the header
+// preamble is not written. Output is buffered; Close completes the document and flushes it.
+type ADXWriter struct {
+	w        *bufio.Writer
+	userDefs map[string]bool
+	state    int
+}
+
+// ADXWriter states.
+const (
+	adxNew = iota
+	adxHeader
+	adxRecords
+	adxClosed
+)
+
+// NewADXWriter returns a writer that writes an ADX document to w.
+func NewADXWriter(w io.Writer) *ADXWriter {
+	return &ADXWriter{w: bufio.NewWriter(w), userDefs: make(map[string]bool)}
+}
+
+// WriteHeader writes the header, which must come before any record.
+func (aw *ADXWriter) WriteHeader(h ADIFHeader) error {
+	if aw.state != adxNew {
+		return fmt.Errorf("%w: header written after records", ErrADXStructure)
+	}
+	if err := aw.checkFields(h.Fields); err != nil {
+		return err
+	}
+	aw.open()
+	aw.state = adxHeader
+	aw.w.WriteString("  <HEADER>\n")
+	for _, f := range h.Fields {
+		aw.writeField("    ", f)
+	}
+	for _, u := range h.UserDefs {
+		aw.userDefs[strings.ToUpper(u.Name)] = true
+		aw.w.WriteString(`    <USERDEF FIELDID="` + strconv.Itoa(u.ID) + `"`)
+		if u.Type != emptyString {
+			aw.writeAttr("TYPE", u.Type)
+		}
+		if spec, hasSpec := strings.CutPrefix(u.value(), u.Name+","); hasSpec {
+			if u.HasRange {
+				aw.writeAttr("RANGE", spec)
+			} else {
+				aw.writeAttr("ENUM", spec)
+			}
+		}
+		aw.w.WriteByte('>')
+		xml.EscapeText(aw.w, []byte(u.Name))
+		aw.w.WriteString("</USERDEF>\n")
+	}
+	_, err := aw.w.WriteString("  </HEADER>\n")
+	return err
+}
+
+// Write writes one record. A record with a field name that cannot be written is rejected before
+// any of it is written.
+func (aw *ADXWriter) Write(rec ADIFRecord) error {
+	if aw.state == adxClosed {
+		return fmt.Errorf("%w: record written after Close", ErrADXStructure)
+	}
+	if err := aw.checkFields(rec.Fields); err != nil {
+		return err
+	}
+	aw.openRecords()
+	aw.w.WriteString("    <RECORD>\n")
+	for _, f := range rec.Fields {
+		aw.writeField("      ", f)
+	}
+	_, err := aw.w.WriteString("    </RECORD>\n")
+	return err
+}
+
+// Close writes the closing tags and flushes the document. It does not close the underlying writer.
+func (aw *ADXWriter) Close() error {
+	if aw.state == adxClosed {
+		return nil
+	}
+	aw.openRecords()
+	aw.state = adxClosed
+	aw.w.WriteString("  </RECORDS>\n</ADX>\n")
+	return aw.w.Flush()
+}
+
+func (aw *ADXWriter) open() {
+	aw.w.WriteString(xml.Header)
+	aw.w.WriteString("<ADX>\n")
+}
+
+func (aw *ADXWriter) openRecords() {
+	if aw.state == adxNew {
+		aw.open()
+	}
+	if aw.state != adxRecords {
+		aw.state = adxRecords
+		aw.w.WriteString("  <RECORDS>\n")
+	}
+}
+
+// checkFields returns an ErrADIFFieldName error for the first field that cannot be written as an
+// ADX element.
+func (aw *ADXWriter) checkFields(fields []ADIFField) error {
+	if err := checkADIFFieldNames(fields); err != nil {
+		return err
+	}
+	for _, f := range fields {
+		name := strings.ToUpper(f.Name)
+		switch {
+		case strings.HasPrefix(name, "APP_"):
+			program, field, ok := strings.Cut(name[len("APP_"):], "_")
+			if !ok || program == emptyString || field == emptyString {
+				return fmt.Errorf("%w: %q is not APP_PROGRAMID_FIELDNAME", ErrADIFFieldName, f.Name)
+			}
+		case aw.userDefs[name]:
+		case !validADXElementName(name):
+			return fmt.Errorf("%w: %q is not a valid ADX element name", ErrADIFFieldName, f.Name)
+		}
+	}
+	return nil
+}
+
+// writeField writes a field that checkFields accepted.
+func (aw *ADXWriter) writeField(indent string, f ADIFField) {
+	name := strings.ToUpper(f.Name)
+	aw.w.WriteString(indent)
+	element := name
+	switch {
+	case strings.HasPrefix(name, "APP_"):
+		program, field, _ := strings.Cut(name[len("APP_"):], "_")
+		element = "APP"
+		aw.w.WriteString("<APP")
+		aw.writeAttr("PROGRAMID", program)
+		aw.writeAttr("FIELDNAME", field)
+		if f.Type != emptyString {
+			aw.writeAttr("TYPE", strings.ToUpper(f.Type))
+		}
+		aw.w.WriteByte('>')
+	case aw.userDefs[name]:
+		element = "USERDEF"
+		aw.w.WriteString("<USERDEF")
+		aw.writeAttr("FIELDNAME", name)
+		aw.w.WriteByte('>')
+	default:
+		aw.w.WriteString("<" + name + ">")
+	}
+	xml.EscapeText(aw.w, []byte(f.Value))
+	aw.w.WriteString("</" + element + ">\n")
+}
+
+func (aw *ADXWriter) writeAttr(name, value string) {
+	aw.w.WriteString(" " + name + `="`)
+	xml.EscapeText(aw.w, []byte(value))
+	aw.w.WriteByte('"')
+}
+
+// validADXElementName reports whether an ADIF field name can be used as an XML element name.
+func validADXElementName(name string) bool {
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !isUpperLetter(c) && c != '_' && (i == 0 || !isDigit(c) && c != '-' && c != '.') {
+			return false
+		}
+	}
+	return name != emptyString
+}
@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testADX = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- exported for testing -->
+<ADX>
+  <HEADER>
+    <ADIF_VER>3.1.4</ADIF_VER>
+    <PROGRAMID>Test</PROGRAMID>
+    <USERDEF FIELDID="1" TYPE="N">EPC</USERDEF>
+    <USERDEF FIELDID="2" TYPE="E" ENUM="{S,M,L}">SWEATERSIZE</USERDEF>
+    <USERDEF FIELDID="3" TYPE="N" RANGE="{5:20}">SHOESIZE</USERDEF>
+  </HEADER>
+  <RECORDS>
+    <RECORD>
+      <CALL>W1AW</CALL>
+      <QSO_DATE>20240101</QSO_DATE>
+      <NAME_INTL>Zoë &amp; 日本</NAME_INTL>
+      <APP PROGRAMID="MONOLOG" FIELDNAME="Compression" TYPE="s">off</APP>
+      <USERDEF FIELDNAME="SweaterSize">M</USERDEF>
+    </RECORD>
+    <RECORD>
+      <call>G4ABC</call>
+    </RECORD>
+  </RECORDS>
+</ADX>
+`
+
+func readAllADX(t *testing.T, s string) (ADIFHeader, []ADIFRecord) {
+	t.Helper()
+	r := NewADXReader(strings.NewReader(s))
+	h, err := r.Header()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var recs []ADIFRecord
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+	return h, recs
+}
+
+func TestADXReader(t *testing.T) {
+	h, recs := readAllADX(t, testADX)
+	if v, _ := h.Get("ADIF_VER"); v != "3.1.4" || len(h.Fields) != 2 {
+		t.Fatalf("unexpected header %+v", h)
+	}
+	want := []ADIFUserDef{
+		{ID: 1, Name: "EPC", Type: "N"},
+		{ID: 2, Name: "SWEATERSIZE", Type: "E", Enum: []string{"S", "M", "L"}},
+		{ID: 3, Name: "SHOESIZE", Type: "N", HasRange: true, Min: 5, Max: 20},
+	}
+	if !reflect.DeepEqual(h.UserDefs, want) {
+		t.Fatalf("unexpected USERDEFs %+v", h.UserDefs)
+	}
+
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(recs))
+	}
+	wantFields := []ADIFField{
+		{Name: "CALL", Value: "W1AW"},
+		{Name: "QSO_DATE", Value: "20240101"},
+		{Name: "NAME_INTL", Value: "Zoë & 日本"},
+		{Name: "APP_MONOLOG_COMPRESSION", Value: "off", Type: "S"},
+		{Name: "SWEATERSIZE", Value: "M"},
+	}
+	if !reflect.DeepEqual(recs[0].Fields, wantFields) {
+		t.Fatalf("unexpected record %+v", recs[0])
+	}
+	if recs[1].Value("CALL") != "G4ABC" {
+		t.Fatalf("unexpected record %+v", recs[1])
+	}
+}
+
+func TestADXReader_Minimal(t *testing.T) {
+	h, recs := readAllADX(t, "<ADX><RECORDS><RECORD><CALL>W1AW</CALL></RECORD></RECORDS></ADX>")
+	if len(h.Fields) != 0 || len(recs) != 1 {
+		t.Fatalf("unexpected %+v %+v", h, recs)
+	}
+	if _, recs := readAllADX(t, "<ADX><HEADER></HEADER></ADX>"); len(recs) != 0 {
+		t.Fatalf("unexpected %+v", recs)
+	}
+}
+
+func TestADXReader_Errors(t *testing.T) {
+	bad := []string{
+		"",
+		"<ADIF></ADIF>",
+		"<ADX><RECORD><CALL>W1AW</CALL></RECORD></ADX>",
+		"<ADX><RECORDS><CALL>W1AW</CALL></RECORDS></ADX>",
+		"<ADX><RECORDS><RECORD><CALL><X/></CALL></RECORD></RECORDS></ADX>",
+		"<ADX><RECORDS><RECORD><CALL>W1AW</CALL></RECORD>",
+		"<ADX><RECORDS><RECORD><CALL>W1AW</RECORD></RECORDS></ADX>",
+		"<ADX><RECORDS>text<RECORD></RECORD></RECORDS></ADX>",
+		"<ADX><RECORDS><RECORD><APP FIELDNAME=\"X\">1</APP></RECORD></RECORDS></ADX>",
+		"<ADX><RECORDS><RECORD><USERDEF>1</USERDEF></RECORD></RECORDS></ADX>",
+		"<ADX><HEADER><USERDEF TYPE=\"N\">X</USERDEF></HEADER><RECORDS></RECORDS></ADX>",
+		"<ADX><HEADER></HEADER><HEADER></HEADER></ADX>",
+	}
+	for _, in := range bad {
+		r := NewADXReader(strings.NewReader(in))
+		var err error
+		for err == nil {
+			_, err = r.Next()
+		}
+		if !errors.Is(err, ErrADXStructure) && !errors.Is(err, ErrADIFUserDef) {
+			t.Fatalf("%q: expected a structure error, got %v", in, err)
+		}
+	}
+}
+
+func TestADXWriter(t *testing.T) {
+	h, recs := readAllADX(t, testADX)
+
+	var buf bytes.Buffer
+	w := NewADXWriter(&buf)
+	if err := w.WriteHeader(h); err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, s := range []string{
+		`<USERDEF FIELDID="2" TYPE="E" ENUM="{S,M,L}">SWEATERSIZE</USERDEF>`,
+		`<APP PROGRAMID="MONOLOG" FIELDNAME="COMPRESSION" TYPE="S">off</APP>`,
+		`<USERDEF FIELDNAME="SWEATERSIZE">M</USERDEF>`,
+		`<NAME_INTL>Zoë &amp; 日本</NAME_INTL>`,
+	} {
+		if !strings.Contains(out, s) {
+			t.Fatalf("output missing %s:\n%s", s, out)
+		}
+	}
+
+	h2, recs2 := readAllADX(t, out)
+	if !reflect.DeepEqual(h2, h) || !reflect.DeepEqual(recs2, recs) {
+		t.Fatalf("round trip failed:\n%+v\n%+v", h2, recs2)
+	}
+
+	if err := w.Write(recs[0]); !errors.Is(err, ErrADXStructure) {
+		t.Fatalf("expected ErrADXStructure after Close, got %v", err)
+	}
+}
+
+func TestADXWriter_NoHeader(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewADXWriter(&buf)
+	if err := w.Write(ADIFRecord{Fields: []ADIFField{{Name: "CALL", Value: "W1AW"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteHeader(ADIFHeader{}); !errors.Is(err, ErrADXStructure) {
+		t.Fatalf("expected ErrADXStructure for a late header, got %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, recs := readAllADX(t, buf.String()); len(recs) != 1 {
+		t.Fatalf("unexpected %+v", recs)
+	}
+
+	bad := []ADIFField{{Name: "APP_NOFIELD", Value: "x"}, {Name: "1CALL", Value: "x"}, {Name: "MY CALL", Value: "x"}}
+	for _, f := range bad {
+		buf.Reset()
+		w := NewADXWriter(&buf)
+		rec := ADIFRecord{Fields: []ADIFField{{Name: "CALL", Value: "W1AW"}, f}}
+		if err := w.Write(rec); !errors.Is(err, ErrADIFFieldName) {
+			t.Fatalf("%s: expected ErrADIFFieldName, got %v", f.Name, err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if _, recs := readAllADX(t, buf.String()); len(recs) != 0 {
+			t.Fatalf("%s: rejected record was written: %q", f.Name, buf.String())
+		}
+	}
+}
+
+func TestADXToADIRoundTrip(t *testing.T) {
+	_, recs := readAllADX(t, testADX)
+	var buf bytes.Buffer
+	w := NewADIWriter(&buf)
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	_, back := readAllADI(t, buf.String())
+	if !reflect.DeepEqual(back, recs) {
+		t.Fatalf("ADX -> ADI round trip failed:\n%+v\n%+v", back, recs)
+	}
+}
@@ -0,0 +1,320 @@
+package utils
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrAwardRefInvalid     = errors.New("invalid award reference")
+	ErrAwardRefNotFound    = errors.New("award reference not found in reference list")
+	ErrAwardRefUnsupported = errors.New("unsupported award programme")
+	ErrAwardRefListFormat  = errors.New("invalid award reference list format")
+)
+
+// AwardProgramme names an award programme whose references are logged in ADIF SIG/SIG_INFO or in
+// a dedicated field such as SOTA_REF.
+type AwardProgramme string
+
+const (
+	AwardIOTA AwardProgramme = "IOTA"
+	AwardSOTA AwardProgramme = "SOTA"
+	AwardPOTA AwardProgramme = "POTA"
+	AwardWWFF AwardProgramme = "WWFF"
+	AwardWAB  AwardProgramme = "WAB"
+)
+
+// AwardReference is a parsed award reference. Ref is the canonical form ("EU-005", "G/LD-001",
+// "US-1234", "GFF-0001", "SU01"). Prefix is the part identifying where the reference lies: the IOTA
+// continent, SOTA association, POTA or WWFF programme prefix, or WAB 100 km grid letters. Region
+// is the SOTA region and Number the numeric part (0 for WAB). Location is the optional POTA
+// location suffix ("US-ME" in "US-0001@US-ME").
+type AwardReference struct {
+	Programme AwardProgramme
+	Ref       string
+	Prefix    string
+	Region    string
+	Number    int
+	Location  string
+}
+
+var (
+	iotaRefRe = regexp.MustCompile(`^(AF|AN|AS|EU|NA|OC|SA)[- ]?([0-9]{1,3})$`)
+	sotaRefRe = regexp.MustCompile(`^([A-Z0-9]{1,4})/([A-Z0-9]{2})-([0-9]{3})$`)
+	potaRefRe = regexp.MustCompile(`^([A-Z0-9]{1,4})-([0-9]{4,5})(?:@([A-Z0-9]{2,4}(?:-[A-Z0-9]{1,3})?))?$`)
+	wwffRefRe = regexp.MustCompile(`^([A-Z0-9]{1,4}FF)-?([0-9]{4})$`)
+	wabRefRe  = regexp.MustCompile(`^([A-HJ-Z]{1,2})([0-9]{2})$`)
+)
+
+// potaLegacyPrefixes maps programme prefixes POTA has since renamed to their current form.
+var potaLegacyPrefixes = map[string]string{
+	"K":  "US",
+	"VE": "CA",
+}
+
+// adifAwardFields maps ADIF fields holding a single programme's references to that programme.
+var adifAwardFields = map[string]AwardProgramme{
+	"IOTA":        AwardIOTA,
+	"MY_IOTA":     AwardIOTA,
+	"SOTA_REF":    AwardSOTA,
+	"MY_SOTA_REF": AwardSOTA,
+	"POTA_REF":    AwardPOTA,
+	"MY_POTA_REF": AwardPOTA,
+	"WWFF_REF":    AwardWWFF,
+	"MY_WWFF_REF": AwardWWFF,
+}
+
+// AwardProgrammeForADIFField returns the programme whose references an ADIF field holds, e.g.
+// AwardSOTA for SOTA_REF and MY_SOTA_REF.
+func AwardProgrammeForADIFField(field string) (AwardProgramme, bool) {
+	p, ok := adifAwardFields[strings.ToUpper(strings.TrimSpace(field))]
+	return p, ok
+}
+
+// ParseAwardReference parses and canonicalises a reference for one of the supported programmes:
+//   - IOTA: continent and group number, "EU-005" (also accepts "eu5" and "EU 5");
+//   - SOTA: association, region and summit number, "G/LD-001";
+//   - POTA: programme prefix and park number, "US-1234", with an optional location "@US-ME";
+//     the legacy prefixes K and VE become US and CA ("K-1234" gives "US-1234");
+//   - WWFF: programme prefix ending in FF and a four-digit number, "GFF-0001";
+//   - WAB: Worked All Britain square, two Ordnance Survey letters ("SU01") or one Irish grid letter
+//     ("J45") and two digits.
+func ParseAwardReference(p AwardProgramme, ref string) (AwardReference, error) {
+	ref = strings.ToUpper(strings.TrimSpace(ref))
+	invalid := fmt.Errorf("%w: %s %q", ErrAwardRefInvalid, p, ref)
+
+	switch p {
+	case AwardIOTA:
+		m := iotaRefRe.FindStringSubmatch(ref)
+		if m == nil {
+			return AwardReference{}, invalid
+		}
+		n, _ := strconv.Atoi(m[2])
+		if n == 0 {
+			return AwardReference{}, invalid
+		}
+		return AwardReference{Programme: p, Ref: fmt.Sprintf("%s-%03d", m[1], n), Prefix: m[1], Number: n}, nil
+
+	case AwardSOTA:
+		m := sotaRefRe.FindStringSubmatch(ref)
+		if m == nil || !strings.ContainsAny(m[1], "ABCDEFGHIJKLMNOPQRSTUVWXYZ") {
+			return AwardReference{}, invalid
+		}
+		n, _ := strconv.Atoi(m[3])
+		if n == 0 {
+			return AwardReference{}, invalid
+		}
+		return AwardReference{Programme: p, Ref: ref, Prefix: m[1], Region: m[2], Number: n}, nil
+
+	case AwardPOTA:
+		m := potaRefRe.FindStringSubmatch(ref)
+		if m == nil || !strings.ContainsAny(m[1], "ABCDEFGHIJKLMNOPQRSTUVWXYZ") {
+			return AwardReference{}, invalid
+		}
+		prefix := m[1]
+		if current, ok := potaLegacyPrefixes[prefix]; ok {
+			prefix = current
+		}
+		n, _ := strconv.Atoi(m[2])
+		return AwardReference{Programme: p, Ref: prefix + "-" + m[2], Prefix: prefix, Number: n, Location: m[3]}, nil
+
+	case AwardWWFF:
+		m := wwffRefRe.FindStringSubmatch(ref)
+		if m == nil {
+			return AwardReference{}, invalid
+		}
+		n, _ := strconv.Atoi(m[2])
+		return AwardReference{Programme: p, Ref: m[1] + "-" + m[2], Prefix: m[1], Number: n}, nil
+
+	case AwardWAB:
+		m := wabRefRe.FindStringSubmatch(ref)
+		if m == nil || (len(m[1]) == 2 && !strings.ContainsRune("HJNOST", rune(m[1][0]))) {
+			return AwardReference{}, invalid
+		}
+		return AwardReference{Programme: p, Ref: ref, Prefix: m[1]}, nil
+
+	default:
+		return AwardReference{}, fmt.Errorf("%w: %q", ErrAwardRefUnsupported, p)
+	}
+}
+
+// ParsePOTAReferences parses an ADIF POTA_REF or MY_POTA_REF value, a comma-separated list of park
+// references for a QSO made from or to several parks at once.
+func ParsePOTAReferences(list string) ([]AwardReference, error) {
+	var refs []AwardReference
+	for _, s := range strings.Split(list, ",") {
+		ref, err := ParseAwardReference(AwardPOTA, s)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// ParseSIGInfo interprets an ADIF SIG and SIG_INFO pair. For a supported programme (matched
+// case-insensitively on SIG) the reference is validated and canonicalised as by ParseAwardReference.
+// Any other SIG is returned with its SIG_INFO unchanged apart from trimming, provided both are
+// non-empty printable ASCII as ADIF requires.
+func ParseSIGInfo(sig, info string) (AwardReference, error) {
+	p := AwardProgramme(strings.ToUpper(strings.TrimSpace(sig)))
+	switch p {
+	case AwardIOTA, AwardSOTA, AwardPOTA, AwardWWFF, AwardWAB:
+		return ParseAwardReference(p, info)
+	}
+	info = strings.TrimSpace(info)
+	if !isPrintableASCII(string(p)) || !isPrintableASCII(info) {
+		return AwardReference{}, fmt.Errorf("%w: SIG %q SIG_INFO %q", ErrAwardRefInvalid, sig, info)
+	}
+	return AwardReference{Programme: p, Ref: info}, nil
+}
+
+func isPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return s != emptyString
+}
+
+// AwardReferenceInfo is one entry of an award reference list. HasPosition reports whether the list
+// gave a position for the reference.
+type AwardReferenceInfo struct {
+	Reference   AwardReference
+	Name        string
+	Location    string
+	Position    LatLong
+	HasPosition bool
+}
+
+// AwardReferenceList holds the references of one programme for existence checks and lookup of
+// names and locations. It is safe for concurrent use once built.
+//
+// No reference lists are embedded in this package. The POTA, SOTA and WWFF directories hold tens
+// of thousands of references and change every week, so an embedded copy would soon reject new
+// parks and summits. Load a current export with LoadAwardReferenceCSV, or embed one in your own
+// program (with go:embed, say) and pass it to LoadAwardReferenceCSV or NewAwardReferenceList.
+type AwardReferenceList struct {
+	programme AwardProgramme
+	refs      map[string]AwardReferenceInfo
+}
+
+// NewAwardReferenceList builds a list from entries whose references have already been parsed, for
+// callers that embed or fetch reference data in their own format.
+func NewAwardReferenceList(p AwardProgramme, entries []AwardReferenceInfo) *AwardReferenceList {
+	l := &AwardReferenceList{programme: p, refs: make(map[string]AwardReferenceInfo, len(entries))}
+	for _, e := range entries {
+		l.refs[e.Reference.Ref] = e
+	}
+	return l
+}
+
+// Header names recognised by LoadAwardReferenceCSV, in lower case. They cover the published
+// POTA (all_parks_ext.csv), SOTA (summitslist.csv) and WWFF directory exports.
+var (
+	awardRefColumns      = []string{"reference", "summitcode", "ref"}
+	awardNameColumns     = []string{"name", "summitname"}
+	awardLocationColumns = []string{"locationdesc", "regionname", "state"}
+)
+
+// LoadAwardReferenceCSV loads a programme's reference list from CSV. Rows before the header (such
+// as the title line of the SOTA summits list) are skipped; the header must name a reference column
+// and may name name, location, latitude and longitude columns.
+func LoadAwardReferenceCSV(p AwardProgramme, r io.Reader) (*AwardReferenceList, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	refCol, nameCol, locCol, latCol, longCol := -1, -1, -1, -1, -1
+	var entries []AwardReferenceInfo
+	for row := 1; ; row++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAwardRefListFormat, err)
+		}
+		if refCol < 0 {
+			cols := make(map[string]int, len(rec))
+			for i, h := range rec {
+				cols[strings.ToLower(strings.TrimSpace(h))] = i
+			}
+			refCol = awardColumn(cols, awardRefColumns)
+			if refCol >= 0 {
+				nameCol = awardColumn(cols, awardNameColumns)
+				locCol = awardColumn(cols, awardLocationColumns)
+				latCol = awardColumn(cols, []string{"latitude"})
+				longCol = awardColumn(cols, []string{"longitude"})
+			}
+			continue
+		}
+		if refCol >= len(rec) {
+			return nil, fmt.Errorf("row %d: %w: missing reference column", row, ErrAwardRefListFormat)
+		}
+		ref, err := ParseAwardReference(p, rec[refCol])
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		info := AwardReferenceInfo{Reference: ref, Name: csvField(rec, nameCol), Location: csvField(rec, locCol)}
+		lat, latErr := strconv.ParseFloat(csvField(rec, latCol), 64)
+		long, longErr := strconv.ParseFloat(csvField(rec, longCol), 64)
+		if latErr == nil && longErr == nil {
+			if pos, err := NewLatLong(lat, long); err == nil {
+				info.Position, info.HasPosition = pos, true
+			}
+		}
+		entries = append(entries, info)
+	}
+	if refCol < 0 {
+		return nil, fmt.Errorf("%w: no reference column", ErrAwardRefListFormat)
+	}
+	return NewAwardReferenceList(p, entries), nil
+}
+
+func awardColumn(cols map[string]int, names []string) int {
+	for _, n := range names {
+		if i, ok := cols[n]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+func csvField(rec []string, i int) string {
+	if i < 0 || i >= len(rec) {
+		return emptyString
+	}
+	return strings.TrimSpace(rec[i])
+}
+
+// Programme returns the award programme the list belongs to.
+func (l *AwardReferenceList) Programme() AwardProgramme {
+	return l.programme
+}
+
+// Len returns the number of references in the list.
+func (l *AwardReferenceList) Len() int {
+	return len(l.refs)
+}
+
+// Lookup parses ref and returns its entry. It returns ErrAwardRefInvalid if ref is malformed and
+// ErrAwardRefNotFound if it is well formed but not in the list.
+func (l *AwardReferenceList) Lookup(ref string) (AwardReferenceInfo, error) {
+	r, err := ParseAwardReference(l.programme, ref)
+	if err != nil {
+		return AwardReferenceInfo{}, err
+	}
+	info, ok := l.refs[r.Ref]
+	if !ok {
+		return AwardReferenceInfo{}, fmt.Errorf("%w: %s %s", ErrAwardRefNotFound, l.programme, r.Ref)
+	}
+	info.Reference.Location = r.Location
+	return info, nil
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseAwardReference(t *testing.T) {
+	cases := []struct {
+		p      AwardProgramme
+		in     string
+		ref    string
+		prefix string
+		number int
+	}{
+		{AwardIOTA, "EU-005", "EU-005", "EU", 5},
+		{AwardIOTA, "eu5", "EU-005", "EU", 5},
+		{AwardIOTA, "OC 123", "OC-123", "OC", 123},
+		{AwardSOTA, "g/ld-001", "G/LD-001", "G", 1},
+		{AwardSOTA, "W7W/LC-042", "W7W/LC-042", "W7W", 42},
+		{AwardSOTA, "HB/BE-150", "HB/BE-150", "HB", 150},
+		{AwardPOTA, "US-1234", "US-1234", "US", 1234},
+		{AwardPOTA, "K-1234", "US-1234", "US", 1234},
+		{AwardPOTA, "VE-0001", "CA-0001", "CA", 1},
+		{AwardPOTA, "GB-0001", "GB-0001", "GB", 1},
+		{AwardPOTA, "US-10001", "US-10001", "US", 10001},
+		{AwardWWFF, "GFF-0001", "GFF-0001", "GFF", 1},
+		{AwardWWFF, "dlff0123", "DLFF-0123", "DLFF", 123},
+		{AwardWAB, "su01", "SU01", "SU", 0},
+		{AwardWAB, "J45", "J45", "J", 0},
+	}
+	for _, c := range cases {
+		got, err := ParseAwardReference(c.p, c.in)
+		if err != nil {
+			t.Fatalf("ParseAwardReference(%s, %q): %v", c.p, c.in, err)
+		}
+		if got.Programme != c.p || got.Ref != c.ref || got.Prefix != c.prefix || got.Number != c.number {
+			t.Fatalf("ParseAwardReference(%s, %q) = %+v", c.p, c.in, got)
+		}
+	}
+
+	sota, _ := ParseAwardReference(AwardSOTA, "G/LD-001")
+	if sota.Region != "LD" {
+		t.Fatalf("unexpected SOTA region %q", sota.Region)
+	}
+	pota, _ := ParseAwardReference(AwardPOTA, "K-0059@US-ME")
+	if pota.Ref != "US-0059" || pota.Location != "US-ME" {
+		t.Fatalf("unexpected POTA reference %+v", pota)
+	}
+}
+
+func TestParseAwardReference_Invalid(t *testing.T) {
+	invalid := map[AwardProgramme][]string{
+		AwardIOTA: {"", "EU-000", "XX-001", "EU-1234", "EU-00A"},
+		AwardSOTA: {"G/LD-1", "GLD-001", "G/LDX-001", "1/LD-001", "G/LD-000"},
+		AwardPOTA: {"US-123", "US-123456", "1234", "US1234", "12-1234", "US-1234@"},
+		AwardWWFF: {"GF-0001", "GFF-001", "GFF-00001"},
+		AwardWAB:  {"I01", "SI01", "AA01", "SU1", "SU012"},
+	}
+	for p, refs := range invalid {
+		for _, ref := range refs {
+			if _, err := ParseAwardReference(p, ref); !errors.Is(err, ErrAwardRefInvalid) {
+				t.Fatalf("ParseAwardReference(%s, %q) expected ErrAwardRefInvalid, got %v", p, ref, err)
+			}
+		}
+	}
+	if _, err := ParseAwardReference("GMA", "G/LD-001"); !errors.Is(err, ErrAwardRefUnsupported) {
+		t.Fatalf("expected ErrAwardRefUnsupported, got %v", err)
+	}
+}
+
+func TestParsePOTAReferences(t *testing.T) {
+	refs, err := ParsePOTAReferences("K-0001, US-0002@US-ME")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refs) != 2 || refs[0].Ref != "US-0001" || refs[1].Ref != "US-0002" || refs[1].Location != "US-ME" {
+		t.Fatalf("unexpected %+v", refs)
+	}
+	if _, err := ParsePOTAReferences("US-0001,,US-0002"); !errors.Is(err, ErrAwardRefInvalid) {
+		t.Fatalf("expected ErrAwardRefInvalid, got %v", err)
+	}
+}
+
+func TestParseSIGInfo(t *testing.T) {
+	ref, err := ParseSIGInfo("iota", "eu5")
+	if err != nil || ref.Programme != AwardIOTA || ref.Ref != "EU-005" {
+		t.Fatalf("unexpected %+v, %v", ref, err)
+	}
+	ref, err = ParseSIGInfo("gma", " DL/AM-001 ")
+	if err != nil || ref.Programme != "GMA" || ref.Ref != "DL/AM-001" {
+		t.Fatalf("unexpected %+v, %v", ref, err)
+	}
+	if _, err := ParseSIGInfo("SOTA", "G/LD-1"); !errors.Is(err, ErrAwardRefInvalid) {
+		t.Fatalf("expected ErrAwardRefInvalid, got %v", err)
+	}
+	if _, err := ParseSIGInfo("", "X"); !errors.Is(err, ErrAwardRefInvalid) {
+		t.Fatalf("expected ErrAwardRefInvalid, got %v", err)
+	}
+	if _, err := ParseSIGInfo("GMA", "DL/AMé"); !errors.Is(err, ErrAwardRefInvalid) {
+		t.Fatalf("expected ErrAwardRefInvalid for non-ASCII SIG_INFO, got %v", err)
+	}
+}
+
+func TestAwardProgrammeForADIFField(t *testing.T) {
+	if p, ok := AwardProgrammeForADIFField("my_sota_ref"); !ok || p != AwardSOTA {
+		t.Fatalf("unexpected %q, %v", p, ok)
+	}
+	if _, ok := AwardProgrammeForADIFField("SIG_INFO"); ok {
+		t.Fatal("SIG_INFO is not tied to one programme")
+	}
+}
+
+const testPOTACSV = `"reference","name","active","entityId","locationDesc","latitude","longitude","grid"
+"US-0001","Acadia National Park","1","291","US-ME","44.31","-68.2034","FN54vh"
+"US-0002","Alagnak Wild River","1","6","US-AK","59.0","-156.0","BO39"
+`
+
+const testSOTACSV = `SOTA Summits List (Date=01/01/2026)
+SummitCode,AssociationName,RegionName,SummitName,AltM,AltFt,GridRef1,GridRef2,Longitude,Latitude
+G/LD-001,England,Lake District,Scafell Pike,978,3209,NY2154,07215,-3.2117,54.4542
+G/LD-002,England,Lake District,Helvellyn,950,3117,NY3415,15151,-3.0158,54.5273
+`
+
+func TestLoadAwardReferenceCSV(t *testing.T) {
+	pota, err := LoadAwardReferenceCSV(AwardPOTA, strings.NewReader(testPOTACSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pota.Len() != 2 || pota.Programme() != AwardPOTA {
+		t.Fatalf("unexpected list of %d %s references", pota.Len(), pota.Programme())
+	}
+	info, err := pota.Lookup("K-0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "Acadia National Park" || info.Location != "US-ME" || !info.HasPosition || info.Position.Longitude != -68.2034 {
+		t.Fatalf("unexpected %+v", info)
+	}
+	if _, err := pota.Lookup("US-9999"); !errors.Is(err, ErrAwardRefNotFound) {
+		t.Fatalf("expected ErrAwardRefNotFound, got %v", err)
+	}
+	if _, err := pota.Lookup("US-99"); !errors.Is(err, ErrAwardRefInvalid) {
+		t.Fatalf("expected ErrAwardRefInvalid, got %v", err)
+	}
+
+	sota, err := LoadAwardReferenceCSV(AwardSOTA, strings.NewReader(testSOTACSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err = sota.Lookup("g/ld-002")
+	if err != nil || info.Name != "Helvellyn" || info.Location != "Lake District" || info.Position.Latitude != 54.5273 {
+		t.Fatalf("unexpected %+v, %v", info, err)
+	}
+
+	if _, err := LoadAwardReferenceCSV(AwardSOTA, strings.NewReader("a,b\n1,2\n")); !errors.Is(err, ErrAwardRefListFormat) {
+		t.Fatalf("expected ErrAwardRefListFormat, got %v", err)
+	}
+	if _, err := LoadAwardReferenceCSV(AwardSOTA, strings.NewReader("SummitCode\nNOT-A-REF\n")); !errors.Is(err, ErrAwardRefInvalid) {
+		t.Fatalf("expected ErrAwardRefInvalid, got %v", err)
+	}
+}
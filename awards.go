@@ -0,0 +1,367 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Award names an award whose progress AwardTracker follows.
+type Award string
+
+const (
+	AwardDXCC Award = "DXCC" // DXCC entities, from the DXCC field
+	AwardWAS  Award = "WAS"  // the 50 US states, from STATE
+	AwardWAZ  Award = "WAZ"  // the 40 CQ zones, from CQZ
+	AwardWPX  Award = "WPX"  // WPX prefixes, from PFX or else the callsign
+	AwardVUCC Award = "VUCC" // 4-character grid squares on 6m and up, from GRIDSQUARE and VUCC_GRIDS
+)
+
+// Awards lists the awards AwardTracker follows.
+var Awards = []Award{AwardDXCC, AwardWAS, AwardWAZ, AwardWPX, AwardVUCC}
+
+// QSLSource is a set of confirmation sources.
+type QSLSource int
+
+const (
+	QSLCard QSLSource = 1 << iota // QSL_RCVD
+	QSLLoTW                       // LOTW_QSL_RCVD
+	QSLEQSL                       // EQSL_QSL_RCVD
+
+	QSLAny = QSLCard | QSLLoTW | QSLEQSL
+)
+
+// AwardStatus is the progress towards one award reference.
+type AwardStatus int
+
+const (
+	AwardNotWorked AwardStatus = iota
+	AwardWorked
+	AwardConfirmed
+)
+
+func (s AwardStatus) String() string {
+	switch s {
+	case AwardWorked:
+		return "worked"
+	case AwardConfirmed:
+		return "confirmed"
+	default:
+		return "not worked"
+	}
+}
+
+// AwardCredit is what a log holds for one award reference: the number of QSOs and the sources that
+// confirmed at least one of them.
+type AwardCredit struct {
+	QSOs      int
+	Confirmed QSLSource
+}
+
+// AwardRefStatus is the credit for one award reference. Deleted is set for deleted DXCC entities,
+// which do not count towards AwardProgress.
+type AwardRefStatus struct {
+	Ref     string
+	Credit  AwardCredit
+	Status  AwardStatus
+	Deleted bool
+}
+
+// AwardProgress counts the references worked and confirmed. Total is the number of references the
+// award has, or 0 for WPX and VUCC, which have no fixed list.
+type AwardProgress struct {
+	Worked    int
+	Confirmed int
+	Total     int
+}
+
+// AwardNeed is a reference a QSO would bring closer to confirmation. Band and Mode are empty for
+// the award as a whole; Status is the current status, so AwardNotWorked marks a new one.
+type AwardNeed struct {
+	Award  Award
+	Ref    string
+	Band   string
+	Mode   string
+	Status AwardStatus
+}
+
+// AwardTracker follows worked and confirmed status for each award, overall, per band and per mode
+// family (see ModeFamily). Only the sources given to NewAwardTracker for an award count as
+// confirmations for it.
+type AwardTracker struct {
+	accept  map[Award]QSLSource
+	credits map[Award]map[awardKey]*AwardCredit
+}
+
+type awardKey struct {
+	ref, band, mode string
+}
+
+// NewAwardTracker returns an empty tracker accepting confirmations from accept, e.g. QSLLoTW|QSLCard,
+// for every award without an entry in perAward, e.g. {AwardWAS: QSLAny} where eQSL also counts.
+func NewAwardTracker(accept QSLSource, perAward map[Award]QSLSource) *AwardTracker {
+	t := &AwardTracker{accept: make(map[Award]QSLSource), credits: make(map[Award]map[awardKey]*AwardCredit)}
+	for _, a := range Awards {
+		t.accept[a] = accept
+		if src, ok := perAward[a]; ok {
+			t.accept[a] = src
+		}
+		t.credits[a] = make(map[awardKey]*AwardCredit)
+	}
+	return t
+}
+
+// Add credits a QSO to every award it counts for. QSOs lacking the fields an award needs, and DXCC
+// QSOs made outside the entity's validity dates, are ignored for that award.
+func (t *AwardTracker) Add(rec ADIFRecord) {
+	band, _ := RecordBand(rec)
+	mode := ModeFamily(rec.Value("MODE"))
+	confirmed := qslSources(rec)
+	for _, a := range Awards {
+		for _, ref := range awardRefs(a, rec, band, true) {
+			for _, k := range awardKeys(ref, band, mode) {
+				c := t.credits[a][k]
+				if c == nil {
+					c = &AwardCredit{}
+					t.credits[a][k] = c
+				}
+				c.QSOs++
+				c.Confirmed |= confirmed
+			}
+		}
+	}
+}
+
+// Credit returns the credit for a reference, overall or on one band or mode. mode may be a mode or
+// a mode family.
+func (t *AwardTracker) Credit(a Award, ref, band, mode string) AwardCredit {
+	c := t.credits[a][awardKey{normalizeAwardRef(a, ref), awardBand(band), awardMode(mode)}]
+	if c == nil {
+		return AwardCredit{}
+	}
+	return *c
+}
+
+// Status returns the status of a reference, overall or on one band or mode.
+func (t *AwardTracker) Status(a Award, ref, band, mode string) AwardStatus {
+	return t.status(a, t.Credit(a, ref, band, mode))
+}
+
+func (t *AwardTracker) status(a Award, c AwardCredit) AwardStatus {
+	switch {
+	case c.Confirmed&t.accept[a] != 0:
+		return AwardConfirmed
+	case c.QSOs > 0:
+		return AwardWorked
+	default:
+		return AwardNotWorked
+	}
+}
+
+// Refs returns the worked references of an award, overall or on one band or mode, sorted by
+// reference (numerically for DXCC and WAZ).
+func (t *AwardTracker) Refs(a Award, band, mode string) []AwardRefStatus {
+	band, mode = awardBand(band), awardMode(mode)
+	var out []AwardRefStatus
+	for k, c := range t.credits[a] {
+		if k.band != band || k.mode != mode {
+			continue
+		}
+		s := AwardRefStatus{Ref: k.ref, Credit: *c, Status: t.status(a, *c)}
+		if a == AwardDXCC {
+			e, _ := LookupDXCCString(k.ref)
+			s.Deleted = e.Deleted
+		}
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		x, err1 := strconv.Atoi(out[i].Ref)
+		y, err2 := strconv.Atoi(out[j].Ref)
+		if err1 == nil && err2 == nil {
+			return x < y
+		}
+		return out[i].Ref < out[j].Ref
+	})
+	return out
+}
+
+// Progress counts the references worked and confirmed, overall or on one band or mode. Deleted
+// DXCC entities are not counted.
+func (t *AwardTracker) Progress(a Award, band, mode string) AwardProgress {
+	var p AwardProgress
+	for _, s := range t.Refs(a, band, mode) {
+		if s.Deleted {
+			continue
+		}
+		p.Worked++
+		if s.Status == AwardConfirmed {
+			p.Confirmed++
+		}
+	}
+	switch a {
+	case AwardDXCC:
+		p.Total = len(CurrentDXCCEntities())
+	case AwardWAS:
+		p.Total = len(wasStates)
+	case AwardWAZ:
+		p.Total = 40
+	}
+	return p
+}
+
+// Needed returns the award references a QSO, such as one built from a spot, would work for the
+// first time or could still confirm: overall, on its band and in its mode family. The QSO needs the
+// fields each award uses, such as DXCC and CQZ from a callsign lookup.
+func (t *AwardTracker) Needed(rec ADIFRecord) []AwardNeed {
+	band, _ := RecordBand(rec)
+	mode := ModeFamily(rec.Value("MODE"))
+	var needs []AwardNeed
+	for _, a := range Awards {
+		for _, ref := range awardRefs(a, rec, band, false) {
+			for _, k := range awardKeys(ref, band, mode) {
+				var c AwardCredit
+				if p := t.credits[a][k]; p != nil {
+					c = *p
+				}
+				if s := t.status(a, c); s != AwardConfirmed {
+					needs = append(needs, AwardNeed{Award: a, Ref: ref, Band: k.band, Mode: k.mode, Status: s})
+				}
+			}
+		}
+	}
+	return needs
+}
+
+// awardKeys returns the keys a reference is credited under: overall, and on band and in mode if
+// they are known.
+func awardKeys(ref, band, mode string) []awardKey {
+	keys := []awardKey{{ref: ref}}
+	if band != emptyString {
+		keys = append(keys, awardKey{ref: ref, band: band})
+	}
+	if mode != emptyString {
+		keys = append(keys, awardKey{ref: ref, mode: mode})
+	}
+	return keys
+}
+
+// qslSources returns the sources that confirmed a QSO.
+func qslSources(rec ADIFRecord) QSLSource {
+	var s QSLSource
+	for field, src := range map[string]QSLSource{"QSL_RCVD": QSLCard, "LOTW_QSL_RCVD": QSLLoTW, "EQSL_QSL_RCVD": QSLEQSL} {
+		if v, err := NormalizeADIFEnum(EnumQSLRcvd, rec.Value(field)); err == nil && v == "Y" {
+			s |= src
+		}
+	}
+	return s
+}
+
+// wasStates holds the 50 states counted for WAS: those of the USA other than DC, with Alaska and
+// Hawaii, which are separate DXCC entities.
+var wasStates = func() map[string]bool {
+	states := map[string]bool{"AK": true, "HI": true}
+	for _, s := range primarySubdivisionData[291] {
+		if s[0] != "DC" {
+			states[s[0]] = true
+		}
+	}
+	return states
+}()
+
+// wasEntities are the DXCC entities of the WAS states.
+var wasEntities = map[string]bool{"291": true, "6": true, "110": true}
+
+// awardRefs returns the references a QSO counts for in an award. checkDate applies the validity
+// dates of DXCC entities, which a spot has no need of.
+func awardRefs(a Award, rec ADIFRecord, band string, checkDate bool) []string {
+	switch a {
+	case AwardDXCC:
+		e, ok := LookupDXCCString(rec.Value("DXCC"))
+		if !ok || e.Code == 0 {
+			return nil
+		}
+		if checkDate && (!e.ValidFrom.IsZero() || !e.ValidTo.IsZero()) {
+			at, ok := awardQSODate(rec)
+			if !ok || !e.ValidAt(at) {
+				return nil
+			}
+		}
+		return []string{e.CodeString()}
+	case AwardWAS:
+		if dxcc := strings.TrimSpace(rec.Value("DXCC")); dxcc != emptyString && !wasEntities[strings.TrimLeft(dxcc, "0")] {
+			return nil
+		}
+		if state := normalizeAwardRef(a, rec.Value("STATE")); wasStates[state] {
+			return []string{state}
+		}
+	case AwardWAZ:
+		if z, err := strconv.Atoi(strings.TrimSpace(rec.Value("CQZ"))); err == nil && z >= 1 && z <= 40 {
+			return []string{strconv.Itoa(z)}
+		}
+	case AwardWPX:
+		if pfx := normalizeAwardRef(a, rec.Value("PFX")); pfx != emptyString {
+			return []string{pfx}
+		}
+		if pfx, err := WPXPrefix(rec.Value("CALL")); err == nil {
+			return []string{pfx}
+		}
+	case AwardVUCC:
+		b, ok := LookupADIFBand(band)
+		if !ok || b.Lower < 50 {
+			return nil
+		}
+		var grids []string
+		seen := make(map[string]bool)
+		for _, g := range append([]string{rec.Value("GRIDSQUARE")}, strings.Split(rec.Value("VUCC_GRIDS"), ",")...) {
+			g = normalizeAwardRef(a, g)
+			if len(g) == 4 && IsValidGridSquare(g) && !seen[g] {
+				seen[g] = true
+				grids = append(grids, g)
+			}
+		}
+		return grids
+	}
+	return nil
+}
+
+// awardQSODate returns the start of a QSO, or midnight on its date if TIME_ON is missing.
+func awardQSODate(rec ADIFRecord) (time.Time, bool) {
+	if at, err := rec.TimeOn(); err == nil {
+		return at, true
+	}
+	at, err := ParseADIFTimestamp(rec.Value("QSO_DATE"), "0000")
+	return at, err == nil
+}
+
+// normalizeAwardRef returns a reference in the form the tracker stores it.
+func normalizeAwardRef(a Award, ref string) string {
+	ref = strings.ToUpper(strings.TrimSpace(ref))
+	switch a {
+	case AwardDXCC, AwardWAZ:
+		if n, err := strconv.Atoi(ref); err == nil {
+			return strconv.Itoa(n)
+		}
+	case AwardVUCC:
+		if len(ref) > 4 {
+			ref = ref[:4]
+		}
+	}
+	return ref
+}
+
+func awardBand(band string) string {
+	if b, ok := LookupADIFBand(band); ok {
+		return b.Name
+	}
+	return emptyString
+}
+
+func awardMode(mode string) string {
+	mode = strings.ToUpper(strings.TrimSpace(mode))
+	switch mode {
+	case ModeFamilyCW, ModeFamilyPhone, ModeFamilyDigital, emptyString:
+		return mode
+	}
+	return ModeFamily(mode)
+}
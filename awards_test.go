@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func awardLog() []ADIFRecord {
+	return []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW", "DXCC", "291", "STATE", "CT", "CQZ", "5", "LOTW_QSL_RCVD", "Y"),
+		testADIFRecord("CALL", "K6ABC", "QSO_DATE", "20240102", "TIME_ON", "1200", "BAND", "40m", "MODE", "SSB", "DXCC", "291", "STATE", "CA", "CQZ", "3", "EQSL_QSL_RCVD", "Y"),
+		testADIFRecord("CALL", "G4ABC", "QSO_DATE", "20240103", "TIME_ON", "1200", "FREQ", "14.074", "MODE", "FT8", "DXCC", "223", "CQZ", "14", "QSL_RCVD", "V"),
+		testADIFRecord("CALL", "KL7XX", "QSO_DATE", "20240104", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW", "DXCC", "6", "STATE", "AK", "CQZ", "1"),
+		testADIFRecord("CALL", "KZ5AA", "QSO_DATE", "19790101", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW", "DXCC", "28", "CQZ", "7", "QSL_RCVD", "Y"),
+		testADIFRecord("CALL", "KZ5BB", "QSO_DATE", "19850101", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW", "DXCC", "28", "CQZ", "7", "QSL_RCVD", "Y"),
+		testADIFRecord("CALL", "W3DC", "QSO_DATE", "20240105", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW", "DXCC", "291", "STATE", "DC"),
+		testADIFRecord("CALL", "N1XYZ", "QSO_DATE", "20240106", "TIME_ON", "1200", "BAND", "2m", "MODE", "FM", "GRIDSQUARE", "fn31pr", "LOTW_QSL_RCVD", "Y"),
+		testADIFRecord("CALL", "N1ROV", "QSO_DATE", "20240106", "TIME_ON", "1300", "BAND", "6m", "MODE", "SSB", "VUCC_GRIDS", "FN31,FN32"),
+		testADIFRecord("CALL", "N1HF", "QSO_DATE", "20240106", "TIME_ON", "1400", "BAND", "10m", "MODE", "SSB", "GRIDSQUARE", "FN42"),
+	}
+}
+
+func TestAwardTracker_Status(t *testing.T) {
+	tr := NewAwardTracker(QSLLoTW|QSLCard, nil)
+	for _, rec := range awardLog() {
+		tr.Add(rec)
+	}
+	tests := []struct {
+		a               Award
+		ref, band, mode string
+		want            AwardStatus
+	}{
+		{AwardDXCC, "291", "", "", AwardConfirmed},
+		{AwardDXCC, "291", "20m", "", AwardConfirmed},
+		{AwardDXCC, "291", "40M", "", AwardWorked},
+		{AwardDXCC, "291", "", "PHONE", AwardWorked},
+		{AwardDXCC, "291", "", "usb", AwardWorked},
+		{AwardDXCC, "291", "80m", "", AwardNotWorked},
+		{AwardDXCC, "223", "20m", "DIGITAL", AwardNotWorked},
+		{AwardDXCC, "223", "20m", "", AwardConfirmed},
+		{AwardDXCC, "006", "", "", AwardWorked},
+		{AwardWAS, "ct", "", "", AwardConfirmed},
+		{AwardWAS, "CA", "", "", AwardWorked},
+		{AwardWAS, "DC", "", "", AwardNotWorked},
+		{AwardWAS, "AK", "20m", "CW", AwardNotWorked},
+		{AwardWAZ, "05", "", "CW", AwardConfirmed},
+		{AwardWPX, "W1", "", "", AwardConfirmed},
+		{AwardWPX, "KL7", "", "", AwardWorked},
+		{AwardVUCC, "FN31", "", "", AwardConfirmed},
+		{AwardVUCC, "FN31PR", "2m", "", AwardConfirmed},
+		{AwardVUCC, "FN32", "6m", "", AwardWorked},
+		{AwardVUCC, "FN42", "", "", AwardNotWorked},
+	}
+	for _, tt := range tests {
+		if got := tr.Status(tt.a, tt.ref, tt.band, tt.mode); got != tt.want {
+			t.Fatalf("Status(%s, %q, %q, %q) = %v; want %v", tt.a, tt.ref, tt.band, tt.mode, got, tt.want)
+		}
+	}
+	if c := tr.Credit(AwardDXCC, "291", "", ""); c.QSOs != 3 || c.Confirmed != QSLLoTW|QSLEQSL {
+		t.Fatalf("unexpected credit %+v", c)
+	}
+}
+
+func TestAwardTracker_PerAwardSources(t *testing.T) {
+	tr := NewAwardTracker(QSLLoTW|QSLCard, map[Award]QSLSource{AwardWAS: QSLAny})
+	tr.Add(testADIFRecord("CALL", "K6ABC", "QSO_DATE", "20240102", "TIME_ON", "1200", "BAND", "40m", "MODE", "SSB",
+		"DXCC", "291", "STATE", "CA", "CQZ", "3", "EQSL_QSL_RCVD", "Y"))
+	if s := tr.Status(AwardWAS, "CA", "", ""); s != AwardConfirmed {
+		t.Fatalf("WAS CA is %v; want confirmed", s)
+	}
+	if s := tr.Status(AwardDXCC, "291", "", ""); s != AwardWorked {
+		t.Fatalf("DXCC 291 is %v; want worked", s)
+	}
+	if p := tr.Progress(AwardWAS, "", ""); p.Confirmed != 1 {
+		t.Fatalf("unexpected WAS progress %+v", p)
+	}
+}
+
+func TestAwardTracker_Progress(t *testing.T) {
+	tr := NewAwardTracker(QSLAny, nil)
+	for _, rec := range awardLog() {
+		tr.Add(rec)
+	}
+	refs := tr.Refs(AwardDXCC, "", "")
+	var got []string
+	for _, r := range refs {
+		got = append(got, r.Ref)
+	}
+	if !reflect.DeepEqual(got, []string{"6", "28", "223", "291"}) {
+		t.Fatalf("unexpected DXCC refs %v", got)
+	}
+	// Only the Canal Zone QSO made before the entity was deleted counts.
+	if !refs[1].Deleted || refs[1].Credit.QSOs != 1 {
+		t.Fatalf("unexpected deleted entity %+v", refs[1])
+	}
+	if p := tr.Progress(AwardDXCC, "", ""); p.Worked != 3 || p.Confirmed != 2 || p.Total != len(CurrentDXCCEntities()) {
+		t.Fatalf("unexpected DXCC progress %+v", p)
+	}
+	if p := tr.Progress(AwardWAS, "", ""); p != (AwardProgress{Worked: 3, Confirmed: 2, Total: 50}) {
+		t.Fatalf("unexpected WAS progress %+v", p)
+	}
+	if p := tr.Progress(AwardWAZ, "20m", ""); p != (AwardProgress{Worked: 4, Confirmed: 3, Total: 40}) {
+		t.Fatalf("unexpected WAZ progress %+v", p)
+	}
+	if p := tr.Progress(AwardVUCC, "", ""); p != (AwardProgress{Worked: 2, Confirmed: 1}) {
+		t.Fatalf("unexpected VUCC progress %+v", p)
+	}
+}
+
+func TestAwardTracker_Needed(t *testing.T) {
+	tr := NewAwardTracker(QSLLoTW|QSLCard, nil)
+	for _, rec := range awardLog() {
+		tr.Add(rec)
+	}
+	spot := testADIFRecord("CALL", "W1XYZ", "FREQ", "7.025", "MODE", "CW", "DXCC", "291", "STATE", "CT", "CQZ", "5")
+	want := []AwardNeed{
+		{Award: AwardDXCC, Ref: "291", Band: "40m", Status: AwardWorked},
+		{Award: AwardWAS, Ref: "CT", Band: "40m", Status: AwardNotWorked},
+		{Award: AwardWAZ, Ref: "5", Band: "40m", Status: AwardNotWorked},
+		{Award: AwardWPX, Ref: "W1", Band: "40m", Status: AwardNotWorked},
+	}
+	if got := tr.Needed(spot); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected needs\n%+v\nwant\n%+v", got, want)
+	}
+
+	spot = testADIFRecord("CALL", "VP8ABC", "BAND", "20m", "MODE", "SSB", "DXCC", "240")
+	got := tr.Needed(spot)
+	if len(got) != 6 || got[0] != (AwardNeed{Award: AwardDXCC, Ref: "240", Status: AwardNotWorked}) || got[3].Award != AwardWPX {
+		t.Fatalf("unexpected needs %+v", got)
+	}
+}
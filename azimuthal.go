@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrOutsideProjection = errors.New("point lies outside the azimuthal projection disc")
+
+// AntipodeDistanceKm is the great-circle distance to the antipode, which maps to the rim of the
+// azimuthal-equidistant disc.
+const AntipodeDistanceKm = math.Pi * EarthRadiusKm
+
+// MapPoint is a position on an azimuthal-equidistant map, on a unit disc centred on the station.
+// X increases to the east and Y to the north (true north is up). The distance from the origin is
+// proportional to great-circle distance: 0 at the station and 1 at its antipode.
+type MapPoint struct {
+	X float64
+	Y float64
+}
+
+// AzimuthalProjection projects positions onto an azimuthal-equidistant map centred on a station.
+// On such a map every great circle through the centre is a straight line, so the bearing of a point
+// from the origin is the beam heading from the station.
+type AzimuthalProjection struct {
+	Centre LatLong
+}
+
+// NewAzimuthalProjection returns a projection centred on the given station position.
+func NewAzimuthalProjection(centre LatLong) (AzimuthalProjection, error) {
+	if err := centre.Validate(); err != nil {
+		return AzimuthalProjection{}, err
+	}
+	return AzimuthalProjection{Centre: centre}, nil
+}
+
+// NewAzimuthalProjectionForGridSquare returns a projection centred on a Maidenhead locator.
+func NewAzimuthalProjectionForGridSquare(grid string) (AzimuthalProjection, error) {
+	p, err := GridSquareToLatLong(grid)
+	if err != nil {
+		return AzimuthalProjection{}, err
+	}
+	return NewAzimuthalProjection(p)
+}
+
+// Project returns the map position of p.
+func (a AzimuthalProjection) Project(p LatLong) MapPoint {
+	rho := centralAngle(a.Centre, p) / math.Pi
+	if rho == 0 {
+		return MapPoint{}
+	}
+	theta := Bearing(a.Centre, p) * degToRad
+	return MapPoint{X: rho * math.Sin(theta), Y: rho * math.Cos(theta)}
+}
+
+// ProjectAll returns the map positions of a sequence of points, such as a path or boundary.
+func (a AzimuthalProjection) ProjectAll(points []LatLong) []MapPoint {
+	out := make([]MapPoint, len(points))
+	for i, p := range points {
+		out[i] = a.Project(p)
+	}
+	return out
+}
+
+// Inverse returns the geographic position of a map point. Points beyond the rim of the unit disc
+// return ErrOutsideProjection.
+func (a AzimuthalProjection) Inverse(m MapPoint) (LatLong, error) {
+	rho := math.Hypot(m.X, m.Y)
+	if rho > 1 {
+		return LatLong{}, ErrOutsideProjection
+	}
+	if rho == 0 {
+		return a.Centre, nil
+	}
+	bearing := normalizeDegrees(math.Atan2(m.X, m.Y) * radToDeg)
+	return Destination(a.Centre, bearing, rho*AntipodeDistanceKm), nil
+}
+
+// Heading returns the beam heading (degrees from true north) and great-circle distance in
+// kilometres from the station to p.
+func (a AzimuthalProjection) Heading(p LatLong) (bearing, distanceKm float64) {
+	return Bearing(a.Centre, p), DistanceKm(a.Centre, p)
+}
+
+// RingRadius returns the radius on the unit disc of a range ring at the given distance.
+func (a AzimuthalProjection) RingRadius(distanceKm float64) float64 {
+	return distanceKm / AntipodeDistanceKm
+}
+
+// GreatCirclePath returns segments+1 points along the short great-circle path from a to b,
+// including both end points. segments less than 1 is treated as 1.
+func GreatCirclePath(a, b LatLong, segments int) []LatLong {
+	if segments < 1 {
+		segments = 1
+	}
+	out := make([]LatLong, segments+1)
+	for i := 0; i <= segments; i++ {
+		out[i] = Intermediate(a, b, float64(i)/float64(segments))
+	}
+	return out
+}
+
+// LongPath returns segments+1 points along the long great-circle path from a to b, leaving a on
+// the reciprocal of the short-path bearing. segments less than 1 is treated as 1.
+func LongPath(a, b LatLong, segments int) []LatLong {
+	if segments < 1 {
+		segments = 1
+	}
+	bearing := normalizeDegrees(Bearing(a, b) + 180)
+	length := 2*AntipodeDistanceKm - DistanceKm(a, b)
+	out := make([]LatLong, segments+1)
+	for i := 0; i < segments; i++ {
+		out[i] = Destination(a, bearing, length*float64(i)/float64(segments))
+	}
+	out[segments] = b
+	return out
+}
+
+// RangeRing returns segments points, evenly spaced by bearing, lying distanceKm from centre.
+// The ring is closed implicitly (the last point connects back to the first).
+// segments less than 3 is treated as 3.
+func RangeRing(centre LatLong, distanceKm float64, segments int) []LatLong {
+	if segments < 3 {
+		segments = 3
+	}
+	out := make([]LatLong, segments)
+	for i := range out {
+		out[i] = Destination(centre, 360*float64(i)/float64(segments), distanceKm)
+	}
+	return out
+}
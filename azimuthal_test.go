@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAzimuthalProjection_Project(t *testing.T) {
+	station := LatLong{Latitude: 51.5, Longitude: -0.13}
+	proj, err := NewAzimuthalProjection(station)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if m := proj.Project(station); m.X != 0 || m.Y != 0 {
+		t.Fatalf("centre should project to origin, got %+v", m)
+	}
+
+	// Due north: on the positive Y axis.
+	north := proj.Project(LatLong{Latitude: 60.5, Longitude: -0.13})
+	if math.Abs(north.X) > 1e-9 || north.Y <= 0 {
+		t.Fatalf("north point = %+v", north)
+	}
+	wantRho := DistanceKm(station, LatLong{Latitude: 60.5, Longitude: -0.13}) / AntipodeDistanceKm
+	if math.Abs(north.Y-wantRho) > 1e-9 {
+		t.Fatalf("north rho = %v want %v", north.Y, wantRho)
+	}
+
+	// New York is west of north-west: negative X, positive Y.
+	ny := proj.Project(LatLong{Latitude: 40.71, Longitude: -74.0})
+	if ny.X >= 0 || ny.Y <= 0 {
+		t.Fatalf("New York = %+v", ny)
+	}
+
+	// The antipode lies on the rim.
+	anti := proj.Project(LatLong{Latitude: -51.5, Longitude: 179.87})
+	if r := math.Hypot(anti.X, anti.Y); math.Abs(r-1) > 1e-6 {
+		t.Fatalf("antipode radius = %v", r)
+	}
+}
+
+func TestAzimuthalProjection_InverseRoundTrip(t *testing.T) {
+	proj, err := NewAzimuthalProjectionForGridSquare("FN31pr")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, p := range []LatLong{
+		{Latitude: 51.5, Longitude: -0.13},
+		{Latitude: -33.87, Longitude: 151.21},
+		{Latitude: 35.68, Longitude: 139.69},
+		{Latitude: -22.9, Longitude: -43.2},
+	} {
+		got, err := proj.Inverse(proj.Project(p))
+		if err != nil {
+			t.Fatalf("Inverse(%+v): %v", p, err)
+		}
+		if d := DistanceKm(got, p); d > 0.01 {
+			t.Fatalf("round trip of %+v gave %+v (%.3f km off)", p, got, d)
+		}
+	}
+	if _, err := proj.Inverse(MapPoint{X: 0.9, Y: 0.9}); !errors.Is(err, ErrOutsideProjection) {
+		t.Fatalf("expected ErrOutsideProjection, got %v", err)
+	}
+	if _, err := NewAzimuthalProjection(LatLong{Latitude: 95}); err == nil {
+		t.Fatalf("expected error for invalid centre")
+	}
+}
+
+func TestGreatCirclePath(t *testing.T) {
+	a := LatLong{Latitude: 51.5, Longitude: -0.13}
+	b := LatLong{Latitude: 40.71, Longitude: -74.0}
+	path := GreatCirclePath(a, b, 10)
+	if len(path) != 11 {
+		t.Fatalf("len = %d", len(path))
+	}
+	if DistanceKm(path[0], a) > 1e-6 || DistanceKm(path[10], b) > 1e-6 {
+		t.Fatalf("path end points = %+v, %+v", path[0], path[10])
+	}
+	// The short path from London to New York passes north of both end points.
+	if path[5].Latitude < 51.5 {
+		t.Fatalf("midpoint latitude = %v", path[5].Latitude)
+	}
+	// On a map centred on a, the path to b is a straight line from the origin.
+	proj, _ := NewAzimuthalProjection(a)
+	end := proj.Project(b)
+	for _, m := range proj.ProjectAll(path[1:]) {
+		if cross := m.X*end.Y - m.Y*end.X; math.Abs(cross) > 1e-9 {
+			t.Fatalf("projected path is not straight: %+v", m)
+		}
+	}
+	if got := GreatCirclePath(a, b, 0); len(got) != 2 {
+		t.Fatalf("segments 0 should give 2 points, got %d", len(got))
+	}
+}
+
+func TestLongPath(t *testing.T) {
+	a := LatLong{Latitude: 51.5, Longitude: -0.13}
+	b := LatLong{Latitude: 40.71, Longitude: -74.0}
+	path := LongPath(a, b, 36)
+	if len(path) != 37 || path[36] != b {
+		t.Fatalf("unexpected long path end %+v", path[len(path)-1])
+	}
+	if d := DistanceKm(path[35], b); d > 2*AntipodeDistanceKm/36 {
+		t.Fatalf("penultimate point %.0f km from destination", d)
+	}
+	short := Bearing(a, b)
+	long := Bearing(a, path[1])
+	if diff := math.Abs(normalizeDegrees(long-short) - 180); diff > 0.01 {
+		t.Fatalf("long path bearing %.2f not reciprocal of %.2f", long, short)
+	}
+}
+
+func TestRangeRing(t *testing.T) {
+	centre := LatLong{Latitude: 51.5, Longitude: -0.13}
+	ring := RangeRing(centre, 1000, 36)
+	if len(ring) != 36 {
+		t.Fatalf("len = %d", len(ring))
+	}
+	proj, _ := NewAzimuthalProjection(centre)
+	want := proj.RingRadius(1000)
+	for _, p := range ring {
+		if d := DistanceKm(centre, p); math.Abs(d-1000) > 0.01 {
+			t.Fatalf("ring point %+v is %.3f km away", p, d)
+		}
+		m := proj.Project(p)
+		if r := math.Hypot(m.X, m.Y); math.Abs(r-want) > 1e-9 {
+			t.Fatalf("projected ring radius %v want %v", r, want)
+		}
+	}
+	if got := RangeRing(centre, 100, 1); len(got) != 3 {
+		t.Fatalf("minimum ring size should be 3, got %d", len(got))
+	}
+}
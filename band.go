@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ADIFBand is one band of the ADIF Band enumeration with its edges in MHz.
+type ADIFBand struct {
+	Name  string
+	Lower float64
+	Upper float64
+}
+
+// adifBands lists the ADIF 3.1 bands in ascending frequency order.
+var adifBands = []ADIFBand{
+	{"2190m", 0.1357, 0.1378},
+	{"630m", 0.472, 0.479},
+	{"560m", 0.501, 0.504},
+	{"160m", 1.8, 2.0},
+	{"80m", 3.5, 4.0},
+	{"60m", 5.06, 5.45},
+	{"40m", 7.0, 7.3},
+	{"30m", 10.1, 10.15},
+	{"20m", 14.0, 14.35},
+	{"17m", 18.068, 18.168},
+	{"15m", 21.0, 21.45},
+	{"12m", 24.89, 24.99},
+	{"10m", 28.0, 29.7},
+	{"8m", 40, 45},
+	{"6m", 50, 54},
+	{"5m", 54.000001, 69.9},
+	{"4m", 70, 71},
+	{"2m", 144, 148},
+	{"1.25m", 222, 225},
+	{"70cm", 420, 450},
+	{"33cm", 902, 928},
+	{"23cm", 1240, 1300},
+	{"13cm", 2300, 2450},
+	{"9cm", 3300, 3500},
+	{"6cm", 5650, 5925},
+	{"3cm", 10000, 10500},
+	{"1.25cm", 24000, 24250},
+	{"6mm", 47000, 47200},
+	{"4mm", 75500, 81000},
+	{"2.5mm", 119980, 123000},
+	{"2mm", 134000, 149000},
+	{"1mm", 241000, 250000},
+	{"submm", 300000, 7500000},
+}
+
+// ADIFBands returns the ADIF bands in ascending frequency order.
+func ADIFBands() []ADIFBand {
+	out := make([]ADIFBand, len(adifBands))
+	copy(out, adifBands)
+	return out
+}
+
+// BandForMHz returns the ADIF band containing a frequency in MHz, edges included.
+func BandForMHz(mhz float64) (string, bool) {
+	for _, b := range adifBands {
+		if mhz < b.Lower {
+			break
+		}
+		if mhz <= b.Upper {
+			return b.Name, true
+		}
+	}
+	return emptyString, false
+}
+
+// LookupADIFBand returns a band by its ADIF name, matched case-insensitively ("20M" gives 20m).
+func LookupADIFBand(name string) (ADIFBand, bool) {
+	name = strings.TrimSpace(name)
+	for _, b := range adifBands {
+		if strings.EqualFold(b.Name, name) {
+			return b, true
+		}
+	}
+	return ADIFBand{}, false
+}
+
+// RecordBand returns the band of an ADIF record: its BAND field if that names an ADIF band, or else
+// the band containing its FREQ (in MHz).
+func RecordBand(rec ADIFRecord) (string, bool) {
+	if b, ok := LookupADIFBand(rec.Value("BAND")); ok {
+		return b.Name, true
+	}
+	mhz, err := strconv.ParseFloat(strings.TrimSpace(rec.Value("FREQ")), 64)
+	if err != nil {
+		return emptyString, false
+	}
+	return BandForMHz(mhz)
+}
@@ -0,0 +1,59 @@
+package utils
+
+import "testing"
+
+func TestBandForMHz(t *testing.T) {
+	cases := map[float64]string{
+		0.136:   "2190m",
+		1.8:     "160m",
+		14.35:   "20m",
+		50.313:  "6m",
+		144.3:   "2m",
+		10368.1: "3cm",
+		400000:  "submm",
+	}
+	for in, want := range cases {
+		if got, ok := BandForMHz(in); !ok || got != want {
+			t.Fatalf("BandForMHz(%v) = %q, %v; want %q", in, got, ok, want)
+		}
+	}
+	for _, in := range []float64{0, 0.9, 14.351, 30, 10500.5} {
+		if got, ok := BandForMHz(in); ok {
+			t.Fatalf("BandForMHz(%v) = %q; want no band", in, got)
+		}
+	}
+}
+
+func TestLookupADIFBand(t *testing.T) {
+	b, ok := LookupADIFBand(" 20M ")
+	if !ok || b != (ADIFBand{"20m", 14.0, 14.35}) {
+		t.Fatalf("unexpected band %+v, %v", b, ok)
+	}
+	if _, ok := LookupADIFBand("21m"); ok {
+		t.Fatal("expected no band for 21m")
+	}
+	bands := ADIFBands()
+	bands[0].Name = "changed"
+	if ADIFBands()[0].Name != "2190m" {
+		t.Fatal("ADIFBands returned the shared table")
+	}
+}
+
+func TestRecordBand(t *testing.T) {
+	cases := []struct {
+		fields []ADIFField
+		want   string
+	}{
+		{[]ADIFField{{Name: "BAND", Value: "40M"}, {Name: "FREQ", Value: "14.074"}}, "40m"},
+		{[]ADIFField{{Name: "BAND", Value: "bogus"}, {Name: "FREQ", Value: "14.074"}}, "20m"},
+		{[]ADIFField{{Name: "FREQ", Value: "432.2"}}, "70cm"},
+		{[]ADIFField{{Name: "FREQ", Value: "14.074.000"}}, ""},
+		{nil, ""},
+	}
+	for _, tt := range cases {
+		got, ok := RecordBand(ADIFRecord{Fields: tt.fields})
+		if got != tt.want || ok != (tt.want != emptyString) {
+			t.Fatalf("RecordBand(%+v) = %q, %v; want %q", tt.fields, got, ok, tt.want)
+		}
+	}
+}
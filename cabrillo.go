@@ -0,0 +1,536 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrCabrilloFormat = errors.New("invalid Cabrillo log format")
+	ErrCabrilloTag    = errors.New("unknown Cabrillo header tag")
+	ErrCabrilloQSO    = errors.New("record cannot be written as a Cabrillo QSO line")
+)
+
+// cabrilloTransmitterField holds the transmitter ID column of multi-transmitter logs.
+const cabrilloTransmitterField = "APP_CABRILLO_TRANSMITTER"
+
+// CabrilloTag is one header line of a Cabrillo log, e.g. {"CATEGORY-OPERATOR", "SINGLE-OP"}.
+// Tags that may repeat, such as SOAPBOX and ADDRESS, appear once per line.
+type CabrilloTag struct {
+	Name  string
+	Value string
+}
+
+// CabrilloExchangeField is one exchange column of a QSO line: the ADIF field it holds and the
+// width it is padded to.
+type CabrilloExchangeField struct {
+	Field string
+	Width int
+}
+
+// CabrilloTemplate describes the QSO line of one contest: the exchange sent and received after each
+// callsign, and whether a transmitter ID column follows (for multi-transmitter categories).
+type CabrilloTemplate struct {
+	Contest       string
+	Sent          []CabrilloExchangeField
+	Rcvd          []CabrilloExchangeField
+	TransmitterID bool
+}
+
+// CabrilloTemplateFor returns the exchange template for a Cabrillo CONTEST value. Contests without
+// a specific template use a signal report followed by the STX_STRING/SRX_STRING exchange.
+func CabrilloTemplateFor(contest string) CabrilloTemplate {
+	contest = strings.ToUpper(strings.TrimSpace(contest))
+	for prefix, t := range cabrilloTemplates {
+		if contest == prefix || strings.HasPrefix(contest, prefix+"-") {
+			t.Contest = contest
+			return t
+		}
+	}
+	t := cabrilloDefaultTemplate
+	t.Contest = contest
+	return t
+}
+
+var (
+	cabrilloRST     = CabrilloExchangeField{"RST_SENT", 3}
+	cabrilloRSTRcvd = CabrilloExchangeField{"RST_RCVD", 3}
+
+	cabrilloDefaultTemplate = CabrilloTemplate{
+		Sent: []CabrilloExchangeField{cabrilloRST, {"STX_STRING", 6}},
+		Rcvd: []CabrilloExchangeField{cabrilloRSTRcvd, {"SRX_STRING", 6}},
+	}
+
+	// cabrilloTemplates is keyed by CONTEST value or by a prefix shared by its mode variants.
+	cabrilloTemplates = map[string]CabrilloTemplate{
+		"CQ-WW": {
+			Sent: []CabrilloExchangeField{cabrilloRST, {"MY_CQ_ZONE", 6}},
+			Rcvd: []CabrilloExchangeField{cabrilloRSTRcvd, {"CQZ", 6}},
+		},
+		"CQ-WPX": {
+			Sent: []CabrilloExchangeField{cabrilloRST, {"STX", 6}},
+			Rcvd: []CabrilloExchangeField{cabrilloRSTRcvd, {"SRX", 6}},
+		},
+		"ARRL-DX": {
+			Sent: []CabrilloExchangeField{cabrilloRST, {"STX_STRING", 6}},
+			Rcvd: []CabrilloExchangeField{cabrilloRSTRcvd, {"SRX_STRING", 6}},
+		},
+		"IARU-HF": {
+			Sent: []CabrilloExchangeField{cabrilloRST, {"MY_ITU_ZONE", 6}},
+			Rcvd: []CabrilloExchangeField{cabrilloRSTRcvd, {"ITUZ", 6}},
+		},
+		"NAQP": {
+			Sent: []CabrilloExchangeField{{"MY_NAME", 10}, {"MY_STATE", 3}},
+			Rcvd: []CabrilloExchangeField{{"NAME", 10}, {"STATE", 3}},
+		},
+	}
+)
+
+// cabrilloHeaderTags lists the Cabrillo 3.0 header tags. Tags starting with "X-" are also
+// accepted.
+var cabrilloHeaderTags = map[string]bool{
+	"CALLSIGN": true, "CONTEST": true, "CATEGORY-ASSISTED": true, "CATEGORY-BAND": true,
+	"CATEGORY-MODE": true, "CATEGORY-OPERATOR": true, "CATEGORY-POWER": true, "CATEGORY-STATION": true,
+	"CATEGORY-TIME": true, "CATEGORY-TRANSMITTER": true, "CATEGORY-OVERLAY": true, "CERTIFICATE": true,
+	"CLAIMED-SCORE": true, "CLUB": true, "CREATED-BY": true, "EMAIL": true, "GRID-LOCATOR": true,
+	"LOCATION": true, "NAME": true, "ADDRESS": true, "ADDRESS-CITY": true,
+	"ADDRESS-STATE-PROVINCE": true, "ADDRESS-POSTALCODE": true, "ADDRESS-COUNTRY": true,
+	"OPERATORS": true, "OFFTIME": true, "SOAPBOX": true, "DEBUG": true,
+}
+
+// cabrilloBands maps the ADIF bands at and above 6m to the designator Cabrillo uses in place of a
+// frequency. Light (above 300 GHz) is "LIGHT".
+var cabrilloBands = []struct {
+	band       string // ADIF band
+	designator string
+}{
+	{"6m", "50"},
+	{"4m", "70"},
+	{"2m", "144"},
+	{"1.25m", "222"},
+	{"70cm", "432"},
+	{"33cm", "902"},
+	{"23cm", "1.2G"},
+	{"13cm", "2.3G"},
+	{"9cm", "3.4G"},
+	{"6cm", "5.7G"},
+	{"3cm", "10G"},
+	{"1.25cm", "24G"},
+	{"6mm", "47G"},
+	{"4mm", "75G"},
+	{"2.5mm", "122G"},
+	{"2mm", "134G"},
+	{"1mm", "241G"},
+	{"submm", "LIGHT"},
+}
+
+// CabrilloFrequency renders the frequency column of a QSO line from a frequency in any form
+// accepted by ParseFrequencyMHz: whole kHz below 30 MHz ("14074") and the band designator from
+// 50 MHz up ("50", "144", "1.2G", "LIGHT").
+func CabrilloFrequency(freq string) (string, error) {
+	mhz, err := ParseFrequencyMHz(freq)
+	if err != nil {
+		return emptyString, err
+	}
+	if f, ok := cabrilloFrequencyMHz(mhz); ok {
+		return f, nil
+	}
+	return emptyString, fmt.Errorf("%w: %s MHz is outside the Cabrillo bands", ErrCabrilloQSO, freq)
+}
+
+// cabrilloFrequencyMHz renders the frequency column from a frequency in MHz, reporting false if it
+// lies outside the ADIF bands or on a band above 30 MHz that Cabrillo has no designator for.
+func cabrilloFrequencyMHz(mhz float64) (string, bool) {
+	band, ok := BandForMHz(mhz)
+	if !ok {
+		return emptyString, false
+	}
+	if mhz < 30 {
+		return strconv.Itoa(int(math.Round(mhz * 1000))), true
+	}
+	return cabrilloBandDesignator(band)
+}
+
+// cabrilloRecordFrequency renders the frequency column from a record's FREQ, which ADIF gives in
+// MHz, or, failing that, from a BAND of 6m or above, where Cabrillo needs only the band designator.
+func cabrilloRecordFrequency(rec ADIFRecord) (string, error) {
+	if freq := rec.Value("FREQ"); freq != emptyString {
+		mhz, err := strconv.ParseFloat(strings.TrimSpace(freq), 64)
+		if err != nil {
+			return emptyString, fmt.Errorf("%w: FREQ %q is not a frequency in MHz", ErrCabrilloQSO, freq)
+		}
+		f, ok := cabrilloFrequencyMHz(mhz)
+		if !ok {
+			return emptyString, fmt.Errorf("%w: FREQ %q MHz is outside the Cabrillo bands", ErrCabrilloQSO, freq)
+		}
+		return f, nil
+	}
+	if d, ok := cabrilloBandDesignator(rec.Value("BAND")); ok {
+		return d, nil
+	}
+	return emptyString, fmt.Errorf("%w: no FREQ, and BAND %q has no designator", ErrCabrilloQSO, rec.Value("BAND"))
+}
+
+// cabrilloMode maps an ADIF MODE to the Cabrillo mode column (CW, PH, FM, RY or DG).
+func cabrilloMode(mode string) string {
+	switch strings.ToUpper(mode) {
+	case "CW":
+		return "CW"
+	case "SSB", "AM", "DIGITALVOICE":
+		return "PH"
+	case "FM":
+		return "FM"
+	case "RTTY":
+		return "RY"
+	default:
+		return "DG"
+	}
+}
+
+// CabrilloWriter writes a Cabrillo 3.0 log: START-OF-LOG, the header tags, one QSO line per record
+// and END-OF-LOG. Output is buffered; Close writes END-OF-LOG and flushes.
+type CabrilloWriter struct {
+	w        *bufio.Writer
+	template CabrilloTemplate
+	callsign string
+	started  bool
+	closed   bool
+}
+
+// NewCabrilloWriter returns a writer that formats QSO lines with the given template.
+func NewCabrilloWriter(w io.Writer, template CabrilloTemplate) *CabrilloWriter {
+	return &CabrilloWriter{w: bufio.NewWriter(w), template: template}
+}
+
+// WriteHeader writes START-OF-LOG and the header tags. Tags must be Cabrillo 3.0 tags or start
+// with "X-". The CALLSIGN tag is used as the sent callsign of records without STATION_CALLSIGN.
+func (cw *CabrilloWriter) WriteHeader(tags []CabrilloTag) error {
+	if cw.started {
+		return fmt.Errorf("%w: header written twice or after QSOs", ErrCabrilloFormat)
+	}
+	for _, t := range tags {
+		name := strings.ToUpper(t.Name)
+		if !cabrilloHeaderTags[name] && !strings.HasPrefix(name, "X-") {
+			return fmt.Errorf("%w: %s", ErrCabrilloTag, t.Name)
+		}
+		if strings.ContainsAny(t.Value, "\r\n") {
+			return fmt.Errorf("%w: %s value spans lines", ErrCabrilloFormat, t.Name)
+		}
+	}
+	cw.start()
+	for _, t := range tags {
+		name := strings.ToUpper(t.Name)
+		if name == "CALLSIGN" {
+			cw.callsign = strings.ToUpper(strings.TrimSpace(t.Value))
+		}
+		cw.w.WriteString(strings.TrimRight(name+": "+t.Value, " ") + "\n")
+	}
+	return nil
+}
+
+func (cw *CabrilloWriter) start() {
+	if !cw.started {
+		cw.started = true
+		cw.w.WriteString("START-OF-LOG: 3.0\n")
+	}
+}
+
+// Write writes one record as a QSO line. The record needs CALL, QSO_DATE, TIME_ON, MODE and FREQ
+// or BAND, and a sent callsign from STATION_CALLSIGN, OPERATOR or the CALLSIGN header tag.
+// Every exchange field of the template must be present, without whitespace in its value, or
+// ErrCabrilloQSO is returned, since a blank or split column would shift the columns after it.
+func (cw *CabrilloWriter) Write(rec ADIFRecord) error {
+	if cw.closed {
+		return fmt.Errorf("%w: QSO written after Close", ErrCabrilloFormat)
+	}
+	line, err := cw.qsoLine(rec)
+	if err != nil {
+		return err
+	}
+	cw.start()
+	_, err = cw.w.WriteString(line + "\n")
+	return err
+}
+
+func (cw *CabrilloWriter) qsoLine(rec ADIFRecord) (string, error) {
+	freq, err := cabrilloRecordFrequency(rec)
+	if err != nil {
+		return emptyString, err
+	}
+	date, tm := rec.Value("QSO_DATE"), rec.Value("TIME_ON")
+	if !IsValidDateYYYYMMDD(date) || !IsValidTimeADIF(tm) {
+		return emptyString, fmt.Errorf("%w: invalid QSO_DATE %q or TIME_ON %q", ErrCabrilloQSO, date, tm)
+	}
+	sent := firstNonEmpty(rec.Value("STATION_CALLSIGN"), rec.Value("OPERATOR"), cw.callsign)
+	call := rec.Value("CALL")
+	if sent == emptyString || call == emptyString || rec.Value("MODE") == emptyString {
+		return emptyString, fmt.Errorf("%w: missing CALL, MODE or sent callsign", ErrCabrilloQSO)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "QSO: %5s %-2s %s %s %-13s", freq, cabrilloMode(rec.Value("MODE")), FormatDate(date), tm[:4], strings.ToUpper(sent))
+	for _, f := range cw.template.Sent {
+		v, err := cabrilloExchangeValue(rec, f)
+		if err != nil {
+			return emptyString, err
+		}
+		fmt.Fprintf(&sb, " %-*s", f.Width, v)
+	}
+	fmt.Fprintf(&sb, " %-13s", strings.ToUpper(call))
+	for _, f := range cw.template.Rcvd {
+		v, err := cabrilloExchangeValue(rec, f)
+		if err != nil {
+			return emptyString, err
+		}
+		fmt.Fprintf(&sb, " %-*s", f.Width, v)
+	}
+	if cw.template.TransmitterID {
+		t := rec.Value(cabrilloTransmitterField)
+		if t == emptyString {
+			t = "0"
+		}
+		sb.WriteString(" " + t)
+	}
+	line := strings.TrimRight(sb.String(), " ")
+	if strings.ContainsAny(line, "\r\n") {
+		return emptyString, fmt.Errorf("%w: field value spans lines", ErrCabrilloQSO)
+	}
+	return line, nil
+}
+
+// cabrilloExchangeValue returns an exchange column for a QSO line. The columns are separated by
+// whitespace, so a missing value or one containing whitespace would shift those that follow.
+func cabrilloExchangeValue(rec ADIFRecord, f CabrilloExchangeField) (string, error) {
+	v := strings.TrimSpace(rec.Value(f.Field))
+	if v == emptyString {
+		return emptyString, fmt.Errorf("%w: missing exchange field %s", ErrCabrilloQSO, f.Field)
+	}
+	if strings.IndexFunc(v, unicode.IsSpace) >= 0 {
+		return emptyString, fmt.Errorf("%w: exchange field %s %q contains whitespace", ErrCabrilloQSO, f.Field, v)
+	}
+	return strings.ToUpper(v), nil
+}
+
+// Close writes END-OF-LOG and flushes the log. It does not close the underlying writer.
+func (cw *CabrilloWriter) Close() error {
+	if cw.closed {
+		return nil
+	}
+	cw.start()
+	cw.closed = true
+	cw.w.WriteString("END-OF-LOG:\n")
+	return cw.w.Flush()
+}
+
+// CabrilloReader reads a submitted Cabrillo log back into ADIF records. QSO lines become records
+// with FREQ (MHz, for kHz frequencies) or BAND (for band designators), MODE (CW, SSB, FM or RTTY;
+// omitted for DG), QSO_DATE, TIME_ON, STATION_CALLSIGN, CALL and the template's exchange fields.
+// X-QSO lines, which the sponsor ignores for scoring, are skipped.
+type CabrilloReader struct {
+	sc       *bufio.Scanner
+	line     int
+	template *CabrilloTemplate
+	tags     []CabrilloTag
+	pending  string // first QSO line, read while reading the header
+	started  bool
+	done     bool
+	err      error
+}
+
+// NewCabrilloReader returns a reader for a Cabrillo log. If template is nil, the template is chosen
+// from the log's CONTEST tag with CabrilloTemplateFor.
+func NewCabrilloReader(r io.Reader, template *CabrilloTemplate) *CabrilloReader {
+	return &CabrilloReader{sc: bufio.NewScanner(r), template: template}
+}
+
+// Header returns the header tags in file order, excluding START-OF-LOG and END-OF-LOG.
+func (cr *CabrilloReader) Header() ([]CabrilloTag, error) {
+	if err := cr.start(); err != nil {
+		return nil, err
+	}
+	return cr.tags, nil
+}
+
+// Next returns the record for the next QSO line, or io.EOF after END-OF-LOG.
+func (cr *CabrilloReader) Next() (ADIFRecord, error) {
+	if err := cr.start(); err != nil {
+		return ADIFRecord{}, err
+	}
+	for !cr.done {
+		text := cr.pending
+		cr.pending = emptyString
+		if text == emptyString {
+			var err error
+			if text, err = cr.readLine(); err != nil {
+				cr.err = err
+				return ADIFRecord{}, err
+			}
+		}
+		tag, value := splitCabrilloLine(text)
+		switch tag {
+		case "QSO":
+			rec, err := cr.parseQSO(value)
+			if err != nil {
+				cr.err = err
+			}
+			return rec, err
+		case "END-OF-LOG":
+			cr.done = true
+		case emptyString, "X-QSO":
+		default:
+			cr.err = cr.formatError("unexpected %s after QSO lines", tag)
+			return ADIFRecord{}, cr.err
+		}
+	}
+	return ADIFRecord{}, io.EOF
+}
+
+func (cr *CabrilloReader) start() error {
+	if cr.started {
+		return cr.err
+	}
+	cr.started = true
+	for {
+		text, err := cr.readLine()
+		if err != nil {
+			cr.err = err
+			return err
+		}
+		tag, value := splitCabrilloLine(text)
+		if cr.line == 1 && tag != "START-OF-LOG" {
+			cr.err = cr.formatError("log does not begin with START-OF-LOG")
+			return cr.err
+		}
+		switch tag {
+		case "START-OF-LOG", emptyString:
+		case "QSO", "X-QSO", "END-OF-LOG":
+			cr.pending = text
+			if cr.template == nil {
+				t := CabrilloTemplateFor(cr.tagValue("CONTEST"))
+				cr.template = &t
+			}
+			return nil
+		default:
+			cr.tags = append(cr.tags, CabrilloTag{Name: tag, Value: value})
+		}
+	}
+}
+
+func (cr *CabrilloReader) tagValue(name string) string {
+	for _, t := range cr.tags {
+		if t.Name == name {
+			return t.Value
+		}
+	}
+	return emptyString
+}
+
+// readLine returns the next line, or a format error if the log ends before END-OF-LOG.
+func (cr *CabrilloReader) readLine() (string, error) {
+	if !cr.sc.Scan() {
+		if err := cr.sc.Err(); err != nil {
+			return emptyString, err
+		}
+		return emptyString, cr.formatError("log ends without END-OF-LOG")
+	}
+	cr.line++
+	return strings.TrimRight(cr.sc.Text(), " \t\r"), nil
+}
+
+// splitCabrilloLine splits "TAG: value" into the upper-cased tag and the trimmed value. A blank line
+// gives an empty tag.
+func splitCabrilloLine(text string) (string, string) {
+	tag, value, _ := strings.Cut(text, ":")
+	return strings.ToUpper(strings.TrimSpace(tag)), strings.TrimSpace(value)
+}
+
+func (cr *CabrilloReader) parseQSO(value string) (ADIFRecord, error) {
+	t := cr.template
+	cols := strings.Fields(value)
+	want := 4 + 1 + len(t.Sent) + 1 + len(t.Rcvd)
+	if len(cols) != want && !(t.TransmitterID && len(cols) == want+1) {
+		return ADIFRecord{}, cr.formatError("QSO line has %d columns, expected %d", len(cols), want)
+	}
+
+	var rec ADIFRecord
+	freq, mode, date, tm := cols[0], cols[1], cols[2], cols[3]
+	if khz, err := strconv.Atoi(freq); err == nil && khz >= 1000 {
+		rec.Set("FREQ", strconv.FormatFloat(float64(khz)/1000, 'f', -1, 64))
+	} else if band, ok := cabrilloDesignatorBand(freq); ok {
+		rec.Set("BAND", band)
+	} else {
+		return ADIFRecord{}, cr.formatError("invalid frequency %q", freq)
+	}
+	switch strings.ToUpper(mode) {
+	case "CW", "FM":
+		rec.Set("MODE", strings.ToUpper(mode))
+	case "PH":
+		rec.Set("MODE", "SSB")
+	case "RY":
+		rec.Set("MODE", "RTTY")
+	case "DG":
+	default:
+		return ADIFRecord{}, cr.formatError("invalid mode %q", mode)
+	}
+	d, hhmm := SanitizeDateToYYYYMMDD(date), SanitizeTimeToADIF(tm)
+	if d == emptyString || len(hhmm) != 4 {
+		return ADIFRecord{}, cr.formatError("invalid date %q or time %q", date, tm)
+	}
+	rec.Set("QSO_DATE", d)
+	rec.Set("TIME_ON", hhmm)
+
+	i := 4
+	rec.Set("STATION_CALLSIGN", strings.ToUpper(cols[i]))
+	i++
+	for _, f := range t.Sent {
+		rec.Set(f.Field, cols[i])
+		i++
+	}
+	rec.Set("CALL", strings.ToUpper(cols[i]))
+	i++
+	for _, f := range t.Rcvd {
+		rec.Set(f.Field, cols[i])
+		i++
+	}
+	if i < len(cols) {
+		rec.Set(cabrilloTransmitterField, cols[i])
+	}
+	return rec, nil
+}
+
+func cabrilloBandDesignator(band string) (string, bool) {
+	for _, b := range cabrilloBands {
+		if strings.EqualFold(b.band, band) {
+			return b.designator, true
+		}
+	}
+	return emptyString, false
+}
+
+func cabrilloDesignatorBand(designator string) (string, bool) {
+	for _, b := range cabrilloBands {
+		if strings.EqualFold(b.designator, designator) {
+			return b.band, true
+		}
+	}
+	return emptyString, false
+}
+
+func (cr *CabrilloReader) formatError(format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrCabrilloFormat, cr.line, fmt.Sprintf(format, args...))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != emptyString {
+			return v
+		}
+	}
+	return emptyString
+}
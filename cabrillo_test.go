@@ -0,0 +1,274 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCabrilloFrequency(t *testing.T) {
+	tests := map[string]string{
+		"14.074":  "14074",
+		"3.5255":  "3526",
+		"50.125":  "50",
+		"144.2":   "144",
+		"432.1":   "432",
+		"1296.1":  "1.2G",
+		"10368.1": "10G",
+	}
+	for in, want := range tests {
+		if got, err := CabrilloFrequency(in); err != nil || got != want {
+			t.Fatalf("CabrilloFrequency(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"31.0", "60.0", "abc"} {
+		if _, err := CabrilloFrequency(in); err == nil {
+			t.Fatalf("CabrilloFrequency(%q): expected an error", in)
+		}
+	}
+}
+
+func TestCabrilloTemplateFor(t *testing.T) {
+	tmpl := CabrilloTemplateFor("cq-ww-cw")
+	if tmpl.Contest != "CQ-WW-CW" || tmpl.Sent[1].Field != "MY_CQ_ZONE" || tmpl.Rcvd[1].Field != "CQZ" {
+		t.Fatalf("unexpected template %+v", tmpl)
+	}
+	if tmpl := CabrilloTemplateFor("CQ-WPX-SSB"); tmpl.Rcvd[1].Field != "SRX" {
+		t.Fatalf("unexpected template %+v", tmpl)
+	}
+	if tmpl := CabrilloTemplateFor("CQ-WWX"); tmpl.Rcvd[1].Field != "SRX_STRING" {
+		t.Fatalf("expected the default template, got %+v", tmpl)
+	}
+}
+
+func TestCabrilloWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCabrilloWriter(&buf, CabrilloTemplateFor("CQ-WW-CW"))
+	tags := []CabrilloTag{
+		{Name: "CALLSIGN", Value: "g4abc"},
+		{Name: "CONTEST", Value: "CQ-WW-CW"},
+		{Name: "SOAPBOX", Value: ""},
+		{Name: "X-RIG", Value: "homebrew"},
+	}
+	if err := w.WriteHeader(tags); err != nil {
+		t.Fatal(err)
+	}
+	recs := []ADIFRecord{
+		testADIFRecord("CALL", "w1aw", "FREQ", "14.0255", "MODE", "CW", "QSO_DATE", "20241123",
+			"TIME_ON", "000130", "RST_SENT", "599", "MY_CQ_ZONE", "14", "RST_RCVD", "599", "CQZ", "5"),
+		testADIFRecord("CALL", "DL1ABC", "BAND", "2m", "MODE", "SSB", "QSO_DATE", "20241123",
+			"TIME_ON", "0102", "STATION_CALLSIGN", "G4ABC/P", "RST_SENT", "59", "MY_CQ_ZONE", "14", "RST_RCVD", "59", "CQZ", "14"),
+	}
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := "START-OF-LOG: 3.0\n" +
+		"CALLSIGN: g4abc\n" +
+		"CONTEST: CQ-WW-CW\n" +
+		"SOAPBOX:\n" +
+		"X-RIG: homebrew\n" +
+		"QSO: 14026 CW 2024-11-23 0001 G4ABC         599 14     W1AW          599 5\n" +
+		"QSO:   144 PH 2024-11-23 0102 G4ABC/P       59  14     DL1ABC        59  14\n" +
+		"END-OF-LOG:\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected output\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	if err := w.Write(recs[0]); !errors.Is(err, ErrCabrilloFormat) {
+		t.Fatalf("expected ErrCabrilloFormat after Close, got %v", err)
+	}
+}
+
+func TestCabrilloWriter_WholeMHz(t *testing.T) {
+	tests := map[string]string{"50": "50", "144": "144", "14": "14000"}
+	for freq, want := range tests {
+		var buf bytes.Buffer
+		w := NewCabrilloWriter(&buf, CabrilloTemplateFor("CQ-WW-SSB"))
+		rec := testADIFRecord("CALL", "W1AW", "FREQ", freq, "MODE", "SSB", "QSO_DATE", "20240101",
+			"TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC", "RST_SENT", "59", "MY_CQ_ZONE", "14", "RST_RCVD", "59", "CQZ", "5")
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), fmt.Sprintf("QSO: %5s PH", want)) {
+			t.Fatalf("FREQ %q: unexpected output\n%s", freq, buf.String())
+		}
+	}
+}
+
+func TestCabrilloWriter_Errors(t *testing.T) {
+	w := NewCabrilloWriter(io.Discard, CabrilloTemplateFor("CQ-WPX-SSB"))
+	if err := w.WriteHeader([]CabrilloTag{{Name: "CATEGORY-COLOUR", Value: "RED"}}); !errors.Is(err, ErrCabrilloTag) {
+		t.Fatalf("expected ErrCabrilloTag, got %v", err)
+	}
+	bad := []ADIFRecord{
+		testADIFRecord("FREQ", "14.2", "MODE", "SSB", "QSO_DATE", "20240101", "TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC"),
+		testADIFRecord("CALL", "W1AW", "MODE", "SSB", "QSO_DATE", "20240101", "TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC"),
+		testADIFRecord("CALL", "W1AW", "FREQ", "14.2", "MODE", "SSB", "QSO_DATE", "20240101", "TIME_ON", "1200"),
+		testADIFRecord("CALL", "W1AW", "FREQ", "14.2", "MODE", "SSB", "QSO_DATE", "2024011", "TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC"),
+		testADIFRecord("CALL", "W1AW", "FREQ", "60", "MODE", "SSB", "QSO_DATE", "20240101", "TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC"),
+		testADIFRecord("CALL", "W1AW", "FREQ", "14074000", "MODE", "SSB", "QSO_DATE", "20240101", "TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC"),
+	}
+	for _, rec := range bad {
+		if err := w.Write(rec); !errors.Is(err, ErrCabrilloQSO) {
+			t.Fatalf("%+v: expected ErrCabrilloQSO, got %v", rec, err)
+		}
+	}
+}
+
+const testCabrillo = `START-OF-LOG: 3.0
+CALLSIGN: G4ABC
+CONTEST: CQ-WPX-SSB
+CATEGORY-TRANSMITTER: TWO
+SOAPBOX: first line
+SOAPBOX: second line
+
+QSO:  3799 PH 2024-03-30 0711 G4ABC         59  001    W1AW          59  123    1
+X-QSO: 14250 PH 2024-03-30 0712 G4ABC       59  002    K1ABC         59  7      0
+QSO:   144 FM 2024-03-30 0713 G4ABC         59  003    DL1ABC        59  42     0
+END-OF-LOG:
+`
+
+func TestCabrilloReader(t *testing.T) {
+	tmpl := CabrilloTemplateFor("CQ-WPX-SSB")
+	tmpl.TransmitterID = true
+	r := NewCabrilloReader(strings.NewReader(testCabrillo), &tmpl)
+	tags, err := r.Header()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 5 || tags[4] != (CabrilloTag{Name: "SOAPBOX", Value: "second line"}) {
+		t.Fatalf("unexpected header %+v", tags)
+	}
+
+	var recs []ADIFRecord
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(recs))
+	}
+	first := recs[0]
+	checks := map[string]string{
+		"FREQ": "3.799", "MODE": "SSB", "QSO_DATE": "20240330", "TIME_ON": "0711",
+		"STATION_CALLSIGN": "G4ABC", "STX": "001", "CALL": "W1AW", "SRX": "123",
+		cabrilloTransmitterField: "1",
+	}
+	for field, want := range checks {
+		if got := first.Value(field); got != want {
+			t.Fatalf("%s = %q, want %q", field, got, want)
+		}
+	}
+	if recs[1].Value("BAND") != "2m" || recs[1].Value("FREQ") != emptyString || recs[1].Value("MODE") != "FM" {
+		t.Fatalf("unexpected record %+v", recs[1])
+	}
+}
+
+func TestCabrilloReader_TemplateFromHeader(t *testing.T) {
+	in := "START-OF-LOG: 3.0\nCONTEST: CQ-WW-CW\nQSO: 7025 CW 2024-11-23 0001 G4ABC 599 14 W1AW 599 5\nEND-OF-LOG:\n"
+	rec, err := NewCabrilloReader(strings.NewReader(in), nil).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Value("CQZ") != "5" || rec.Value("MY_CQ_ZONE") != "14" || rec.Value("FREQ") != "7.025" {
+		t.Fatalf("unexpected record %+v", rec)
+	}
+}
+
+func TestCabrilloRoundTrip(t *testing.T) {
+	tmpl := CabrilloTemplateFor("CQ-WPX-SSB")
+	rec := testADIFRecord("CALL", "W1AW", "FREQ", "14.25", "MODE", "SSB", "QSO_DATE", "20240330",
+		"TIME_ON", "1200", "STATION_CALLSIGN", "G4ABC", "RST_SENT", "59", "STX", "12", "RST_RCVD", "57", "SRX", "345")
+
+	var buf bytes.Buffer
+	w := NewCabrilloWriter(&buf, tmpl)
+	if err := w.Write(rec); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	back, err := NewCabrilloReader(&buf, &tmpl).Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range rec.Fields {
+		if back.Value(f.Name) != f.Value {
+			t.Fatalf("%s = %q after round trip, want %q", f.Name, back.Value(f.Name), f.Value)
+		}
+	}
+}
+
+func TestCabrilloReader_Errors(t *testing.T) {
+	bad := []string{
+		"",
+		"CALLSIGN: G4ABC\nSTART-OF-LOG: 3.0\nEND-OF-LOG:\n",
+		"START-OF-LOG: 3.0\nQSO: 7025 CW 2024-11-23 0001 G4ABC 599 14 W1AW 599\nEND-OF-LOG:\n",
+		"START-OF-LOG: 3.0\nQSO: 7025 XX 2024-11-23 0001 G4ABC 599 14 W1AW 599 5\nEND-OF-LOG:\n",
+		"START-OF-LOG: 3.0\nQSO: 7025 CW 2024-13-23 0001 G4ABC 599 14 W1AW 599 5\nEND-OF-LOG:\n",
+		"START-OF-LOG: 3.0\nQSO: 7025 CW 2024-11-23 0001 G4ABC 599 14 W1AW 599 5\nSOAPBOX: late\nEND-OF-LOG:\n",
+		"START-OF-LOG: 3.0\nQSO: 7025 CW 2024-11-23 0001 G4ABC 599 14 W1AW 599 5\n",
+	}
+	tmpl := CabrilloTemplateFor("CQ-WW-CW")
+	for _, in := range bad {
+		r := NewCabrilloReader(strings.NewReader(in), &tmpl)
+		var err error
+		for err == nil {
+			_, err = r.Next()
+		}
+		if !errors.Is(err, ErrCabrilloFormat) {
+			t.Fatalf("%q: expected ErrCabrilloFormat, got %v", in, err)
+		}
+	}
+}
+
+func TestCabrilloRoundTrip_Exchange(t *testing.T) {
+	tmpl := CabrilloTemplateFor("NAQP-CW")
+	rec := testADIFRecord("CALL", "W1AW", "FREQ", "7.025", "MODE", "CW", "QSO_DATE", "20240113",
+		"TIME_ON", "1800", "STATION_CALLSIGN", "K1ABC", "MY_NAME", "JOE", "MY_STATE", "CT", "NAME", "BOB")
+	var buf bytes.Buffer
+	w := NewCabrilloWriter(&buf, tmpl)
+	bad := []ADIFRecord{rec, rec}
+	bad[0].Fields = append(append([]ADIFField(nil), rec.Fields...), ADIFField{Name: "STATE", Value: "NEW YORK"})
+	bad[1].Fields = append(append([]ADIFField(nil), rec.Fields...), ADIFField{Name: "STATE", Value: " "})
+	for _, b := range bad {
+		if err := w.Write(b); !errors.Is(err, ErrCabrilloQSO) {
+			t.Fatalf("STATE %q: expected ErrCabrilloQSO, got %v", b.Value("STATE"), err)
+		}
+	}
+	rec.Set("STATE", "NY")
+	if err := w.Write(rec); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r := NewCabrilloReader(&buf, &tmpl)
+	back, err := r.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Value("STATE") != "NY" || back.Value("NAME") != "BOB" || back.Value("CALL") != "W1AW" {
+		t.Fatalf("unexpected record %+v", back)
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Fatalf("expected a single QSO line, got %v", err)
+	}
+}
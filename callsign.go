@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrCallsignInvalid     = errors.New("invalid callsign")
+	ErrCallsignUnallocated = errors.New("callsign prefix is not in an ITU-allocated series")
+)
+
+// Callsign modifiers recognised after a '/'.
+const (
+	ModifierPortable     = "P"
+	ModifierMobile       = "M"
+	ModifierMaritime     = "MM"
+	ModifierAeronautical = "AM"
+	ModifierQRP          = "QRP"
+	ModifierAlternative  = "A"
+)
+
+// Callsign is a parsed, possibly compound, amateur callsign.
+//
+// HomeCall is the station's own call ("G4ABC" in "EA8/G4ABC/P"). Portable is the designator that
+// changes where the station operates from, either a prefix ("EA8", "VP2E") or a single call-area
+// digit ("7" in "W1AW/7"); it is empty for a station at home. Modifiers lists the recognised
+// suffixes (/P, /M, /MM, /AM, /QRP, /A) in the order written. Prefix is the prefix governing the
+// station's location: the portable prefix if there is one, the home prefix with its digit replaced
+// for a call-area change ("W7" for "W1AW/7"), or otherwise the home call's own prefix ("G4").
+// Maritime is true for /MM and /AM, where the station is not in any DXCC entity.
+type Callsign struct {
+	Call      string
+	HomeCall  string
+	Prefix    string
+	Portable  string
+	Modifiers []string
+	Maritime  bool
+}
+
+// baseCallRe matches a callsign without designators: an ITU series of one to three characters, the
+// call-area digits and a suffix of up to four characters ending in a letter.
+var baseCallRe = regexp.MustCompile(`^([A-Z]{1,2}|[A-Z][0-9]|[0-9][A-Z]{1,2})([0-9]{1,4})([A-Z0-9]{0,3}[A-Z])$`)
+
+// designatorRe matches a prefix used as a portable designator, such as "EA8", "F" or "VP2E".
+var designatorRe = regexp.MustCompile(`^[A-Z0-9]{1,4}$`)
+
+// ParseCallsign splits a callsign such as "EA8/G4ABC/P", "W1AW/7" or "VP2E/K1XX/MM" into its parts.
+// Input is trimmed and upper-cased. The home call must be a well-formed callsign in an ITU-allocated
+// series (see ValidITUPrefix); ErrCallsignInvalid or ErrCallsignUnallocated is returned otherwise.
+// Where both parts of a compound call could be a callsign, the shorter is taken as the designator,
+// and with equal lengths the first ("VP2E" in "VP2E/K1XX").
+func ParseCallsign(s string) (Callsign, error) {
+	c := Callsign{Call: strings.ToUpper(strings.TrimSpace(s))}
+	if c.Call == emptyString {
+		return Callsign{}, ErrCallsignInvalid
+	}
+
+	var parts []string
+	for _, part := range strings.Split(c.Call, "/") {
+		switch part {
+		case ModifierPortable, ModifierMobile, ModifierQRP, ModifierAlternative:
+			c.Modifiers = append(c.Modifiers, part)
+		case ModifierMaritime, ModifierAeronautical:
+			c.Modifiers = append(c.Modifiers, part)
+			c.Maritime = true
+		default:
+			parts = append(parts, part)
+		}
+	}
+
+	switch len(parts) {
+	case 1:
+		c.HomeCall = parts[0]
+	case 2:
+		first, second := parts[0], parts[1]
+		if len(first) > len(second) {
+			c.HomeCall, c.Portable = first, second
+		} else {
+			c.HomeCall, c.Portable = second, first
+		}
+	default:
+		return Callsign{}, ErrCallsignInvalid
+	}
+
+	m := baseCallRe.FindStringSubmatch(c.HomeCall)
+	if m == nil {
+		return Callsign{}, ErrCallsignInvalid
+	}
+	if !ValidITUPrefix(c.HomeCall) {
+		return Callsign{}, ErrCallsignUnallocated
+	}
+	homePrefix := m[1] + m[2]
+
+	switch {
+	case c.Portable == emptyString:
+		c.Prefix = homePrefix
+	case len(c.Portable) == 1 && isDigit(c.Portable[0]):
+		c.Prefix = homePrefix[:len(homePrefix)-1] + c.Portable
+	case designatorRe.MatchString(c.Portable):
+		c.Prefix = c.Portable
+	default:
+		return Callsign{}, ErrCallsignInvalid
+	}
+	return c, nil
+}
+
+// String returns the callsign as parsed (trimmed and upper-cased).
+func (c Callsign) String() string {
+	return c.Call
+}
+
+// HasModifier reports whether the callsign carries the given modifier, e.g. ModifierPortable.
+func (c Callsign) HasModifier(modifier string) bool {
+	for _, m := range c.Modifiers {
+		if m == modifier {
+			return true
+		}
+	}
+	return false
+}
+
+// DXCCCall returns the string that determines the callsign's DXCC entity, for use with a prefix
+// lookup such as CtyDatabase.Resolve: the effective prefix for portable operation and the home call
+// otherwise. It is empty for maritime and aeronautical mobile stations.
+func (c Callsign) DXCCCall() string {
+	switch {
+	case c.Maritime:
+		return emptyString
+	case c.Portable != emptyString:
+		return c.Prefix
+	default:
+		return c.HomeCall
+	}
+}
+
+// ValidITUPrefix reports whether a callsign (or prefix) begins with a series allocated for
+// callsigns by the ITU Radio Regulations (Appendix 42). The 1A, 1S, S0 and Z6 prefixes used by DXCC
+// entities without an ITU allocation are also accepted.
+func ValidITUPrefix(call string) bool {
+	call = strings.ToUpper(strings.TrimSpace(call))
+	if len(call) < 2 {
+		return false
+	}
+	c0, c1 := call[0], call[1]
+	switch {
+	case isUpperLetter(c0) && isUpperLetter(c1):
+		return c0 != 'Q'
+	case isUpperLetter(c0) && isDigit(c1):
+		return strings.IndexByte("BFGIKMNRW", c0) >= 0 || ituLetterDigitSeries[call[:2]]
+	case isDigit(c0) && isUpperLetter(c1):
+		return c0 >= '2' || call[:2] == "1A" || call[:2] == "1S"
+	}
+	return false
+}
+
+// ituLetterDigitSeries lists the allocated letter-digit series for countries without a single-letter
+// prefix, plus S0 (Western Sahara) and Z6 (Kosovo), which are used without an ITU allocation.
+var ituLetterDigitSeries = func() map[string]bool {
+	m := make(map[string]bool)
+	for _, series := range []string{
+		"A23456789", "C23456789", "D23456789", "E234567", "H234", "H6789", "J2345678", "L23456789",
+		"P23456789", "S0235", "S6789", "T2345678", "V2345678", "Y23456789", "Z2368",
+	} {
+		for _, d := range series[1:] {
+			m[series[:1]+string(d)] = true
+		}
+	}
+	return m
+}()
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isUpperLetter(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseCallsign(t *testing.T) {
+	cases := []struct {
+		in        string
+		home      string
+		prefix    string
+		portable  string
+		modifiers []string
+		maritime  bool
+		dxccCall  string
+	}{
+		{"G4ABC", "G4ABC", "G4", "", nil, false, "G4ABC"},
+		{" w1aw ", "W1AW", "W1", "", nil, false, "W1AW"},
+		{"EA8/G4ABC/P", "G4ABC", "EA8", "EA8", []string{"P"}, false, "EA8"},
+		{"G4ABC/EA8", "G4ABC", "EA8", "EA8", nil, false, "EA8"},
+		{"W1AW/7", "W1AW", "W7", "7", nil, false, "W7"},
+		{"JA1ABC/6", "JA1ABC", "JA6", "6", nil, false, "JA6"},
+		{"UA1ABC/9", "UA1ABC", "UA9", "9", nil, false, "UA9"},
+		{"VP2E/K1XX/MM", "K1XX", "VP2E", "VP2E", []string{"MM"}, true, ""},
+		{"G4ABC/AM", "G4ABC", "G4", "", []string{"AM"}, true, ""},
+		{"K1ABC/M", "K1ABC", "K1", "", []string{"M"}, false, "K1ABC"},
+		{"DL1ABC/QRP", "DL1ABC", "DL1", "", []string{"QRP"}, false, "DL1ABC"},
+		{"W1AW/A", "W1AW", "W1", "", []string{"A"}, false, "W1AW"},
+		{"F/G4ABC/P/QRP", "G4ABC", "F", "F", []string{"P", "QRP"}, false, "F"},
+		{"KH6/W1AW", "W1AW", "KH6", "KH6", nil, false, "KH6"},
+		{"3DA0XY", "3DA0XY", "3DA0", "", nil, false, "3DA0XY"},
+		{"2E0ABC", "2E0ABC", "2E0", "", nil, false, "2E0ABC"},
+		{"A61ABC", "A61ABC", "A61", "", nil, false, "A61ABC"},
+		{"4U1UN", "4U1UN", "4U1", "", nil, false, "4U1UN"},
+		{"1A0KM", "1A0KM", "1A0", "", nil, false, "1A0KM"},
+		{"SX2004A", "SX2004A", "SX2004", "", nil, false, "SX2004A"},
+	}
+	for _, c := range cases {
+		got, err := ParseCallsign(c.in)
+		if err != nil {
+			t.Fatalf("ParseCallsign(%q): %v", c.in, err)
+		}
+		if got.HomeCall != c.home || got.Prefix != c.prefix || got.Portable != c.portable ||
+			!reflect.DeepEqual(got.Modifiers, c.modifiers) || got.Maritime != c.maritime || got.DXCCCall() != c.dxccCall {
+			t.Fatalf("ParseCallsign(%q) = %+v (DXCCCall %q)", c.in, got, got.DXCCCall())
+		}
+	}
+
+	cs, _ := ParseCallsign("ea8/g4abc/p")
+	if cs.String() != "EA8/G4ABC/P" || !cs.HasModifier(ModifierPortable) || cs.HasModifier(ModifierMobile) {
+		t.Fatalf("unexpected %+v", cs)
+	}
+}
+
+func TestParseCallsign_Invalid(t *testing.T) {
+	invalid := []string{"", "/", "G4ABC/EA8/DL", "ABC", "G4", "1234", "G4ABC/EA-8", "G4ABC//", "W1AW/TOOLONG"}
+	for _, in := range invalid {
+		if _, err := ParseCallsign(in); !errors.Is(err, ErrCallsignInvalid) {
+			t.Fatalf("ParseCallsign(%q) expected ErrCallsignInvalid, got %v", in, err)
+		}
+	}
+	unallocated := []string{"QA1ABC", "0A1ABC", "1B1ABC", "E91ABC", "H51ABC", "X51ABC"}
+	for _, in := range unallocated {
+		if _, err := ParseCallsign(in); !errors.Is(err, ErrCallsignUnallocated) {
+			t.Fatalf("ParseCallsign(%q) expected ErrCallsignUnallocated, got %v", in, err)
+		}
+	}
+}
+
+func TestValidITUPrefix(t *testing.T) {
+	for _, p := range []string{"G", "K1", "VP2E", "A6", "E7", "3DA", "4U", "9M6", "T8", "Z8", "S0", "Z6", "1A", "1S", "R1FJ"} {
+		if !ValidITUPrefix(p + "ABC") {
+			t.Fatalf("expected %s to be allocated", p)
+		}
+	}
+	for _, p := range []string{"Q", "A1", "E8", "J9", "S4", "Z9", "0A", "1B", "", "9"} {
+		if ValidITUPrefix(p) {
+			t.Fatalf("expected %q to be unallocated", p)
+		}
+	}
+}
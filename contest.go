@@ -0,0 +1,360 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrContestQSO = errors.New("QSO cannot be scored")
+
+// ContestStation is the station entering a contest. Continent, CQZone and ITUZone are taken from
+// the DXCC entity by NewContestScorer when left empty and the entity has a single zone.
+type ContestStation struct {
+	Call      string
+	DXCC      int
+	Continent string
+	CQZone    int
+	ITUZone   int
+}
+
+// ContestContact is a QSO prepared for scoring: the record with the values the rules need. The
+// continent and zones come from the CONT, CQZ and ITUZ fields or, failing those, the DXCC entity.
+type ContestContact struct {
+	Record    ADIFRecord
+	Call      string
+	Band      string
+	Mode      string // mode family, see ModeFamily
+	DXCC      int
+	Continent string
+	CQZone    int
+	ITUZone   int
+}
+
+// ContestMult is one kind of multiplier. Key returns the multiplier a contact counts for, or "" if
+// none; PerBand counts each multiplier once per band rather than once overall.
+type ContestMult struct {
+	Name    string
+	PerBand bool
+	Key     func(st ContestStation, c ContestContact) string
+}
+
+// ContestRules describes how a contest is scored: the points for each QSO, the multipliers, the
+// dupe rules and the exchange format. The claimed score is the QSO points times the multipliers.
+// The rules shipped for CQ WW, CQ WPX, ARRL DX and IARU HF can be copied and changed, or new rules
+// written from scratch.
+type ContestRules struct {
+	Contest  string // Cabrillo CONTEST value
+	Dupes    DupeRules
+	Exchange CabrilloTemplate
+	Points   func(st ContestStation, c ContestContact) int
+	Mults    []ContestMult
+}
+
+// ContestPoints scores a QSO by where the two stations are. SameContinentNA, when non-zero,
+// replaces SameContinent for QSOs between two North American stations. LowBandFactor, when
+// non-zero, multiplies the points for QSOs between different countries on 160m, 80m and 40m.
+type ContestPoints struct {
+	SameCountry     int
+	SameContinent   int
+	SameContinentNA int
+	OtherContinent  int
+	LowBandFactor   int
+}
+
+// Points returns the points for a contact, for use as ContestRules.Points.
+func (p ContestPoints) Points(st ContestStation, c ContestContact) int {
+	if c.DXCC == st.DXCC {
+		return p.SameCountry
+	}
+	points := p.OtherContinent
+	if c.Continent == st.Continent {
+		points = p.SameContinent
+		if p.SameContinentNA != 0 && st.Continent == "NA" {
+			points = p.SameContinentNA
+		}
+	}
+	if p.LowBandFactor != 0 && (c.Band == "160m" || c.Band == "80m" || c.Band == "40m") {
+		points *= p.LowBandFactor
+	}
+	return points
+}
+
+// ContestQSOScore is what one QSO added to the score.
+type ContestQSOScore struct {
+	Dupe     bool
+	Points   int
+	NewMults []ContestNewMult
+}
+
+// ContestNewMult is a multiplier worked for the first time.
+type ContestNewMult struct {
+	Name string
+	Key  string
+	Band string // empty for multipliers counted once overall
+}
+
+// ContestScore is the running claimed score. Mults holds the count for each of the rules' Mults.
+type ContestScore struct {
+	QSOs      int
+	Dupes     int
+	Points    int
+	Mults     []int
+	MultTotal int
+	Claimed   int
+}
+
+// ContestScorer keeps the claimed score of a contest log up to date as QSOs are added. Each QSO
+// costs a dupe check and a map lookup per multiplier, however long the log.
+type ContestScorer struct {
+	rules   ContestRules
+	station ContestStation
+	dupes   *DupeChecker
+	worked  []map[string]bool
+	score   ContestScore
+}
+
+// NewContestScorer returns a scorer for station under rules.
+func NewContestScorer(rules ContestRules, station ContestStation) *ContestScorer {
+	station.Call = strings.ToUpper(strings.TrimSpace(station.Call))
+	if e, ok := LookupDXCC(station.DXCC); ok {
+		station.Continent, station.CQZone, station.ITUZone = contestDXCCDefaults(e, station.Continent, station.CQZone, station.ITUZone)
+	}
+	s := &ContestScorer{
+		rules:   rules,
+		station: station,
+		dupes:   NewDupeChecker(rules.Dupes),
+		worked:  make([]map[string]bool, len(rules.Mults)),
+		score:   ContestScore{Mults: make([]int, len(rules.Mults))},
+	}
+	for i := range s.worked {
+		s.worked[i] = make(map[string]bool)
+	}
+	return s
+}
+
+// Add scores a QSO and adds it to the log. A dupe is logged but scores nothing. The QSO needs CALL,
+// BAND or FREQ, a DXCC entity and whatever the dupe rules need.
+func (s *ContestScorer) Add(rec ADIFRecord) (ContestQSOScore, error) {
+	c, err := NewContestContact(rec)
+	if err != nil {
+		return ContestQSOScore{}, err
+	}
+	dupe, err := s.dupes.IsDupe(rec)
+	if err != nil {
+		return ContestQSOScore{}, fmt.Errorf("%w: %w", ErrContestQSO, err)
+	}
+	if _, err := s.dupes.Add(rec); err != nil {
+		return ContestQSOScore{}, fmt.Errorf("%w: %w", ErrContestQSO, err)
+	}
+	s.score.QSOs++
+	if dupe {
+		s.score.Dupes++
+		return ContestQSOScore{Dupe: true}, nil
+	}
+
+	var qs ContestQSOScore
+	if s.rules.Points != nil {
+		qs.Points = s.rules.Points(s.station, c)
+	}
+	s.score.Points += qs.Points
+	for i, m := range s.rules.Mults {
+		key := m.Key(s.station, c)
+		if key == emptyString {
+			continue
+		}
+		nm := ContestNewMult{Name: m.Name, Key: key}
+		if m.PerBand {
+			nm.Band = c.Band
+		}
+		if k := nm.Band + "|" + key; !s.worked[i][k] {
+			s.worked[i][k] = true
+			s.score.Mults[i]++
+			s.score.MultTotal++
+			qs.NewMults = append(qs.NewMults, nm)
+		}
+	}
+	s.score.Claimed = s.score.Points * s.score.MultTotal
+	return qs, nil
+}
+
+// Score returns the score so far.
+func (s *ContestScorer) Score() ContestScore {
+	score := s.score
+	score.Mults = append([]int(nil), s.score.Mults...)
+	return score
+}
+
+// NewContestContact prepares a QSO for scoring.
+func NewContestContact(rec ADIFRecord) (ContestContact, error) {
+	c := ContestContact{Record: rec, Call: strings.ToUpper(strings.TrimSpace(rec.Value("CALL")))}
+	if c.Call == emptyString {
+		return c, fmt.Errorf("%w: no CALL", ErrContestQSO)
+	}
+	band, ok := RecordBand(rec)
+	if !ok {
+		return c, fmt.Errorf("%w: %s: no BAND or FREQ", ErrContestQSO, c.Call)
+	}
+	e, ok := LookupDXCCString(rec.Value("DXCC"))
+	if !ok {
+		return c, fmt.Errorf("%w: %s: no DXCC entity", ErrContestQSO, c.Call)
+	}
+	c.Band, c.Mode, c.DXCC = band, ModeFamily(rec.Value("MODE")), e.Code
+	cqz, _ := strconv.Atoi(strings.TrimSpace(rec.Value("CQZ")))
+	ituz, _ := strconv.Atoi(strings.TrimSpace(rec.Value("ITUZ")))
+	c.Continent, c.CQZone, c.ITUZone = contestDXCCDefaults(e, strings.ToUpper(strings.TrimSpace(rec.Value("CONT"))), cqz, ituz)
+	return c, nil
+}
+
+// contestDXCCDefaults fills in a missing continent or zone from a DXCC entity that has only one.
+func contestDXCCDefaults(e DXCCEntity, cont string, cqz, ituz int) (string, int, int) {
+	if cont == emptyString {
+		cont = e.Continent
+	}
+	if cqz == 0 && len(e.CQZones) == 1 {
+		cqz = e.CQZones[0]
+	}
+	if ituz == 0 && len(e.ITUZones) == 1 {
+		ituz = e.ITUZones[0]
+	}
+	return cont, cqz, ituz
+}
+
+// ContestRulesFor returns the shipped rules for a Cabrillo CONTEST value: the contest name alone or
+// with a CW or phone suffix ("CQ-WW-CW", "ARRL-DX-SSB"). Other modes, such as CQ-WW-RTTY, are
+// scored differently and are not shipped.
+func ContestRulesFor(contest string) (ContestRules, bool) {
+	contest = strings.ToUpper(strings.TrimSpace(contest))
+	for _, r := range []ContestRules{ContestCQWW, ContestCQWPX, ContestARRLDX, ContestIARUHF} {
+		mode, ok := strings.CutPrefix(contest, r.Contest)
+		if !ok {
+			continue
+		}
+		switch mode {
+		case emptyString, "-CW", "-SSB", "-PH":
+			r.Exchange.Contest = contest
+			return r, true
+		}
+	}
+	return ContestRules{}, false
+}
+
+var (
+	// ContestCQWW scores the CQ World Wide DX contest: 3 points between continents, 1 within a
+	// continent (2 between North American stations), 0 within a country; CQ zones and DXCC
+	// entities are multipliers on each band.
+	ContestCQWW = ContestRules{
+		Contest:  "CQ-WW",
+		Dupes:    DupeRulesContestBand,
+		Exchange: CabrilloTemplateFor("CQ-WW"),
+		Points:   ContestPoints{SameCountry: 0, SameContinent: 1, SameContinentNA: 2, OtherContinent: 3}.Points,
+		Mults: []ContestMult{
+			{Name: "Zones", PerBand: true, Key: contestCQZone},
+			{Name: "Countries", PerBand: true, Key: contestDXCC},
+		},
+	}
+
+	// ContestCQWPX scores the CQ WPX contest: 3 points between continents and 1 within a continent
+	// (2 between North American stations), doubled on 160m, 80m and 40m, and 1 within a country;
+	// each WPX prefix is a multiplier once.
+	ContestCQWPX = ContestRules{
+		Contest:  "CQ-WPX",
+		Dupes:    DupeRulesContestBand,
+		Exchange: CabrilloTemplateFor("CQ-WPX"),
+		Points:   ContestPoints{SameCountry: 1, SameContinent: 1, SameContinentNA: 2, OtherContinent: 3, LowBandFactor: 2}.Points,
+		Mults:    []ContestMult{{Name: "Prefixes", Key: contestWPXPrefix}},
+	}
+
+	// ContestARRLDX scores the ARRL International DX contest, in which the USA and Canada (W/VE)
+	// work the rest of the world: 3 points per W/VE to DX QSO. W/VE stations count DXCC entities
+	// and DX stations count states and provinces (STATE, or else SRX_STRING) on each band.
+	ContestARRLDX = ContestRules{
+		Contest:  "ARRL-DX",
+		Dupes:    DupeRulesContestBand,
+		Exchange: CabrilloTemplateFor("ARRL-DX"),
+		Points: func(st ContestStation, c ContestContact) int {
+			if arrlDXWVE(st.DXCC) != arrlDXWVE(c.DXCC) {
+				return 3
+			}
+			return 0
+		},
+		Mults: []ContestMult{{Name: "Multipliers", PerBand: true, Key: arrlDXMult}},
+	}
+
+	// ContestIARUHF scores the IARU HF World Championship: 1 point within the ITU zone or with an
+	// IARU society HQ station, 3 within the continent and 5 between continents; ITU zones and HQ
+	// stations (whose SRX_STRING names the society) are multipliers on each band.
+	ContestIARUHF = ContestRules{
+		Contest:  "IARU-HF",
+		Dupes:    DupeRulesContestBandMode,
+		Exchange: CabrilloTemplateFor("IARU-HF"),
+		Points: func(st ContestStation, c ContestContact) int {
+			switch {
+			case iaruHQ(c) != emptyString, c.ITUZone != 0 && c.ITUZone == st.ITUZone:
+				return 1
+			case c.Continent == st.Continent:
+				return 3
+			default:
+				return 5
+			}
+		},
+		Mults: []ContestMult{
+			{Name: "ITU zones", PerBand: true, Key: func(_ ContestStation, c ContestContact) string {
+				if iaruHQ(c) != emptyString || c.ITUZone == 0 {
+					return emptyString
+				}
+				return strconv.Itoa(c.ITUZone)
+			}},
+			{Name: "HQ stations", PerBand: true, Key: func(_ ContestStation, c ContestContact) string { return iaruHQ(c) }},
+		},
+	}
+)
+
+func contestCQZone(_ ContestStation, c ContestContact) string {
+	if c.CQZone == 0 {
+		return emptyString
+	}
+	return strconv.Itoa(c.CQZone)
+}
+
+func contestDXCC(_ ContestStation, c ContestContact) string {
+	return strconv.Itoa(c.DXCC)
+}
+
+func contestWPXPrefix(_ ContestStation, c ContestContact) string {
+	if pfx := strings.ToUpper(strings.TrimSpace(c.Record.Value("PFX"))); pfx != emptyString {
+		return pfx
+	}
+	pfx, _ := WPXPrefix(c.Call)
+	return pfx
+}
+
+// arrlDXWVE reports whether an entity is W/VE for the ARRL DX contest: the contiguous USA or
+// Canada. Alaska and Hawaii are DX.
+func arrlDXWVE(dxcc int) bool {
+	return dxcc == 291 || dxcc == 1
+}
+
+func arrlDXMult(st ContestStation, c ContestContact) string {
+	switch {
+	case arrlDXWVE(st.DXCC) == arrlDXWVE(c.DXCC):
+		return emptyString
+	case arrlDXWVE(st.DXCC):
+		return strconv.Itoa(c.DXCC)
+	default:
+		return strings.ToUpper(strings.TrimSpace(firstNonEmpty(c.Record.Value("STATE"), c.Record.Value("SRX_STRING"))))
+	}
+}
+
+// iaruHQ returns the society a HQ station sent in place of its zone, or "".
+func iaruHQ(c ContestContact) string {
+	x := strings.ToUpper(strings.TrimSpace(c.Record.Value("SRX_STRING")))
+	if x == emptyString {
+		return emptyString
+	}
+	if _, err := strconv.Atoi(x); err == nil {
+		return emptyString
+	}
+	return x
+}
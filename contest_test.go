@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func scoreQSOs(t *testing.T, s *ContestScorer, recs ...ADIFRecord) []ContestQSOScore {
+	t.Helper()
+	var out []ContestQSOScore
+	for _, rec := range recs {
+		qs, err := s.Add(rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, qs)
+	}
+	return out
+}
+
+func TestContestScorer_CQWW(t *testing.T) {
+	rules, ok := ContestRulesFor("cq-ww-cw")
+	if !ok || rules.Exchange.Contest != "CQ-WW-CW" || rules.Exchange.Rcvd[1].Field != "CQZ" {
+		t.Fatalf("unexpected rules %+v", rules)
+	}
+	// G4ABC: England (223), EU, zone 14.
+	s := NewContestScorer(rules, ContestStation{Call: "g4abc", DXCC: 223})
+	got := scoreQSOs(t, s,
+		testADIFRecord("CALL", "W1AW", "BAND", "20m", "MODE", "CW", "DXCC", "291", "CQZ", "5"),
+		testADIFRecord("CALL", "DL1ABC", "BAND", "20m", "MODE", "CW", "DXCC", "230"),
+		testADIFRecord("CALL", "G3XYZ", "BAND", "20m", "MODE", "CW", "DXCC", "223"),
+		testADIFRecord("CALL", "W1AW", "BAND", "20m", "MODE", "CW", "DXCC", "291", "CQZ", "5"),
+		testADIFRecord("CALL", "W1AW", "FREQ", "7.010", "MODE", "CW", "DXCC", "291", "CQZ", "5"),
+	)
+	want := []ContestQSOScore{
+		{Points: 3, NewMults: []ContestNewMult{{"Zones", "5", "20m"}, {"Countries", "291", "20m"}}},
+		{Points: 1, NewMults: []ContestNewMult{{"Zones", "14", "20m"}, {"Countries", "230", "20m"}}},
+		{Points: 0, NewMults: []ContestNewMult{{"Countries", "223", "20m"}}},
+		{Dupe: true},
+		{Points: 3, NewMults: []ContestNewMult{{"Zones", "5", "40m"}, {"Countries", "291", "40m"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected QSO scores\n%+v\nwant\n%+v", got, want)
+	}
+	score := s.Score()
+	wantScore := ContestScore{QSOs: 5, Dupes: 1, Points: 7, Mults: []int{3, 4}, MultTotal: 7, Claimed: 49}
+	if !reflect.DeepEqual(score, wantScore) {
+		t.Fatalf("unexpected score %+v", score)
+	}
+	score.Mults[0] = 99
+	if s.Score().Mults[0] != 3 {
+		t.Fatal("Score shares its multiplier counts")
+	}
+}
+
+func TestContestScorer_NorthAmerica(t *testing.T) {
+	s := NewContestScorer(ContestCQWW, ContestStation{Call: "W1AW", DXCC: 291, CQZone: 5})
+	got := scoreQSOs(t, s,
+		testADIFRecord("CALL", "VE3ABC", "BAND", "20m", "MODE", "SSB", "DXCC", "1", "CQZ", "4"),
+		testADIFRecord("CALL", "K6ABC", "BAND", "20m", "MODE", "SSB", "DXCC", "291", "CQZ", "3"),
+	)
+	if got[0].Points != 2 || got[1].Points != 0 {
+		t.Fatalf("unexpected points %+v", got)
+	}
+}
+
+func TestContestScorer_CQWPX(t *testing.T) {
+	s := NewContestScorer(ContestCQWPX, ContestStation{Call: "DL1ABC", DXCC: 230})
+	got := scoreQSOs(t, s,
+		testADIFRecord("CALL", "W1AW", "BAND", "40m", "MODE", "SSB", "DXCC", "291"),
+		testADIFRecord("CALL", "W1XYZ", "BAND", "20m", "MODE", "SSB", "DXCC", "291"),
+		testADIFRecord("CALL", "G4ABC", "BAND", "80m", "MODE", "SSB", "DXCC", "223"),
+		testADIFRecord("CALL", "DK2ZZ", "BAND", "80m", "MODE", "SSB", "DXCC", "230", "PFX", "dk2"),
+	)
+	var points []int
+	for _, qs := range got {
+		points = append(points, qs.Points)
+	}
+	if !reflect.DeepEqual(points, []int{6, 3, 2, 1}) || got[1].NewMults != nil {
+		t.Fatalf("unexpected QSO scores %+v", got)
+	}
+	if sc := s.Score(); sc.MultTotal != 3 || sc.Claimed != 36 {
+		t.Fatalf("unexpected score %+v", sc)
+	}
+}
+
+func TestContestScorer_ARRLDX(t *testing.T) {
+	dx := NewContestScorer(ContestARRLDX, ContestStation{Call: "G4ABC", DXCC: 223})
+	got := scoreQSOs(t, dx,
+		testADIFRecord("CALL", "W1AW", "BAND", "20m", "MODE", "CW", "DXCC", "291", "SRX_STRING", "ct"),
+		testADIFRecord("CALL", "VE3ABC", "BAND", "20m", "MODE", "CW", "DXCC", "1", "STATE", "ON"),
+		testADIFRecord("CALL", "DL1ABC", "BAND", "20m", "MODE", "CW", "DXCC", "230"),
+		testADIFRecord("CALL", "KL7XX", "BAND", "20m", "MODE", "CW", "DXCC", "6", "STATE", "AK"),
+	)
+	want := []ContestQSOScore{
+		{Points: 3, NewMults: []ContestNewMult{{"Multipliers", "CT", "20m"}}},
+		{Points: 3, NewMults: []ContestNewMult{{"Multipliers", "ON", "20m"}}},
+		{},
+		{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected QSO scores %+v", got)
+	}
+
+	wve := NewContestScorer(ContestARRLDX, ContestStation{Call: "W1AW", DXCC: 291})
+	got = scoreQSOs(t, wve,
+		testADIFRecord("CALL", "KL7XX", "BAND", "15m", "MODE", "CW", "DXCC", "6"),
+		testADIFRecord("CALL", "VE3ABC", "BAND", "15m", "MODE", "CW", "DXCC", "1"),
+	)
+	if got[0].Points != 3 || got[0].NewMults[0].Key != "6" || got[1].Points != 0 || got[1].NewMults != nil {
+		t.Fatalf("unexpected QSO scores %+v", got)
+	}
+}
+
+func TestContestScorer_IARUHF(t *testing.T) {
+	// G4ABC: ITU zone 27.
+	s := NewContestScorer(ContestIARUHF, ContestStation{Call: "G4ABC", DXCC: 223})
+	got := scoreQSOs(t, s,
+		testADIFRecord("CALL", "G3XYZ", "BAND", "20m", "MODE", "CW", "DXCC", "223", "ITUZ", "27"),
+		testADIFRecord("CALL", "DL1ABC", "BAND", "20m", "MODE", "CW", "DXCC", "230", "ITUZ", "28"),
+		testADIFRecord("CALL", "W1AW", "BAND", "20m", "MODE", "CW", "DXCC", "291", "SRX_STRING", "ARRL"),
+		testADIFRecord("CALL", "JA1XYZ", "BAND", "20m", "MODE", "CW", "DXCC", "339", "ITUZ", "45"),
+		testADIFRecord("CALL", "JA1XYZ", "BAND", "20m", "MODE", "SSB", "DXCC", "339", "ITUZ", "45"),
+	)
+	var points []int
+	for _, qs := range got {
+		points = append(points, qs.Points)
+	}
+	if !reflect.DeepEqual(points, []int{1, 3, 1, 5, 5}) {
+		t.Fatalf("unexpected points %v", points)
+	}
+	if got[2].NewMults[0] != (ContestNewMult{"HQ stations", "ARRL", "20m"}) {
+		t.Fatalf("unexpected mults %+v", got[2])
+	}
+	if sc := s.Score(); !reflect.DeepEqual(sc.Mults, []int{3, 1}) || sc.Claimed != 15*4 {
+		t.Fatalf("unexpected score %+v", sc)
+	}
+}
+
+func TestContestScorer_Errors(t *testing.T) {
+	s := NewContestScorer(ContestCQWW, ContestStation{Call: "G4ABC", DXCC: 223})
+	bad := []ADIFRecord{
+		testADIFRecord("BAND", "20m", "DXCC", "291"),
+		testADIFRecord("CALL", "W1AW", "DXCC", "291"),
+		testADIFRecord("CALL", "W1AW", "BAND", "20m"),
+	}
+	for _, rec := range bad {
+		if _, err := s.Add(rec); !errors.Is(err, ErrContestQSO) {
+			t.Fatalf("%+v: expected ErrContestQSO, got %v", rec.Fields, err)
+		}
+	}
+	if s.Score().QSOs != 0 {
+		t.Fatal("rejected QSOs were counted")
+	}
+	for _, contest := range []string{"NAQP-CW", "CQ-WW-RTTY", "CQ-WPX-RTTY", "CQ-WWX"} {
+		if _, ok := ContestRulesFor(contest); ok {
+			t.Fatalf("expected no rules for %s", contest)
+		}
+	}
+	if r, ok := ContestRulesFor("arrl-dx-ph"); !ok || r.Contest != "ARRL-DX" {
+		t.Fatalf("unexpected rules %+v", r)
+	}
+}
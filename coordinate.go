@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrLatitudeOutOfRange  = errors.New("latitude out of range (must be between -90 and 90 degrees)")
+	ErrLongitudeOutOfRange = errors.New("longitude out of range (must be between -180 and 180 degrees)")
+	ErrCoordinateAxis      = errors.New("coordinate axis must be latitude or longitude")
+	ErrCoordinateNaN       = errors.New("coordinate is not a finite number")
+)
+
+// Axis identifies whether a Coordinate measures latitude or longitude.
+type Axis int
+
+const (
+	// AxisUnknown is used when the axis cannot be determined, e.g. a bare signed decimal.
+	AxisUnknown Axis = iota
+	// AxisLatitude measures north (+) / south (-) of the equator, in the range ±90.
+	AxisLatitude
+	// AxisLongitude measures east (+) / west (-) of the prime meridian, in the range ±180.
+	AxisLongitude
+)
+
+// String returns a human-readable name for the axis.
+func (a Axis) String() string {
+	switch a {
+	case AxisLatitude:
+		return "latitude"
+	case AxisLongitude:
+		return "longitude"
+	default:
+		return "unknown"
+	}
+}
+
+// Limit returns the maximum absolute value, in degrees, allowed for the axis.
+// AxisUnknown allows the wider longitude range.
+func (a Axis) Limit() float64 {
+	if a == AxisLatitude {
+		return 90
+	}
+	return 180
+}
+
+// Coordinate is a single latitude or longitude value in signed decimal degrees.
+type Coordinate struct {
+	Axis    Axis
+	Degrees float64
+}
+
+// NewLatitude returns a latitude Coordinate, or ErrLatitudeOutOfRange if deg is outside ±90.
+func NewLatitude(deg float64) (Coordinate, error) {
+	c := Coordinate{Axis: AxisLatitude, Degrees: deg}
+	return c, c.Validate()
+}
+
+// NewLongitude returns a longitude Coordinate, or ErrLongitudeOutOfRange if deg is outside ±180.
+func NewLongitude(deg float64) (Coordinate, error) {
+	c := Coordinate{Axis: AxisLongitude, Degrees: deg}
+	return c, c.Validate()
+}
+
+// Validate checks that the coordinate has a known axis and lies within that axis' range.
+func (c Coordinate) Validate() error {
+	if math.IsNaN(c.Degrees) || math.IsInf(c.Degrees, 0) {
+		return ErrCoordinateNaN
+	}
+	switch c.Axis {
+	case AxisLatitude:
+		if c.Degrees < -90 || c.Degrees > 90 {
+			return ErrLatitudeOutOfRange
+		}
+	case AxisLongitude:
+		if c.Degrees < -180 || c.Degrees > 180 {
+			return ErrLongitudeOutOfRange
+		}
+	default:
+		return ErrCoordinateAxis
+	}
+	return nil
+}
+
+// Hemisphere returns the direction letter for the coordinate: N/S for latitude and E/W for longitude.
+// Zero is reported as N or E. An empty string is returned for AxisUnknown.
+func (c Coordinate) Hemisphere() string {
+	switch c.Axis {
+	case AxisLatitude:
+		if c.Degrees < 0 {
+			return "S"
+		}
+		return "N"
+	case AxisLongitude:
+		if c.Degrees < 0 {
+			return "W"
+		}
+		return "E"
+	default:
+		return emptyString
+	}
+}
+
+// ADIFLocation formats the coordinate as an ADIF Location, XDDD MM.MMM (e.g. "W071 30.000").
+// Minutes are rounded to three decimals, carrying into the degrees where needed.
+func (c Coordinate) ADIFLocation() (string, error) {
+	if err := c.Validate(); err != nil {
+		return emptyString, err
+	}
+	deg, minutes := splitDegreesMinutes(c.Degrees, 3)
+	return fmt.Sprintf("%s%03d %06.3f", c.Hemisphere(), deg, minutes), nil
+}
+
+// DecimalString formats the coordinate as signed decimal degrees with six decimals (e.g. "-71.500000").
+func (c Coordinate) DecimalString() string {
+	return strconv.FormatFloat(c.Degrees, 'f', 6, 64)
+}
+
+// DMS formats the coordinate as degrees, minutes and seconds, e.g. 51°28'38.0"N.
+// Seconds are rounded to one decimal.
+func (c Coordinate) DMS() string {
+	abs := math.Abs(c.Degrees)
+	deg := int(abs)
+	tenths := int(math.Round((abs - float64(deg)) * 36000))
+	if tenths >= 36000 {
+		deg++
+		tenths = 0
+	}
+	minutes := tenths / 600
+	seconds := float64(tenths%600) / 10
+	return fmt.Sprintf("%d°%02d'%04.1f\"%s", deg, minutes, seconds, c.Hemisphere())
+}
+
+// DDM formats the coordinate as degrees and decimal minutes, e.g. 51°28.633'N.
+func (c Coordinate) DDM() string {
+	deg, minutes := splitDegreesMinutes(c.Degrees, 3)
+	return fmt.Sprintf("%d°%06.3f'%s", deg, minutes, c.Hemisphere())
+}
+
+// String returns the coordinate in DDM form.
+func (c Coordinate) String() string {
+	return c.DDM()
+}
+
+// splitDegreesMinutes splits the absolute value of deg into whole degrees and minutes rounded to
+// the given number of decimals. Rounding that reaches 60 minutes carries into the degrees.
+func splitDegreesMinutes(deg float64, decimals int) (int, float64) {
+	abs := math.Abs(deg)
+	whole := int(abs)
+	scale := math.Pow10(decimals)
+	minutes := math.Round((abs-float64(whole))*60*scale) / scale
+	if minutes >= 60.0 {
+		whole++
+		minutes = 0
+	}
+	return whole, minutes
+}
@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// CoordinateParseError reports why a coordinate string could not be parsed.
+// Pos is the zero-based character (rune) offset in Input where the problem was found.
+type CoordinateParseError struct {
+	Input  string
+	Pos    int
+	Reason string
+}
+
+func (e *CoordinateParseError) Error() string {
+	return fmt.Sprintf("invalid coordinate %q at position %d: %s", e.Input, e.Pos, e.Reason)
+}
+
+// coordinateComponent is one numeric part (degrees, minutes or seconds) of a coordinate string.
+type coordinateComponent struct {
+	value   float64
+	pos     int
+	hasFrac bool
+}
+
+// ParseCoordinate parses a latitude or longitude string and returns it as signed decimal degrees.
+//
+// Accepted forms include the strict ADIF Location format and common free-text notations:
+//   - XDDD MM.MMM:          N051 28.633, W000 00.090
+//   - degrees/min/sec:      51°28'38"N, 51° 28′ 38.2″ N, 51 28 38 N
+//   - degrees/decimal mins: 51 28.633 N, N51 28.633
+//   - decimal degrees:      N51.4772, 51.4772N, -0.0015, +12.5
+//
+// The axis is taken from the hemisphere letter (N/S = latitude, E/W = longitude). When the input has
+// no letter, the optional axis hint is used; otherwise the returned Coordinate has AxisUnknown and is
+// only checked against ±180. A hint that contradicts the hemisphere letter is an error.
+// Errors are returned as *CoordinateParseError.
+func ParseCoordinate(s string, axis ...Axis) (Coordinate, error) {
+	runes := []rune(s)
+	fail := func(pos int, format string, args ...any) (Coordinate, error) {
+		return Coordinate{}, &CoordinateParseError{Input: s, Pos: pos, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	var hemi rune
+	hemiPos := -1
+	negative := false
+	signSeen := false
+	finished := false
+	comps := make([]coordinateComponent, 0, 3)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case finished:
+			return fail(i, "unexpected %q after hemisphere letter", r)
+		case isHemisphereRune(r):
+			if hemi != 0 {
+				return fail(i, "more than one hemisphere letter")
+			}
+			if signSeen {
+				return fail(i, "hemisphere letter cannot be combined with a sign")
+			}
+			hemi = unicode.ToUpper(r)
+			hemiPos = i
+			finished = len(comps) > 0
+			i++
+		case r == '+' || r == '-':
+			if len(comps) > 0 || signSeen || hemi != 0 {
+				return fail(i, "unexpected sign %q", r)
+			}
+			signSeen = true
+			negative = r == '-'
+			i++
+		case r == '.' || (r >= '0' && r <= '9'):
+			if len(comps) == 3 {
+				return fail(i, "too many numeric components")
+			}
+			start := i
+			for i < len(runes) && (runes[i] == '.' || (runes[i] >= '0' && runes[i] <= '9')) {
+				i++
+			}
+			text := string(runes[start:i])
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return fail(start, "invalid number %q", text)
+			}
+			comp := coordinateComponent{value: v, pos: start}
+			for _, c := range text {
+				if c == '.' {
+					comp.hasFrac = true
+				}
+			}
+			// An optional unit symbol pins the component to degrees, minutes or seconds.
+			if i < len(runes) {
+				want := -1
+				switch runes[i] {
+				case '°', 'º':
+					want = 0
+				case '\'', '′':
+					want = 1
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						want = 2
+						i++
+					}
+				case '"', '″':
+					want = 2
+				}
+				if want >= 0 {
+					if want != len(comps) {
+						return fail(i, "unit symbol %q out of order", runes[i])
+					}
+					i++
+				}
+			}
+			comps = append(comps, comp)
+		default:
+			return fail(i, "unexpected character %q", r)
+		}
+	}
+
+	if len(comps) == 0 {
+		return fail(len(runes), "missing degrees")
+	}
+	for _, comp := range comps[:len(comps)-1] {
+		if comp.hasFrac {
+			return fail(comp.pos, "only the last component may have a decimal fraction")
+		}
+	}
+	if len(comps) > 1 && comps[1].value >= 60 {
+		return fail(comps[1].pos, "minutes must be less than 60")
+	}
+	if len(comps) > 2 && comps[2].value >= 60 {
+		return fail(comps[2].pos, "seconds must be less than 60")
+	}
+
+	deg := comps[0].value
+	if len(comps) > 1 {
+		deg += comps[1].value / 60
+	}
+	if len(comps) > 2 {
+		deg += comps[2].value / 3600
+	}
+
+	c := Coordinate{Axis: AxisUnknown, Degrees: deg}
+	switch hemi {
+	case 'N', 'S':
+		c.Axis = AxisLatitude
+	case 'E', 'W':
+		c.Axis = AxisLongitude
+	}
+	if hemi == 'S' || hemi == 'W' || negative {
+		c.Degrees = -c.Degrees
+	}
+	if len(axis) > 0 && axis[0] != AxisUnknown {
+		if c.Axis != AxisUnknown && c.Axis != axis[0] {
+			return fail(hemiPos, "hemisphere %q does not match %s", hemi, axis[0])
+		}
+		c.Axis = axis[0]
+	}
+	if deg > c.Axis.Limit() {
+		return fail(comps[0].pos, "%s out of range (maximum %v degrees)", c.Axis, c.Axis.Limit())
+	}
+	return c, nil
+}
+
+// ParseADIFLocation parses a strict ADIF Location (XDDD MM.MMM) string. Unlike ParseCoordinate it
+// rejects free-text forms, making it suitable for reading LAT/LON fields from ADIF records.
+func ParseADIFLocation(s string) (Coordinate, error) {
+	c, ok := parseXDDDMMM(s)
+	if !ok {
+		return Coordinate{}, &CoordinateParseError{Input: s, Pos: 0, Reason: "not in XDDD MM.MMM format"}
+	}
+	if err := c.Validate(); err != nil {
+		return Coordinate{}, &CoordinateParseError{Input: s, Pos: 1, Reason: err.Error()}
+	}
+	return c, nil
+}
+
+func isHemisphereRune(r rune) bool {
+	switch unicode.ToUpper(r) {
+	case 'N', 'S', 'E', 'W':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseCoordinate_Valid(t *testing.T) {
+	cases := []struct {
+		in   string
+		hint []Axis
+		axis Axis
+		deg  float64
+	}{
+		{"N051 28.633", nil, AxisLatitude, 51.477217},
+		{"W000 00.090", nil, AxisLongitude, -0.0015},
+		{`51°28'38"N`, nil, AxisLatitude, 51.477222},
+		{"51° 28′ 38.2″ N", nil, AxisLatitude, 51.477278},
+		{"51 28.633 N", nil, AxisLatitude, 51.477217},
+		{"N51.4772", nil, AxisLatitude, 51.4772},
+		{"71.5w", nil, AxisLongitude, -71.5},
+		{"-0.0015", nil, AxisUnknown, -0.0015},
+		{"-0.0015", []Axis{AxisLongitude}, AxisLongitude, -0.0015},
+		{"+12.5", []Axis{AxisLatitude}, AxisLatitude, 12.5},
+		{"33 52 S", nil, AxisLatitude, -33.866667},
+	}
+	for _, c := range cases {
+		got, err := ParseCoordinate(c.in, c.hint...)
+		if err != nil {
+			t.Fatalf("ParseCoordinate(%q) unexpected error: %v", c.in, err)
+		}
+		if got.Axis != c.axis {
+			t.Errorf("ParseCoordinate(%q) axis = %v; want %v", c.in, got.Axis, c.axis)
+		}
+		if math.Abs(got.Degrees-c.deg) > 1e-6 {
+			t.Errorf("ParseCoordinate(%q) = %f; want %f", c.in, got.Degrees, c.deg)
+		}
+	}
+}
+
+func TestParseCoordinate_Invalid(t *testing.T) {
+	cases := []struct {
+		in   string
+		hint []Axis
+		pos  int
+	}{
+		{"", nil, 0},
+		{"N51 28.633 S", nil, 11},                // two hemisphere letters
+		{"-N51", nil, 1},                         // sign and letter
+		{"51.5 30 N", nil, 0},                    // fraction before the last component
+		{"51 60.000 N", nil, 3},                  // minutes >= 60
+		{"51 28 61 N", nil, 6},                   // seconds >= 60
+		{"91 N", nil, 0},                         // latitude out of range
+		{"N51 28.633", []Axis{AxisLongitude}, 0}, // hint contradicts letter
+		{"51x28", nil, 2},                        // unexpected character
+		{"51'28°N", nil, 2},                      // units out of order
+		{"N51 W", nil, 4},                        // letter after finished coordinate
+		{"1 2 3 4", nil, 6},                      // too many components
+	}
+	for _, c := range cases {
+		_, err := ParseCoordinate(c.in, c.hint...)
+		var pe *CoordinateParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("ParseCoordinate(%q) expected *CoordinateParseError, got %v", c.in, err)
+		}
+		if pe.Pos != c.pos {
+			t.Errorf("ParseCoordinate(%q) error position = %d; want %d (%v)", c.in, pe.Pos, c.pos, err)
+		}
+	}
+}
+
+func TestParseADIFLocation(t *testing.T) {
+	c, err := ParseADIFLocation("W071 30.000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Axis != AxisLongitude || c.Degrees != -71.5 {
+		t.Fatalf("got %+v; want longitude -71.5", c)
+	}
+	for _, in := range []string{"51 28.633 N", "N091 00.000", "W181 00.000"} {
+		if _, err := ParseADIFLocation(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
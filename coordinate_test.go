@@ -0,0 +1,54 @@
+package utils
+
+import "testing"
+
+func TestNewLatitudeLongitude_Range(t *testing.T) {
+	if _, err := NewLatitude(90); err != nil {
+		t.Fatalf("unexpected error for 90: %v", err)
+	}
+	if _, err := NewLatitude(-90.0001); err != ErrLatitudeOutOfRange {
+		t.Fatalf("expected ErrLatitudeOutOfRange, got %v", err)
+	}
+	if _, err := NewLongitude(-180); err != nil {
+		t.Fatalf("unexpected error for -180: %v", err)
+	}
+	if _, err := NewLongitude(180.5); err != ErrLongitudeOutOfRange {
+		t.Fatalf("expected ErrLongitudeOutOfRange, got %v", err)
+	}
+	if err := (Coordinate{Degrees: 10}).Validate(); err != ErrCoordinateAxis {
+		t.Fatalf("expected ErrCoordinateAxis, got %v", err)
+	}
+}
+
+func TestCoordinate_Formats(t *testing.T) {
+	cases := []struct {
+		c    Coordinate
+		adif string
+		dec  string
+		dms  string
+		ddm  string
+	}{
+		{Coordinate{AxisLatitude, 51.4772}, "N051 28.632", "51.477200", "51°28'37.9\"N", "51°28.632'N"},
+		{Coordinate{AxisLongitude, -0.0015}, "W000 00.090", "-0.001500", "0°00'05.4\"W", "0°00.090'W"},
+		{Coordinate{AxisLongitude, -71.5}, "W071 30.000", "-71.500000", "71°30'00.0\"W", "71°30.000'W"},
+		{Coordinate{AxisLatitude, -33.99999999}, "S034 00.000", "-34.000000", "34°00'00.0\"S", "34°00.000'S"},
+	}
+	for _, c := range cases {
+		adif, err := c.c.ADIFLocation()
+		if err != nil {
+			t.Fatalf("unexpected error for %+v: %v", c.c, err)
+		}
+		if adif != c.adif {
+			t.Errorf("ADIFLocation(%+v) = %q; want %q", c.c, adif, c.adif)
+		}
+		if got := c.c.DecimalString(); got != c.dec {
+			t.Errorf("DecimalString(%+v) = %q; want %q", c.c, got, c.dec)
+		}
+		if got := c.c.DMS(); got != c.dms {
+			t.Errorf("DMS(%+v) = %q; want %q", c.c, got, c.dms)
+		}
+		if got := c.c.DDM(); got != c.ddm {
+			t.Errorf("DDM(%+v) = %q; want %q", c.c, got, c.ddm)
+		}
+	}
+}
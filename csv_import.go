@@ -0,0 +1,557 @@
+package utils
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrCSVProfile = errors.New("invalid CSV import profile")
+	ErrCSVRow     = errors.New("CSV row cannot be imported")
+)
+
+// CSVTransform names a conversion applied to a column value before it is stored in its field.
+// Values are always trimmed of surrounding whitespace first.
+type CSVTransform string
+
+const (
+	CSVTransformNone         CSVTransform = ""
+	CSVTransformUpper        CSVTransform = "upper"         // upper-case, e.g. for callsigns
+	CSVTransformDate         CSVTransform = "date"          // YYYYMMDD, YYYY-MM-DD or YYYY/MM/DD
+	CSVTransformDateDMY      CSVTransform = "date-dmy"      // DD/MM/YYYY or DD/MM/YY
+	CSVTransformDateMDY      CSVTransform = "date-mdy"      // MM/DD/YYYY or MM/DD/YY
+	CSVTransformTime         CSVTransform = "time"          // HHMM, HH:MM or HH:MM:SS
+	CSVTransformFrequency    CSVTransform = "frequency"     // MHz, dotted MHz, or whole kHz or Hz
+	CSVTransformFrequencyKHz CSVTransform = "frequency-khz" // kHz, e.g. "14074.5"
+	CSVTransformBand         CSVTransform = "band"          // ADIF band ("20m") or MHz ("14MHz")
+	CSVTransformLatitude     CSVTransform = "latitude"      // decimal, DMS or DDM latitude
+	CSVTransformLongitude    CSVTransform = "longitude"     // decimal, DMS or DDM longitude
+)
+
+var csvTransforms = map[CSVTransform]bool{
+	CSVTransformNone: true, CSVTransformUpper: true, CSVTransformDate: true, CSVTransformDateDMY: true,
+	CSVTransformDateMDY: true, CSVTransformTime: true, CSVTransformFrequency: true,
+	CSVTransformFrequencyKHz: true, CSVTransformBand: true, CSVTransformLatitude: true,
+	CSVTransformLongitude: true,
+}
+
+// CSVColumn maps one CSV column to an ADIF field. The column is identified by its header text
+// (case-insensitive) or by Column, its 1-based position, which takes precedence.
+type CSVColumn struct {
+	Field     string       `json:"field"`
+	Header    string       `json:"header,omitempty"`
+	Column    int          `json:"column,omitempty"`
+	Transform CSVTransform `json:"transform,omitempty"`
+}
+
+// CSVConstant is a field given the same value in every imported record, such as the
+// STATION_CALLSIGN of a paper log or the MY_SOTA_REF of an activation.
+type CSVConstant struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// CSVImportProfile describes how to turn the rows of one kind of CSV file into ADIF records. It can
+// be saved with Save and read back with LoadCSVImportProfile, so a user sets it up once per source.
+//
+// Delimiter defaults to ",". SkipRows lines (such as a spreadsheet title) are skipped before the
+// header row, if HasHeader is set, or the first data row. With AutoDetect, header columns not
+// mapped in Columns are mapped by name: ADIF field names ("QSO_DATE", "My Gridsquare") and common
+// labels ("Date", "UTC", "Callsign", "Freq", "Locator"). Required lists the fields every record
+// must have; CALL is required if it is empty. With Normalize, records are repaired with
+// NormalizeADIFRecord after the column transforms.
+type CSVImportProfile struct {
+	Name       string        `json:"name"`
+	Delimiter  string        `json:"delimiter,omitempty"`
+	Comment    string        `json:"comment,omitempty"`
+	SkipRows   int           `json:"skip_rows,omitempty"`
+	HasHeader  bool          `json:"has_header"`
+	AutoDetect bool          `json:"auto_detect,omitempty"`
+	Columns    []CSVColumn   `json:"columns,omitempty"`
+	Constants  []CSVConstant `json:"constants,omitempty"`
+	Required   []string      `json:"required,omitempty"`
+	Normalize  bool          `json:"normalize,omitempty"`
+}
+
+// LoadCSVImportProfile reads a profile saved with Save and checks it with Validate.
+func LoadCSVImportProfile(r io.Reader) (CSVImportProfile, error) {
+	var p CSVImportProfile
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
+		return CSVImportProfile{}, fmt.Errorf("%w: %w", ErrCSVProfile, err)
+	}
+	if err := p.Validate(); err != nil {
+		return CSVImportProfile{}, err
+	}
+	return p, nil
+}
+
+// Save writes the profile as indented JSON.
+func (p CSVImportProfile) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent(emptyString, "  ")
+	return enc.Encode(p)
+}
+
+// Validate checks that the profile can be used: field names are valid, every column is identified
+// by a header or a position, header mappings have a header row, and transforms are known.
+func (p CSVImportProfile) Validate() error {
+	if utf8.RuneCountInString(p.Delimiter) > 1 || utf8.RuneCountInString(p.Comment) > 1 {
+		return fmt.Errorf("%w: delimiter and comment must be single characters", ErrCSVProfile)
+	}
+	if p.SkipRows < 0 {
+		return fmt.Errorf("%w: negative skip_rows", ErrCSVProfile)
+	}
+	if len(p.Columns) == 0 && !p.AutoDetect {
+		return fmt.Errorf("%w: no columns are mapped", ErrCSVProfile)
+	}
+	if p.AutoDetect && !p.HasHeader {
+		return fmt.Errorf("%w: auto_detect needs a header row", ErrCSVProfile)
+	}
+	for _, c := range p.Columns {
+		switch {
+		case !validADIFFieldName(strings.ToUpper(c.Field)):
+			return fmt.Errorf("%w: field name %q", ErrCSVProfile, c.Field)
+		case c.Column < 0:
+			return fmt.Errorf("%w: %s: column %d", ErrCSVProfile, c.Field, c.Column)
+		case c.Column == 0 && c.Header == emptyString:
+			return fmt.Errorf("%w: %s has no header or column", ErrCSVProfile, c.Field)
+		case c.Column == 0 && !p.HasHeader:
+			return fmt.Errorf("%w: %s is mapped by header but the file has no header row", ErrCSVProfile, c.Field)
+		case !csvTransforms[c.Transform]:
+			return fmt.Errorf("%w: %s: unknown transform %q", ErrCSVProfile, c.Field, c.Transform)
+		}
+	}
+	for _, c := range p.Constants {
+		if !validADIFFieldName(strings.ToUpper(c.Field)) {
+			return fmt.Errorf("%w: field name %q", ErrCSVProfile, c.Field)
+		}
+	}
+	for _, name := range p.Required {
+		if !validADIFFieldName(strings.ToUpper(name)) {
+			return fmt.Errorf("%w: field name %q", ErrCSVProfile, name)
+		}
+	}
+	return nil
+}
+
+// CSVRowError reports why one row was not imported. Line is the line of the CSV file the row
+// starts on. Err wraps ErrCSVRow, or is an *ADIFFieldError for a field that failed validation.
+type CSVRowError struct {
+	Line  int
+	Field string
+	Value string
+	Err   error
+}
+
+func (e *CSVRowError) Error() string {
+	var fe *ADIFFieldError
+	switch {
+	case e.Field == emptyString || errors.As(e.Err, &fe):
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	case e.Value == emptyString:
+		return fmt.Sprintf("line %d: %s: %v", e.Line, e.Field, e.Err)
+	default:
+		return fmt.Sprintf("line %d: %s %q: %v", e.Line, e.Field, e.Value, e.Err)
+	}
+}
+
+func (e *CSVRowError) Unwrap() error {
+	return e.Err
+}
+
+// CSVColumnCollision is a header column AutoDetect left unmapped because an earlier column, at
+// MappedColumn, already supplies its field.
+type CSVColumnCollision struct {
+	Field        string
+	Header       string
+	Column       int
+	MappedColumn int
+}
+
+// CSVImportResult summarises an import. Rows counts the data rows read, blank rows excluded, and
+// Imported the rows that became records (or would have, in a dry run). Errors holds every error
+// for every rejected row, in file order. Collisions lists the header columns AutoDetect skipped so
+// as not to overwrite a field already mapped.
+type CSVImportResult struct {
+	Rows       int
+	Imported   int
+	Errors     []*CSVRowError
+	Collisions []CSVColumnCollision
+}
+
+// ImportCSV reads CSV rows from r, builds an ADIF record from each as described by the profile and
+// passes each valid record to write. A row is rejected, and reported in the result rather than
+// returned as an error, if a transform fails, a required field is missing or ValidateADIFRecord
+// finds an invalid field. With dryRun, rows are processed and reported in the same way but write is
+// not called, so the result can be previewed before importing.
+//
+// The returned error is for problems that stop the whole import: an invalid profile, a mapped
+// header missing from the file, a read error, or an error from write.
+func ImportCSV(r io.Reader, p CSVImportProfile, dryRun bool, write func(ADIFRecord) error) (CSVImportResult, error) {
+	if err := p.Validate(); err != nil {
+		return CSVImportResult{}, err
+	}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	if p.Delimiter != emptyString {
+		cr.Comma, _ = utf8.DecodeRuneInString(p.Delimiter)
+	}
+	if p.Comment != emptyString {
+		cr.Comment, _ = utf8.DecodeRuneInString(p.Comment)
+	}
+
+	var result CSVImportResult
+	for i := 0; i < p.SkipRows; i++ {
+		if _, err := cr.Read(); err != nil {
+			return csvImportEOF(result, err)
+		}
+	}
+	columns := p.Columns
+	if p.HasHeader {
+		header, err := cr.Read()
+		if err != nil {
+			return csvImportEOF(result, err)
+		}
+		if columns, result.Collisions, err = resolveCSVColumns(p, header); err != nil {
+			return CSVImportResult{}, err
+		}
+	}
+	required := p.Required
+	if len(required) == 0 {
+		required = []string{"CALL"}
+	}
+
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		var perr *csv.ParseError
+		if errors.As(err, &perr) {
+			result.Rows++
+			result.Errors = append(result.Errors, &CSVRowError{Line: perr.StartLine, Err: fmt.Errorf("%w: %w", ErrCSVRow, perr.Err)})
+			continue
+		}
+		if err != nil {
+			return result, err
+		}
+		if csvRowBlank(row) {
+			continue
+		}
+		result.Rows++
+		line, _ := cr.FieldPos(0)
+
+		rec, errs := buildCSVRecord(p, columns, required, row, line)
+		if len(errs) > 0 {
+			result.Errors = append(result.Errors, errs...)
+			continue
+		}
+		result.Imported++
+		if !dryRun && write != nil {
+			if err := write(rec); err != nil {
+				return result, err
+			}
+		}
+	}
+}
+
+func csvImportEOF(result CSVImportResult, err error) (CSVImportResult, error) {
+	if errors.Is(err, io.EOF) {
+		return result, nil
+	}
+	return result, err
+}
+
+func csvRowBlank(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != emptyString {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveCSVColumns gives every header-mapped column its position and, with AutoDetect, adds the
+// columns whose header names an ADIF field. A detected column whose field is already mapped is
+// skipped and reported as a collision, so the first column for a field wins.
+func resolveCSVColumns(p CSVImportProfile, header []string) ([]CSVColumn, []CSVColumnCollision, error) {
+	find := func(name string) int {
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(name)) {
+				return i + 1
+			}
+		}
+		return 0
+	}
+
+	mapped := make(map[int]bool)
+	fields := make(map[string]int)
+	columns := make([]CSVColumn, 0, len(p.Columns))
+	for _, c := range p.Columns {
+		if c.Column == 0 {
+			if c.Column = find(c.Header); c.Column == 0 {
+				return nil, nil, fmt.Errorf("%w: column %q not found in header", ErrCSVProfile, c.Header)
+			}
+		}
+		mapped[c.Column] = true
+		if _, ok := fields[strings.ToUpper(c.Field)]; !ok {
+			fields[strings.ToUpper(c.Field)] = c.Column
+		}
+		columns = append(columns, c)
+	}
+	var collisions []CSVColumnCollision
+	if p.AutoDetect {
+		for i, h := range header {
+			field, transform, ok := csvHeaderField(h)
+			if !ok || mapped[i+1] {
+				continue
+			}
+			if first, ok := fields[field]; ok {
+				collisions = append(collisions, CSVColumnCollision{Field: field, Header: h, Column: i + 1, MappedColumn: first})
+				continue
+			}
+			fields[field] = i + 1
+			columns = append(columns, CSVColumn{Field: field, Header: h, Column: i + 1, Transform: transform})
+		}
+	}
+	if len(columns) == 0 {
+		return nil, nil, fmt.Errorf("%w: no header column could be mapped", ErrCSVProfile)
+	}
+	return columns, collisions, nil
+}
+
+// csvHeaderLabels maps common column labels, lower-cased with spaces and punctuation removed, to
+// the field and transform they imply.
+var csvHeaderLabels = map[string]struct {
+	field     string
+	transform CSVTransform
+}{
+	"call":           {"CALL", CSVTransformUpper},
+	"callsign":       {"CALL", CSVTransformUpper},
+	"hiscall":        {"CALL", CSVTransformUpper},
+	"worked":         {"CALL", CSVTransformUpper},
+	"mycall":         {"STATION_CALLSIGN", CSVTransformUpper},
+	"mycallsign":     {"STATION_CALLSIGN", CSVTransformUpper},
+	"stationcall":    {"STATION_CALLSIGN", CSVTransformUpper},
+	"operator":       {"OPERATOR", CSVTransformUpper},
+	"date":           {"QSO_DATE", CSVTransformDate},
+	"qsodate":        {"QSO_DATE", CSVTransformDate},
+	"time":           {"TIME_ON", CSVTransformTime},
+	"utc":            {"TIME_ON", CSVTransformTime},
+	"timeon":         {"TIME_ON", CSVTransformTime},
+	"timeoff":        {"TIME_OFF", CSVTransformTime},
+	"freq":           {"FREQ", CSVTransformFrequency},
+	"frequency":      {"FREQ", CSVTransformFrequency},
+	"freqmhz":        {"FREQ", CSVTransformFrequency},
+	"freqkhz":        {"FREQ", CSVTransformFrequencyKHz},
+	"band":           {"BAND", CSVTransformBand},
+	"mode":           {"MODE", CSVTransformUpper},
+	"submode":        {"SUBMODE", CSVTransformUpper},
+	"rst":            {"RST_SENT", CSVTransformNone},
+	"rsts":           {"RST_SENT", CSVTransformNone},
+	"rstsent":        {"RST_SENT", CSVTransformNone},
+	"sent":           {"RST_SENT", CSVTransformNone},
+	"rstr":           {"RST_RCVD", CSVTransformNone},
+	"rstrcvd":        {"RST_RCVD", CSVTransformNone},
+	"rstreceived":    {"RST_RCVD", CSVTransformNone},
+	"rcvd":           {"RST_RCVD", CSVTransformNone},
+	"name":           {"NAME", CSVTransformNone},
+	"qth":            {"QTH", CSVTransformNone},
+	"grid":           {"GRIDSQUARE", CSVTransformNone},
+	"locator":        {"GRIDSQUARE", CSVTransformNone},
+	"gridsquare":     {"GRIDSQUARE", CSVTransformNone},
+	"mygrid":         {"MY_GRIDSQUARE", CSVTransformNone},
+	"mylocator":      {"MY_GRIDSQUARE", CSVTransformNone},
+	"lat":            {"LAT", CSVTransformLatitude},
+	"latitude":       {"LAT", CSVTransformLatitude},
+	"lon":            {"LON", CSVTransformLongitude},
+	"long":           {"LON", CSVTransformLongitude},
+	"longitude":      {"LON", CSVTransformLongitude},
+	"comment":        {"COMMENT", CSVTransformNone},
+	"comments":       {"COMMENT", CSVTransformNone},
+	"remarks":        {"COMMENT", CSVTransformNone},
+	"notes":          {"NOTES", CSVTransformNone},
+	"power":          {"TX_PWR", CSVTransformNone},
+	"summit":         {"SOTA_REF", CSVTransformUpper},
+	"hissummit":      {"SOTA_REF", CSVTransformUpper},
+	"mysummit":       {"MY_SOTA_REF", CSVTransformUpper},
+	"park":           {"POTA_REF", CSVTransformUpper},
+	"parkreference":  {"POTA_REF", CSVTransformUpper},
+	"mypark":         {"MY_POTA_REF", CSVTransformUpper},
+	"myparkref":      {"MY_POTA_REF", CSVTransformUpper},
+	"wwff":           {"WWFF_REF", CSVTransformUpper},
+	"iota":           {"IOTA", CSVTransformUpper},
+	"serialsent":     {"STX", CSVTransformNone},
+	"serialreceived": {"SRX", CSVTransformNone},
+}
+
+// csvHeaderField returns the field a header names: one of csvHeaderLabels, or an ADIF field name
+// with spaces for underscores allowed. The labels are tried first so that a header such as "Freq"
+// or "Lat" gets the transform its values need.
+func csvHeaderField(header string) (string, CSVTransform, bool) {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(header) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	if l, ok := csvHeaderLabels[sb.String()]; ok {
+		return l.field, l.transform, true
+	}
+	name := strings.ToUpper(strings.Join(strings.Fields(header), "_"))
+	typ, _, ok := ADIFFieldType(name)
+	if !ok {
+		return emptyString, CSVTransformNone, false
+	}
+	switch {
+	case typ == ADIFDate:
+		return name, CSVTransformDate, true
+	case typ == ADIFTime:
+		return name, CSVTransformTime, true
+	case typ == ADIFLocation && strings.HasSuffix(name, "LAT"):
+		return name, CSVTransformLatitude, true
+	case typ == ADIFLocation:
+		return name, CSVTransformLongitude, true
+	}
+	return name, CSVTransformNone, true
+}
+
+// buildCSVRecord builds and checks the record for one row.
+func buildCSVRecord(p CSVImportProfile, columns []CSVColumn, required []string, row []string, line int) (ADIFRecord, []*CSVRowError) {
+	var rec ADIFRecord
+	var errs []*CSVRowError
+	for _, c := range p.Constants {
+		rec.Set(c.Field, c.Value)
+	}
+	for _, c := range columns {
+		if c.Column > len(row) {
+			continue
+		}
+		raw := strings.TrimSpace(row[c.Column-1])
+		if raw == emptyString {
+			continue
+		}
+		value, err := applyCSVTransform(c.Transform, raw)
+		if err != nil {
+			errs = append(errs, &CSVRowError{Line: line, Field: strings.ToUpper(c.Field), Value: raw, Err: err})
+			continue
+		}
+		rec.Set(c.Field, value)
+	}
+	if p.Normalize {
+		rec, _ = NormalizeADIFRecord(rec)
+	}
+	for _, name := range required {
+		if rec.Value(name) == emptyString {
+			errs = append(errs, &CSVRowError{Line: line, Field: strings.ToUpper(name), Err: fmt.Errorf("%w: required field is missing", ErrCSVRow)})
+		}
+	}
+	for _, fe := range ValidateADIFRecord(rec) {
+		errs = append(errs, &CSVRowError{Line: line, Field: fe.Field, Value: fe.Value, Err: fe})
+	}
+	return rec, errs
+}
+
+// applyCSVTransform converts one trimmed, non-empty column value.
+func applyCSVTransform(t CSVTransform, value string) (string, error) {
+	fail := func(what string) (string, error) {
+		return emptyString, fmt.Errorf("%w: not a %s", ErrCSVRow, what)
+	}
+	switch t {
+	case CSVTransformUpper:
+		return strings.ToUpper(value), nil
+	case CSVTransformDate:
+		if d := SanitizeDateToYYYYMMDD(value); d != emptyString {
+			return d, nil
+		}
+		return fail("date (YYYY-MM-DD)")
+	case CSVTransformDateDMY, CSVTransformDateMDY:
+		if d := sanitizeCSVDate(value, t == CSVTransformDateDMY); d != emptyString {
+			return d, nil
+		}
+		if t == CSVTransformDateDMY {
+			return fail("date (DD/MM/YYYY)")
+		}
+		return fail("date (MM/DD/YYYY)")
+	case CSVTransformTime:
+		if tm := SanitizeTimeToADIF(value); tm != emptyString {
+			return tm, nil
+		}
+		return fail("time (HH:MM)")
+	case CSVTransformFrequency:
+		f := normalizeADIFFrequency(value)
+		if checkADIFValue(ADIFNumber, f) != emptyString {
+			return fail("frequency")
+		}
+		return f, nil
+	case CSVTransformFrequencyKHz:
+		value = strings.Replace(value, ",", dotString, 1)
+		khz, err := strconv.ParseFloat(value, 64)
+		if err != nil || khz <= 0 || checkADIFValue(ADIFNumber, value) != emptyString {
+			return fail("frequency in kHz")
+		}
+		return formatFrequencyMHz(khz / 1000), nil
+	case CSVTransformBand:
+		if band, err := NormalizeADIFEnum(EnumBand, value); err == nil {
+			return band, nil
+		}
+		mhz := strings.TrimSpace(strings.TrimSuffix(strings.ToUpper(value), "MHZ"))
+		if f, err := strconv.ParseFloat(mhz, 64); err == nil && f > 0 {
+			if band, ok := BandForMHz(f); ok {
+				return band, nil
+			}
+		}
+		return fail("band")
+	case CSVTransformLatitude, CSVTransformLongitude:
+		axis := AxisLatitude
+		if t == CSVTransformLongitude {
+			axis = AxisLongitude
+		}
+		loc := normalizeADIFLocation(value, axis)
+		if _, err := ParseADIFLocation(loc); err != nil {
+			return fail(axis.String())
+		}
+		return loc, nil
+	default:
+		return value, nil
+	}
+}
+
+// sanitizeCSVDate converts a day-first or month-first date with any separator and a two- or
+// four-digit year to YYYYMMDD. Two-digit years are taken as 1930 to 2029, matching the range of
+// ADIF dates.
+func sanitizeCSVDate(value string, dayFirst bool) string {
+	parts := strings.FieldsFunc(value, func(r rune) bool { return r < '0' || r > '9' })
+	if len(parts) != 3 || len(parts[0]) > 2 || len(parts[1]) > 2 || (len(parts[2]) != 2 && len(parts[2]) != 4) {
+		return emptyString
+	}
+	day, month, year := parts[0], parts[1], parts[2]
+	if !dayFirst {
+		day, month = month, day
+	}
+	if len(year) == 2 {
+		if year < "30" {
+			year = "20" + year
+		} else {
+			year = "19" + year
+		}
+	}
+	pad := func(s string) string {
+		if len(s) == 1 {
+			return "0" + s
+		}
+		return s
+	}
+	d := year + pad(month) + pad(day)
+	if !IsValidDateYYYYMMDD(d) {
+		return emptyString
+	}
+	return d
+}
@@ -0,0 +1,268 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func importAllCSV(t *testing.T, in string, p CSVImportProfile) ([]ADIFRecord, CSVImportResult) {
+	t.Helper()
+	var recs []ADIFRecord
+	res, err := ImportCSV(strings.NewReader(in), p, false, func(rec ADIFRecord) error {
+		recs = append(recs, rec)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return recs, res
+}
+
+func TestImportCSV_AutoDetect(t *testing.T) {
+	in := "Paper log G4ABC\n" +
+		"Date,UTC,Callsign,Freq,Mode,RST Sent,RST Rcvd,My Gridsquare,Remarks,Ignored\n" +
+		"2024-03-01,12:34,w1aw,14.074.000,FT8,-10,-12,IO91,first,x\n" +
+		",,,,,,,,,\n" +
+		"2024/03/01,1300,k1abc,7074,ft8,,,,,\n"
+	p := CSVImportProfile{SkipRows: 1, HasHeader: true, AutoDetect: true, Normalize: true}
+	recs, res := importAllCSV(t, in, p)
+	if res.Rows != 2 || res.Imported != 2 || res.Errors != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	want := []ADIFField{
+		{Name: "QSO_DATE", Value: "20240301"},
+		{Name: "TIME_ON", Value: "1234"},
+		{Name: "CALL", Value: "W1AW"},
+		{Name: "FREQ", Value: "14.074"},
+		{Name: "MODE", Value: "FT8"},
+		{Name: "RST_SENT", Value: "-10"},
+		{Name: "RST_RCVD", Value: "-12"},
+		{Name: "MY_GRIDSQUARE", Value: "IO91"},
+		{Name: "COMMENT", Value: "first"},
+		{Name: "BAND", Value: "20m"},
+	}
+	if !reflect.DeepEqual(recs[0].Fields, want) {
+		t.Fatalf("unexpected record\n%+v\nwant\n%+v", recs[0].Fields, want)
+	}
+	if recs[1].Value("FREQ") != "7.074" || recs[1].Value("BAND") != "40m" || recs[1].Value("CALL") != "K1ABC" {
+		t.Fatalf("unexpected record %+v", recs[1])
+	}
+}
+
+func TestImportCSV_AutoDetectFieldNames(t *testing.T) {
+	in := "Call,Freq,Band,Lat,My Lon,Mode,QSO Date,Time On\n" +
+		"w1aw,14.074.000,14MHz,51.5,-0.5,ft8,2024-03-01,12:34\n"
+	recs, res := importAllCSV(t, in, CSVImportProfile{HasHeader: true, AutoDetect: true})
+	if res.Imported != 1 || res.Errors != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	want := []ADIFField{
+		{Name: "CALL", Value: "W1AW"},
+		{Name: "FREQ", Value: "14.074"},
+		{Name: "BAND", Value: "20m"},
+		{Name: "LAT", Value: "N051 30.000"},
+		{Name: "MY_LON", Value: "W000 30.000"},
+		{Name: "MODE", Value: "FT8"},
+		{Name: "QSO_DATE", Value: "20240301"},
+		{Name: "TIME_ON", Value: "1234"},
+	}
+	if !reflect.DeepEqual(recs[0].Fields, want) {
+		t.Fatalf("unexpected record\n%+v\nwant\n%+v", recs[0].Fields, want)
+	}
+}
+
+func TestImportCSV_AutoDetectCollision(t *testing.T) {
+	in := "Call,Date,UTC,Callsign,Worked\n" +
+		"w1aw,2024-03-01,1234,k1abc,\n"
+	p := CSVImportProfile{HasHeader: true, AutoDetect: true}
+	recs, res := importAllCSV(t, in, p)
+	if res.Imported != 1 || recs[0].Value("CALL") != "W1AW" {
+		t.Fatalf("unexpected result %+v, records %+v", res, recs)
+	}
+	want := []CSVColumnCollision{
+		{Field: "CALL", Header: "Callsign", Column: 4, MappedColumn: 1},
+		{Field: "CALL", Header: "Worked", Column: 5, MappedColumn: 1},
+	}
+	if !reflect.DeepEqual(res.Collisions, want) {
+		t.Fatalf("unexpected collisions %+v", res.Collisions)
+	}
+
+	p.Columns = []CSVColumn{{Field: "CALL", Header: "Callsign"}}
+	recs, res = importAllCSV(t, in, p)
+	if recs[0].Value("CALL") != "k1abc" || len(res.Collisions) != 2 || res.Collisions[0].MappedColumn != 4 {
+		t.Fatalf("unexpected result %+v, records %+v", res, recs)
+	}
+}
+
+// A SOTA CSV v2 file has no header: V2, own call, own summit, date, time, band, mode, call, summit, notes.
+func TestImportCSV_ColumnsAndConstants(t *testing.T) {
+	in := "V2,G4ABC/P,G/LD-001,24/06/24,1102,14MHz,CW,DL1ABC,,\n" +
+		"V2,G4ABC/P,G/LD-001,24/06/24,1105,7MHz,SSB,M0XYZ/P,g/ld-003,s2s\n"
+	p := CSVImportProfile{
+		Name: "SOTA v2",
+		Columns: []CSVColumn{
+			{Field: "STATION_CALLSIGN", Column: 2, Transform: CSVTransformUpper},
+			{Field: "MY_SOTA_REF", Column: 3, Transform: CSVTransformUpper},
+			{Field: "QSO_DATE", Column: 4, Transform: CSVTransformDateDMY},
+			{Field: "TIME_ON", Column: 5, Transform: CSVTransformTime},
+			{Field: "BAND", Column: 6, Transform: CSVTransformBand},
+			{Field: "MODE", Column: 7},
+			{Field: "CALL", Column: 8, Transform: CSVTransformUpper},
+			{Field: "SOTA_REF", Column: 9, Transform: CSVTransformUpper},
+			{Field: "COMMENT", Column: 10},
+		},
+		Constants: []CSVConstant{{Field: "MY_SIG", Value: "SOTA"}, {Field: "TX_PWR", Value: "5"}},
+		Required:  []string{"CALL", "QSO_DATE", "TIME_ON"},
+	}
+	recs, res := importAllCSV(t, in, p)
+	if res.Imported != 2 || res.Errors != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	first := recs[0]
+	if first.Value("QSO_DATE") != "20240624" || first.Value("BAND") != "20m" || first.Value("MY_SIG") != "SOTA" ||
+		first.Value("TX_PWR") != "5" || first.Value("CALL") != "DL1ABC" {
+		t.Fatalf("unexpected record %+v", first)
+	}
+	if _, ok := first.Get("SOTA_REF"); ok {
+		t.Fatalf("empty column should not add a field: %+v", first)
+	}
+	if recs[1].Value("SOTA_REF") != "G/LD-003" || recs[1].Value("BAND") != "40m" {
+		t.Fatalf("unexpected record %+v", recs[1])
+	}
+}
+
+func TestImportCSV_RowErrorsAndDryRun(t *testing.T) {
+	in := "call;date;time;band\n" +
+		"W1AW;2024-01-01;1200;20m\n" +
+		"K1ABC;2024-13-01;1200;20m\n" +
+		";2024-01-01;1200;20m\n" +
+		"G4ABC;2024-01-01;noon;21m\n"
+	p := CSVImportProfile{
+		Delimiter: ";",
+		HasHeader: true,
+		Columns: []CSVColumn{
+			{Field: "CALL", Header: "Call"},
+			{Field: "QSO_DATE", Header: "Date", Transform: CSVTransformDate},
+			{Field: "TIME_ON", Header: "Time", Transform: CSVTransformTime},
+			{Field: "BAND", Header: "Band"},
+		},
+	}
+	called := false
+	res, err := ImportCSV(strings.NewReader(in), p, true, func(ADIFRecord) error {
+		called = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if called {
+		t.Fatal("write called in a dry run")
+	}
+	if res.Rows != 4 || res.Imported != 1 || len(res.Errors) != 4 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	wantLines := []int{3, 4, 5, 5}
+	wantFields := []string{"QSO_DATE", "CALL", "TIME_ON", "BAND"}
+	for i, e := range res.Errors {
+		if e.Line != wantLines[i] || e.Field != wantFields[i] {
+			t.Fatalf("error %d: unexpected %v", i, e)
+		}
+	}
+	if !errors.Is(res.Errors[0], ErrCSVRow) || res.Errors[0].Error() != `line 3: QSO_DATE "2024-13-01": CSV row cannot be imported: not a date (YYYY-MM-DD)` {
+		t.Fatalf("unexpected error %v", res.Errors[0])
+	}
+	var fe *ADIFFieldError
+	if !errors.As(res.Errors[3], &fe) || fe.Field != "BAND" {
+		t.Fatalf("expected an ADIF field error, got %v", res.Errors[3])
+	}
+}
+
+func TestImportCSV_Fatal(t *testing.T) {
+	p := CSVImportProfile{HasHeader: true, Columns: []CSVColumn{{Field: "CALL", Header: "Callsign"}}}
+	if _, err := ImportCSV(strings.NewReader("Call\nW1AW\n"), p, true, nil); !errors.Is(err, ErrCSVProfile) {
+		t.Fatalf("expected ErrCSVProfile for a missing header, got %v", err)
+	}
+
+	stop := errors.New("stop")
+	p.Columns[0].Header = "call"
+	if _, err := ImportCSV(strings.NewReader("Call\nW1AW\n"), p, false, func(ADIFRecord) error { return stop }); !errors.Is(err, stop) {
+		t.Fatalf("expected the write error, got %v", err)
+	}
+
+	bad := []CSVImportProfile{
+		{},
+		{Columns: []CSVColumn{{Field: "CALL", Header: "Call"}}},
+		{Columns: []CSVColumn{{Field: "CALL"}}},
+		{Columns: []CSVColumn{{Field: "MY CALL", Column: 1}}},
+		{Columns: []CSVColumn{{Field: "CALL", Column: 1, Transform: "reverse"}}},
+		{Columns: []CSVColumn{{Field: "CALL", Column: 1}}, Delimiter: "||"},
+		{AutoDetect: true},
+	}
+	for _, p := range bad {
+		if err := p.Validate(); !errors.Is(err, ErrCSVProfile) {
+			t.Fatalf("%+v: expected ErrCSVProfile, got %v", p, err)
+		}
+	}
+}
+
+func TestCSVImportProfile_SaveLoad(t *testing.T) {
+	p := CSVImportProfile{
+		Name:      "Paper log",
+		HasHeader: true,
+		Columns:   []CSVColumn{{Field: "CALL", Header: "Call", Transform: CSVTransformUpper}, {Field: "FREQ", Column: 3, Transform: CSVTransformFrequencyKHz}},
+		Constants: []CSVConstant{{Field: "STATION_CALLSIGN", Value: "G4ABC"}},
+	}
+	var buf bytes.Buffer
+	if err := p.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"transform": "frequency-khz"`) {
+		t.Fatalf("unexpected JSON %s", buf.String())
+	}
+	back, err := LoadCSVImportProfile(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, p) {
+		t.Fatalf("round trip failed: %+v", back)
+	}
+	if _, err := LoadCSVImportProfile(strings.NewReader(`{"columns": [{"field": "CALL"}]}`)); !errors.Is(err, ErrCSVProfile) {
+		t.Fatalf("expected ErrCSVProfile, got %v", err)
+	}
+}
+
+func TestApplyCSVTransform(t *testing.T) {
+	tests := []struct {
+		t        CSVTransform
+		in, want string
+	}{
+		{CSVTransformDateMDY, "3/1/2024", "20240301"},
+		{CSVTransformDateDMY, "01.03.99", "19990301"},
+		{CSVTransformFrequencyKHz, "14074,5", "14.0745"},
+		{CSVTransformBand, "2M", "2m"},
+		{CSVTransformLatitude, "51.5", "N051 30.000"},
+		{CSVTransformLongitude, "71°30'W", "W071 30.000"},
+	}
+	for _, tt := range tests {
+		if got, err := applyCSVTransform(tt.t, tt.in); err != nil || got != tt.want {
+			t.Fatalf("%s %q = %q, %v; want %q", tt.t, tt.in, got, err, tt.want)
+		}
+	}
+	for _, tt := range []struct {
+		t  CSVTransform
+		in string
+	}{
+		{CSVTransformDateDMY, "31/02/2024"},
+		{CSVTransformDateMDY, "2024-03-01"},
+		{CSVTransformFrequency, "fourteen"},
+		{CSVTransformBand, "99MHz"},
+		{CSVTransformLatitude, "95"},
+	} {
+		if _, err := applyCSVTransform(tt.t, tt.in); !errors.Is(err, ErrCSVRow) {
+			t.Fatalf("%s %q: expected ErrCSVRow, got %v", tt.t, tt.in, err)
+		}
+	}
+}
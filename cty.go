@@ -0,0 +1,407 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrCtyFormat          = errors.New("invalid country file format")
+	ErrCallsignNotFound   = errors.New("callsign does not match any entity in the country file")
+	ErrCtyFileUnsupported = errors.New("unsupported country file (expected .dat or .csv)")
+)
+
+// CtyEntity is one entity from a country file (cty.dat, cty.csv or Big CTY).
+//
+// DXCC is the ADIF DXCC entity code from the DXCC entity table. Country files also list some
+// entities that count only for the DARC WAE award, such as Sicily or Shetland; these have WAEOnly
+// set and DXCC gives the entity they belong to for DXCC purposes. DXCC is 0 if the entity could not
+// be matched to the table. Location uses the package convention (east and north positive) and
+// UTCOffset is in hours east of UTC, so both are sign-inverted relative to the file.
+type CtyEntity struct {
+	Name          string
+	PrimaryPrefix string
+	DXCC          int
+	WAEOnly       bool
+	Continent     string
+	CQZone        int
+	ITUZone       int
+	Location      LatLong
+	UTCOffset     float64
+}
+
+// CallsignInfo is the result of resolving a callsign against a country file. CQZone, ITUZone,
+// Continent, Location and UTCOffset include any per-prefix or per-call overrides from the file,
+// so they may differ from the entity's defaults. ExactMatch is true when the callsign matched an
+// exact-call entry ("=CALL" in the file) rather than a prefix.
+type CallsignInfo struct {
+	Callsign   string
+	Entity     CtyEntity
+	DXCC       int
+	Continent  string
+	CQZone     int
+	ITUZone    int
+	Location   LatLong
+	UTCOffset  float64
+	ExactMatch bool
+}
+
+// CtyDatabase resolves callsigns using the prefixes and exact calls of a country file.
+// A CtyDatabase is safe for concurrent use once loaded.
+type CtyDatabase struct {
+	entities []*CtyEntity
+	prefixes map[string]ctyMatch
+	exact    map[string]ctyMatch
+}
+
+// ctyMatch is a prefix or exact call together with its effective (possibly overridden) values.
+type ctyMatch struct {
+	entity    *CtyEntity
+	continent string
+	cq        int
+	itu       int
+	location  LatLong
+	utcOffset float64
+}
+
+// LoadCtyFile loads a country file, choosing the format from the file extension:
+// ".csv" for cty.csv and anything else (normally ".dat") for cty.dat or Big CTY.
+func LoadCtyFile(path string) (*CtyDatabase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return LoadCtyCSV(f)
+	case ".dat", emptyString:
+		return LoadCtyDat(f)
+	default:
+		return nil, ErrCtyFileUnsupported
+	}
+}
+
+// LoadCtyDat parses a country file in cty.dat format, which is also the format of the Big CTY file.
+// Each record is a header line "Name: CQ: ITU: Cont: Lat: Long: Offset: Prefix:" followed by a
+// comma-separated alias list ending in ';'. Aliases may carry the standard overrides: (CQ zone),
+// [ITU zone], <lat/long>, {continent} and ~offset~; aliases starting with '=' are exact calls.
+func LoadCtyDat(r io.Reader) (*CtyDatabase, error) {
+	db := newCtyDatabase()
+	var record strings.Builder
+	line, start := 0, 0
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == emptyString {
+			continue
+		}
+		if record.Len() == 0 {
+			start = line
+		}
+		record.WriteString(text)
+		record.WriteByte(' ')
+		if !strings.HasSuffix(text, ";") {
+			continue
+		}
+		if err := db.addDatRecord(record.String()); err != nil {
+			return nil, fmt.Errorf("line %d: %w", start, err)
+		}
+		record.Reset()
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if record.Len() != 0 {
+		return nil, fmt.Errorf("line %d: %w: unterminated record", start, ErrCtyFormat)
+	}
+	return db, nil
+}
+
+// LoadCtyCSV parses a country file in cty.csv format: one entity per row with the columns primary
+// prefix, name, DXCC code, continent, CQ zone, ITU zone, latitude, longitude, UTC offset and a
+// space-separated alias list ending in ';'.
+func LoadCtyCSV(r io.Reader) (*CtyDatabase, error) {
+	db := newCtyDatabase()
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	for row := 1; ; row++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w: %v", row, ErrCtyFormat, err)
+		}
+		if len(rec) < 10 {
+			return nil, fmt.Errorf("row %d: %w: expected 10 columns, got %d", row, ErrCtyFormat, len(rec))
+		}
+		e, err := parseCtyHeader(rec[1], rec[4], rec[5], rec[3], rec[6], rec[7], rec[8], rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		if code, err := strconv.Atoi(strings.TrimSpace(rec[2])); err == nil && code > 0 {
+			e.DXCC = code
+		}
+		aliases := strings.Fields(strings.TrimSuffix(strings.TrimSpace(rec[9]), ";"))
+		if err := db.addEntity(e, aliases); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+	}
+	return db, nil
+}
+
+// Entities returns the entities in the order they appear in the file.
+func (db *CtyDatabase) Entities() []CtyEntity {
+	out := make([]CtyEntity, len(db.entities))
+	for i, e := range db.entities {
+		out[i] = *e
+	}
+	return out
+}
+
+// Resolve returns the entity, zones, location and UTC offset for a callsign. Exact-call entries take
+// precedence, then the longest matching prefix. Compound calls are split with ParseCallsign and
+// resolved from their DXCCCall, so in "EA8/G4ABC/P" the portable prefix governs, a call-area change
+// such as "UA1ABC/9" uses the new area, and modifiers such as /P or /QRP are ignored. Maritime and
+// aeronautical mobile calls (/MM, /AM) and calls that do not parse return ErrCallsignNotFound.
+func (db *CtyDatabase) Resolve(call string) (CallsignInfo, error) {
+	call = strings.ToUpper(strings.TrimSpace(call))
+	if m, ok := db.exact[call]; ok {
+		return m.info(call, true), nil
+	}
+	cs, err := ParseCallsign(call)
+	if err != nil {
+		return CallsignInfo{}, fmt.Errorf("%w: %w", ErrCallsignNotFound, err)
+	}
+	if cs.Maritime {
+		return CallsignInfo{}, ErrCallsignNotFound
+	}
+	if m, ok := db.exact[cs.HomeCall]; ok && cs.Portable == emptyString {
+		return m.info(call, true), nil
+	}
+	lookup := cs.DXCCCall()
+	for n := len(lookup); n > 0; n-- {
+		if m, ok := db.prefixes[lookup[:n]]; ok {
+			return m.info(call, false), nil
+		}
+	}
+	return CallsignInfo{}, ErrCallsignNotFound
+}
+
+// info returns the CallsignInfo for a match.
+func (m ctyMatch) info(call string, exact bool) CallsignInfo {
+	return CallsignInfo{
+		Callsign:   call,
+		Entity:     *m.entity,
+		DXCC:       m.entity.DXCC,
+		Continent:  m.continent,
+		CQZone:     m.cq,
+		ITUZone:    m.itu,
+		Location:   m.location,
+		UTCOffset:  m.utcOffset,
+		ExactMatch: exact,
+	}
+}
+
+func newCtyDatabase() *CtyDatabase {
+	return &CtyDatabase{prefixes: make(map[string]ctyMatch), exact: make(map[string]ctyMatch)}
+}
+
+// addDatRecord parses one cty.dat record (header and alias list, joined into one string).
+func (db *CtyDatabase) addDatRecord(record string) error {
+	fields := strings.SplitN(record, ":", 9)
+	if len(fields) != 9 {
+		return fmt.Errorf("%w: expected 8 header fields", ErrCtyFormat)
+	}
+	e, err := parseCtyHeader(fields[0], fields[1], fields[2], fields[3], fields[4], fields[5], fields[6], fields[7])
+	if err != nil {
+		return err
+	}
+	e.DXCC = ctyDXCC(e.PrimaryPrefix, e.Name, e.WAEOnly)
+
+	list := strings.TrimSuffix(strings.TrimSpace(fields[8]), ";")
+	var aliases []string
+	for _, a := range strings.Split(list, ",") {
+		if a = strings.TrimSpace(a); a != emptyString {
+			aliases = append(aliases, a)
+		}
+	}
+	return db.addEntity(e, aliases)
+}
+
+// addEntity records an entity and its aliases.
+func (db *CtyDatabase) addEntity(e *CtyEntity, aliases []string) error {
+	if e.DXCC == 0 {
+		e.DXCC = ctyDXCC(e.PrimaryPrefix, e.Name, e.WAEOnly)
+	}
+	db.entities = append(db.entities, e)
+	base := ctyMatch{entity: e, continent: e.Continent, cq: e.CQZone, itu: e.ITUZone, location: e.Location, utcOffset: e.UTCOffset}
+	for _, a := range aliases {
+		m, key, exact, err := parseCtyAlias(a, base)
+		if err != nil {
+			return err
+		}
+		if exact {
+			db.exact[key] = m
+		} else {
+			db.prefixes[key] = m
+		}
+	}
+	return nil
+}
+
+// parseCtyHeader builds an entity from the header fields common to cty.dat and cty.csv.
+func parseCtyHeader(name, cq, itu, continent, lat, long, offset, prefix string) (*CtyEntity, error) {
+	e := &CtyEntity{
+		Name:      strings.TrimSpace(name),
+		Continent: strings.ToUpper(strings.TrimSpace(continent)),
+	}
+	prefix = strings.ToUpper(strings.TrimSpace(prefix))
+	e.WAEOnly = strings.HasPrefix(prefix, "*")
+	e.PrimaryPrefix = strings.TrimPrefix(prefix, "*")
+
+	var err error
+	if e.CQZone, err = strconv.Atoi(strings.TrimSpace(cq)); err != nil {
+		return nil, fmt.Errorf("%w: CQ zone %q", ErrCtyFormat, cq)
+	}
+	if e.ITUZone, err = strconv.Atoi(strings.TrimSpace(itu)); err != nil {
+		return nil, fmt.Errorf("%w: ITU zone %q", ErrCtyFormat, itu)
+	}
+	if e.Location, err = parseCtyLocation(lat, long); err != nil {
+		return nil, err
+	}
+	if e.UTCOffset, err = parseCtyOffset(offset); err != nil {
+		return nil, err
+	}
+	if e.Name == emptyString || e.PrimaryPrefix == emptyString {
+		return nil, fmt.Errorf("%w: missing name or prefix", ErrCtyFormat)
+	}
+	return e, nil
+}
+
+// parseCtyAlias parses a prefix or exact call with optional overrides, starting from base.
+func parseCtyAlias(alias string, base ctyMatch) (m ctyMatch, key string, exact bool, err error) {
+	m = base
+	exact = strings.HasPrefix(alias, "=")
+	alias = strings.TrimPrefix(alias, "=")
+
+	end := strings.IndexAny(alias, "([<{~")
+	if end < 0 {
+		end = len(alias)
+	}
+	key = strings.ToUpper(alias[:end])
+	if key == emptyString {
+		return m, key, exact, fmt.Errorf("%w: empty alias", ErrCtyFormat)
+	}
+
+	rest := alias[end:]
+	for rest != emptyString {
+		closing := map[byte]byte{'(': ')', '[': ']', '<': '>', '{': '}', '~': '~'}[rest[0]]
+		stop := strings.IndexByte(rest[1:], closing)
+		if closing == 0 || stop < 0 {
+			return m, key, exact, fmt.Errorf("%w: malformed override in %q", ErrCtyFormat, alias)
+		}
+		value := rest[1 : stop+1]
+		switch rest[0] {
+		case '(':
+			m.cq, err = strconv.Atoi(value)
+		case '[':
+			m.itu, err = strconv.Atoi(value)
+		case '{':
+			m.continent = strings.ToUpper(value)
+		case '<':
+			parts := strings.Split(value, "/")
+			if len(parts) != 2 {
+				err = ErrCtyFormat
+				break
+			}
+			m.location, err = parseCtyLocation(parts[0], parts[1])
+		case '~':
+			m.utcOffset, err = parseCtyOffset(value)
+		}
+		if err != nil {
+			return m, key, exact, fmt.Errorf("%w: malformed override in %q", ErrCtyFormat, alias)
+		}
+		rest = rest[stop+2:]
+	}
+	return m, key, exact, nil
+}
+
+// parseCtyLocation converts a country-file latitude and longitude (west positive) to a LatLong.
+func parseCtyLocation(lat, long string) (LatLong, error) {
+	la, err1 := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	lo, err2 := strconv.ParseFloat(strings.TrimSpace(long), 64)
+	if err1 != nil || err2 != nil {
+		return LatLong{}, fmt.Errorf("%w: location %q/%q", ErrCtyFormat, lat, long)
+	}
+	p := LatLong{Latitude: la, Longitude: -lo}
+	if err := p.Validate(); err != nil {
+		return LatLong{}, fmt.Errorf("%w: %v", ErrCtyFormat, err)
+	}
+	return p, nil
+}
+
+// parseCtyOffset converts a country-file time offset (hours behind UTC) to hours east of UTC.
+func parseCtyOffset(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: UTC offset %q", ErrCtyFormat, s)
+	}
+	return -v, nil
+}
+
+// ctyDXCC finds the DXCC entity table code for a country-file entity, using its primary prefix and
+// falling back to its name. WAE-only entities map to the DXCC entity they belong to.
+func ctyDXCC(prefix, name string, waeOnly bool) int {
+	if waeOnly {
+		return ctyWAEParents[prefix]
+	}
+	if code, ok := ctyPrefixAliases[prefix]; ok {
+		return code
+	}
+	if code, ok := dxccByPrefix[prefix]; ok {
+		return code
+	}
+	if e, ok := LookupDXCCByName(name); ok && !e.Deleted {
+		return e.Code
+	}
+	return 0
+}
+
+// ctyWAEParents maps the primary prefixes of WAE-only entities to their DXCC entity.
+var ctyWAEParents = map[string]int{
+	"4U1V": 206, // Vienna International Centre: Austria
+	"GM/S": 279, // Shetland Islands: Scotland
+	"IG9":  248, // African Italy: Italy
+	"IT9":  248, // Sicily: Italy
+	"JW/B": 259, // Bear Island: Svalbard
+	"TA1":  390, // European Turkey: Turkey
+}
+
+// ctyPrefixAliases maps country-file primary prefixes that differ from the DXCC table's.
+var ctyPrefixAliases = map[string]int{
+	"IS":   225, // Sardinia
+	"JD/M": 177, // Minami Torishima
+	"JD/O": 192, // Ogasawara
+}
+
+// dxccByPrefix indexes current entities by primary prefix.
+var dxccByPrefix = func() map[string]int {
+	m := make(map[string]int, len(dxccEntities))
+	for _, e := range dxccEntities {
+		if !e.Deleted {
+			m[e.Prefix] = e.Code
+		}
+	}
+	return m
+}()
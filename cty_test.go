@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ctyDatSample is an excerpt in the format of cty.dat / Big CTY.
+const ctyDatSample = `Sov Mil Order of Malta:   15:  28:  EU:   41.90:   -12.43:    -1.0:  1A:
+    1A;
+Canada:                   05:  09:  NA:   44.35:    78.75:     5.0:  VE:
+    CF,CG,CJ,CK,CY,CZ,VA,VB,VC,VD,VE,VF,VG,VO,VX,VY,XJ,XK,XL,XM,XN,XO,
+    VA7(3)[2],VE7(3)[2],VY1(1)[2],=VE2EM/M,=VY0ERC(2)[4]<63.75/68.52>~5.0~;
+Sable Island:             05:  09:  NA:   43.93:    59.90:     4.0:  CY0:
+    CY0;
+Shetland Islands:         14:  27:  EU:   60.50:     1.50:     0.0:  *GM/s:
+    2M0BDR,2M0BDT,GM0ILB,=GB0ULL;
+Scotland:                 14:  27:  EU:   56.82:     4.18:     0.0:  GM:
+    2A,2I,2M,GM,GS,MA,MM,MS,=GB2CR;
+England:                  14:  27:  EU:   52.77:     1.47:     0.0:  G:
+    2E,G,M;
+Canary Islands:           33:  36:  AF:   28.32:    15.85:     0.0:  EA8:
+    AM8,AN8,AO8,EA8,EB8,EC8,ED8,EE8,EF8,EG8,EH8;
+Spain:                    14:  37:  EU:   40.32:     3.43:    -1.0:  EA:
+    AM,AN,AO,EA,EB,EC,ED,EE,EF,EG,EH;
+Japan:                    25:  45:  AS:   36.40:  -138.38:    -9.0:  JA:
+    7J,7K,7L,7M,7N,8J,8K,8L,8M,8N,JA,JE,JF,JG,JH,JI,JJ,JK,JL,JM,JN,JO,JP,JQ,JR,JS;
+Minami Torishima:         27:  90:  OC:   24.28:  -153.97:   -10.0:  JD/m:
+    =JD1BMM,=JD1YAA;
+Alaska:                   01:  01:  NA:   61.40:   148.87:     8.0:  KL:
+    AL,KL,NL,WL,=K2ICW;
+United States:            05:  08:  NA:   37.53:    91.67:     5.0:  K:
+    AA,AB,K,N,W,
+    KL7AA{OC},W6(3)[6],=W1AW;
+`
+
+const ctyCSVSample = `1A,Sov Mil Order of Malta,246,EU,15,28,41.90,-12.43,-1.0,1A;
+GM,Scotland,279,EU,14,27,56.82,4.18,0.0,2A 2I 2M GM GS MA MM MS =GB2CR;
+*GM/s,Shetland Islands,279,EU,14,27,60.50,1.50,0.0,GM0ILB =GB0ULL;
+K,United States,291,NA,05,08,37.53,91.67,5.0,AA AB K N W W6(3)[6] =W1AW;
+`
+
+func loadSample(t *testing.T) *CtyDatabase {
+	t.Helper()
+	db, err := LoadCtyDat(strings.NewReader(ctyDatSample))
+	if err != nil {
+		t.Fatalf("LoadCtyDat: %v", err)
+	}
+	return db
+}
+
+func TestLoadCtyDat_Entities(t *testing.T) {
+	db := loadSample(t)
+	entities := db.Entities()
+	if len(entities) != 12 {
+		t.Fatalf("expected 12 entities, got %d", len(entities))
+	}
+	want := map[string]struct {
+		dxcc int
+		wae  bool
+	}{
+		"Sov Mil Order of Malta": {246, false},
+		"Canada":                 {1, false},
+		"Sable Island":           {211, false},
+		"Shetland Islands":       {279, true},
+		"Scotland":               {279, false},
+		"England":                {223, false},
+		"Canary Islands":         {29, false},
+		"Spain":                  {281, false},
+		"Japan":                  {339, false},
+		"Minami Torishima":       {177, false},
+		"Alaska":                 {6, false},
+		"United States":          {291, false},
+	}
+	for _, e := range entities {
+		w, ok := want[e.Name]
+		if !ok || e.DXCC != w.dxcc || e.WAEOnly != w.wae {
+			t.Fatalf("entity %q: DXCC %d WAE %v", e.Name, e.DXCC, e.WAEOnly)
+		}
+	}
+	us := entities[len(entities)-1]
+	if us.Location.Longitude > 0 || math.Abs(us.UTCOffset+5) > 1e-9 {
+		t.Fatalf("United States location/offset not converted: %+v", us)
+	}
+}
+
+func TestCtyDatabase_Resolve(t *testing.T) {
+	db := loadSample(t)
+	cases := []struct {
+		call      string
+		dxcc      int
+		entity    string
+		cq, itu   int
+		continent string
+		exact     bool
+	}{
+		{"G4ABC", 223, "England", 14, 27, "EU", false},
+		{"m0xyz", 223, "England", 14, 27, "EU", false},
+		{"GM3ABC", 279, "Scotland", 14, 27, "EU", false},
+		{"GM0ILB", 279, "Shetland Islands", 14, 27, "EU", false},
+		{"EA8/G4ABC/P", 29, "Canary Islands", 33, 36, "AF", false},
+		{"G4ABC/EA8", 29, "Canary Islands", 33, 36, "AF", false},
+		{"EA1ABC", 281, "Spain", 14, 37, "EU", false},
+		{"VE7XYZ", 1, "Canada", 3, 2, "NA", false},
+		{"VE3XYZ", 1, "Canada", 5, 9, "NA", false},
+		{"CY0A", 211, "Sable Island", 5, 9, "NA", false},
+		{"W6ABC", 291, "United States", 3, 6, "NA", false},
+		{"W1AW", 291, "United States", 5, 8, "NA", true},
+		{"W1AW/7", 291, "United States", 5, 8, "NA", false},
+		{"W1AW/6", 291, "United States", 3, 6, "NA", false},
+		{"KL7AA", 291, "United States", 5, 8, "OC", false},
+		{"KL7XX", 6, "Alaska", 1, 1, "NA", false},
+		{"K2ICW", 6, "Alaska", 1, 1, "NA", true},
+		{"VE2EM/M", 1, "Canada", 5, 9, "NA", true},
+		{"VY0ERC", 1, "Canada", 2, 4, "NA", true},
+		{"JD1BMM", 177, "Minami Torishima", 27, 90, "OC", true},
+		{"JA1ABC/QRP", 339, "Japan", 25, 45, "AS", false},
+	}
+	for _, c := range cases {
+		got, err := db.Resolve(c.call)
+		if err != nil {
+			t.Fatalf("Resolve(%q): %v", c.call, err)
+		}
+		if got.DXCC != c.dxcc || got.Entity.Name != c.entity || got.CQZone != c.cq || got.ITUZone != c.itu ||
+			got.Continent != c.continent || got.ExactMatch != c.exact {
+			t.Fatalf("Resolve(%q) = %+v", c.call, got)
+		}
+	}
+
+	vy0, _ := db.Resolve("VY0ERC")
+	if math.Abs(vy0.Location.Latitude-63.75) > 1e-9 || math.Abs(vy0.Location.Longitude+68.52) > 1e-9 || vy0.UTCOffset != -5 {
+		t.Fatalf("VY0ERC overrides not applied: %+v", vy0)
+	}
+	if ja, _ := db.Resolve("JA1ABC"); ja.UTCOffset != 9 || ja.Location.Longitude < 0 {
+		t.Fatalf("Japan offset/location wrong: %+v", ja)
+	}
+
+	for _, call := range []string{"", "ZZ9ZZ", "G4ABC/MM", "W1AW/AM", "/"} {
+		if _, err := db.Resolve(call); !errors.Is(err, ErrCallsignNotFound) {
+			t.Fatalf("Resolve(%q) expected ErrCallsignNotFound, got %v", call, err)
+		}
+	}
+}
+
+func TestLoadCtyCSV(t *testing.T) {
+	db, err := LoadCtyCSV(strings.NewReader(ctyCSVSample))
+	if err != nil {
+		t.Fatalf("LoadCtyCSV: %v", err)
+	}
+	if n := len(db.Entities()); n != 4 {
+		t.Fatalf("expected 4 entities, got %d", n)
+	}
+	cases := []struct {
+		call string
+		dxcc int
+		name string
+	}{
+		{"1A0KM", 246, "Sov Mil Order of Malta"},
+		{"MM0ABC", 279, "Scotland"},
+		{"GM0ILB", 279, "Shetland Islands"},
+		{"W6XX", 291, "United States"},
+		{"W1AW", 291, "United States"},
+	}
+	for _, c := range cases {
+		got, err := db.Resolve(c.call)
+		if err != nil || got.DXCC != c.dxcc || got.Entity.Name != c.name {
+			t.Fatalf("Resolve(%q) = %+v, %v", c.call, got, err)
+		}
+	}
+	if w6, _ := db.Resolve("W6XX"); w6.CQZone != 3 || w6.ITUZone != 6 {
+		t.Fatalf("zone overrides not applied: %+v", w6)
+	}
+}
+
+func TestLoadCty_Errors(t *testing.T) {
+	bad := []string{
+		"Canada: 05: 09: NA: 44.35: 78.75: 5.0: VE:\n VE,VA",          // unterminated
+		"Canada: xx: 09: NA: 44.35: 78.75: 5.0: VE:\n VE;",            // bad CQ zone
+		"Canada: 05: 09: NA: 44.35: 78.75: VE:\n VE;",                 // missing field
+		"Canada: 05: 09: NA: 44.35: 78.75: 5.0: VE:\n VE(5;",          // malformed override
+		"Canada: 05: 09: NA: 144.35: 78.75: 5.0: VE:\n VE;",           // latitude out of range
+		"Canada: 05: 09: NA: 44.35: 78.75: 5.0: VE:\n VE<44.3>;",      // bad location override
+		"Canada: 05: 09: NA: 44.35: 78.75: 5.0: VE:\n VE,,=;",         // empty exact call
+		"Canada: 05: 09: NA: 44.35: 78.75: 5.0: VE:\n VE~x~;",         // bad offset
+		"Canada: 05: 09: NA: 44.35: 78.75: 5.0: VE:\n VE;\nFoo: 1: 2", // trailing garbage
+	}
+	for _, in := range bad {
+		if _, err := LoadCtyDat(strings.NewReader(in)); !errors.Is(err, ErrCtyFormat) {
+			t.Fatalf("LoadCtyDat(%q) expected ErrCtyFormat, got %v", in, err)
+		}
+	}
+	if _, err := LoadCtyCSV(strings.NewReader("K,United States,291,NA\n")); !errors.Is(err, ErrCtyFormat) {
+		t.Fatalf("LoadCtyCSV short row expected ErrCtyFormat, got %v", err)
+	}
+}
+
+func TestLoadCtyFile(t *testing.T) {
+	dir := t.TempDir()
+	dat := filepath.Join(dir, "cty.dat")
+	csvPath := filepath.Join(dir, "cty.csv")
+	if err := os.WriteFile(dat, []byte(ctyDatSample), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(csvPath, []byte(ctyCSVSample), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{dat, csvPath} {
+		db, err := LoadCtyFile(p)
+		if err != nil {
+			t.Fatalf("LoadCtyFile(%s): %v", p, err)
+		}
+		if info, err := db.Resolve("W1AW"); err != nil || info.DXCC != 291 {
+			t.Fatalf("%s: Resolve(W1AW) = %+v, %v", p, info, err)
+		}
+	}
+	if _, err := LoadCtyFile(filepath.Join(dir, "cty.xml")); err == nil {
+		t.Fatalf("expected error for missing file")
+	}
+	xml := filepath.Join(dir, "cty.xml")
+	if err := os.WriteFile(xml, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCtyFile(xml); !errors.Is(err, ErrCtyFileUnsupported) {
+		t.Fatalf("expected ErrCtyFileUnsupported, got %v", err)
+	}
+}
+
+func TestCtyDXCC_MatchesEntityTable(t *testing.T) {
+	for _, e := range CurrentDXCCEntities() {
+		if got := ctyDXCC(e.Prefix, e.Name, false); got != e.Code {
+			t.Fatalf("prefix %s of entity %d resolves to %d", e.Prefix, e.Code, got)
+		}
+	}
+	for prefix, code := range ctyWAEParents {
+		if e, ok := LookupDXCC(code); !ok || e.Deleted {
+			t.Fatalf("WAE entity %s maps to invalid entity %d", prefix, code)
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strings"
 	"time"
 )
 
+var ErrADIFTimestamp = errors.New("invalid ADIF date or time")
+
 // FormatDate converts a raw date string in YYYYMMDD format into a formatted date string in YYYY-MM-DD format.
 // Returns "YYYY-MM-DD" if the input does not have exactly 8 characters.
 func FormatDate(rawDate string) string {
@@ -68,6 +72,19 @@ func IsValidTimeADIF(s string) bool {
 	return true
 }
 
+// ParseADIFTimestamp combines an ADIF date (YYYYMMDD) and time (HHMM or HHMMSS), such as QSO_DATE
+// and TIME_ON, into a UTC time.
+func ParseADIFTimestamp(date, tm string) (time.Time, error) {
+	date, tm = strings.TrimSpace(date), strings.TrimSpace(tm)
+	if !IsValidDateYYYYMMDD(date) || !IsValidTimeADIF(tm) {
+		return time.Time{}, fmt.Errorf("%w: %q %q", ErrADIFTimestamp, date, tm)
+	}
+	if len(tm) == 4 {
+		tm += "00"
+	}
+	return time.Parse("20060102150405", date+tm)
+}
+
 func DateNowAsYYYYMMDD() string {
 	return time.Now().UTC().Format("20060102")
 }
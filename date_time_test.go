@@ -1,6 +1,10 @@
 package utils
 
-import "testing"
+import (
+	"errors"
+	"testing"
+	"time"
+)
 
 func TestFormatDate(t *testing.T) {
 	cases := map[string]string{
@@ -27,3 +31,20 @@ func TestFormatTime(t *testing.T) {
 		}
 	}
 }
+
+func TestParseADIFTimestamp(t *testing.T) {
+	cases := map[[2]string]time.Time{
+		{"20240131", "1234"}:   time.Date(2024, 1, 31, 12, 34, 0, 0, time.UTC),
+		{"20240131", "123456"}: time.Date(2024, 1, 31, 12, 34, 56, 0, time.UTC),
+	}
+	for in, want := range cases {
+		if got, err := ParseADIFTimestamp(in[0], in[1]); err != nil || !got.Equal(want) {
+			t.Fatalf("ParseADIFTimestamp(%q, %q) = %v, %v; want %v", in[0], in[1], got, err, want)
+		}
+	}
+	for _, in := range [][2]string{{"20240131", ""}, {"2024-01-31", "1234"}, {"20240230", "1234"}, {"20240131", "2460"}} {
+		if _, err := ParseADIFTimestamp(in[0], in[1]); !errors.Is(err, ErrADIFTimestamp) {
+			t.Fatalf("ParseADIFTimestamp(%q, %q): expected ErrADIFTimestamp, got %v", in[0], in[1], err)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrDupeCheck = errors.New("QSO cannot be checked for duplicates")
+
+// DupeModeMatch selects how the modes of two QSOs are compared.
+type DupeModeMatch int
+
+const (
+	// DupeModeIgnore treats QSOs in any mode as duplicates of each other.
+	DupeModeIgnore DupeModeMatch = iota
+	// DupeModeExact requires the same ADIF MODE, after migrating deprecated modes ("USB" is SSB).
+	DupeModeExact
+	// DupeModeFamily requires the same mode family (see ModeFamily), so SSB matches FM.
+	DupeModeFamily
+)
+
+// DupeRules decides when a QSO duplicates an earlier one. The callsigns must always match; Band and
+// Mode add the band and mode to the match. Window is the largest difference between the start
+// times, with zero matching at any time. FreqToleranceKHz, when non-zero, also requires the FREQ
+// values to be within that many kHz if both QSOs have one.
+type DupeRules struct {
+	Band             bool
+	Mode             DupeModeMatch
+	Window           time.Duration
+	FreqToleranceKHz float64
+}
+
+var (
+	// DupeRulesLog finds the same call, band and mode worked within ten minutes, as when a QSO is
+	// logged twice.
+	DupeRulesLog = DupeRules{Band: true, Mode: DupeModeExact, Window: 10 * time.Minute}
+	// DupeRulesContestBand allows one QSO with a station per band for the whole contest.
+	DupeRulesContestBand = DupeRules{Band: true}
+	// DupeRulesContestMode allows one QSO with a station per mode family for the whole contest.
+	DupeRulesContestMode = DupeRules{Mode: DupeModeFamily}
+	// DupeRulesContestBandMode allows one QSO with a station per band and mode family.
+	DupeRulesContestBandMode = DupeRules{Band: true, Mode: DupeModeFamily}
+	// DupeRulesImportMerge matches the same QSO recorded by two loggers whose clocks, mode names and
+	// frequency readouts differ slightly.
+	DupeRulesImportMerge = DupeRules{Band: true, Mode: DupeModeFamily, Window: 5 * time.Minute, FreqToleranceKHz: 5}
+)
+
+// Mode families returned by ModeFamily.
+const (
+	ModeFamilyCW      = "CW"
+	ModeFamilyPhone   = "PHONE"
+	ModeFamilyDigital = "DIGITAL"
+)
+
+// ModeFamily returns the family of an ADIF mode or submode: CW, PHONE (SSB, AM, FM and digital
+// voice) or DIGITAL (everything else, including RTTY and image modes). It returns "" for an empty
+// mode.
+func ModeFamily(mode string) string {
+	mode = strings.ToUpper(strings.TrimSpace(mode))
+	if mode == emptyString {
+		return emptyString
+	}
+	if m, _, err := NormalizeModeSubmode(mode, emptyString); err == nil {
+		mode = m
+	}
+	switch mode {
+	case "CW":
+		return ModeFamilyCW
+	case "SSB", "AM", "FM", "DIGITALVOICE":
+		return ModeFamilyPhone
+	default:
+		return ModeFamilyDigital
+	}
+}
+
+// DupeChecker finds duplicate QSOs in a log under a set of DupeRules. QSOs are indexed by callsign
+// and, as the rules require, band and mode, with each index entry kept in time order, so a check
+// costs a map lookup and a binary search however large the log is.
+type DupeChecker struct {
+	rules DupeRules
+	index map[string][]dupeEntry
+	n     int
+}
+
+type dupeEntry struct {
+	at   time.Time
+	khz  float64 // 0 when the QSO has no FREQ
+	seqn int
+}
+
+// NewDupeChecker returns an empty DupeChecker applying rules.
+func NewDupeChecker(rules DupeRules) *DupeChecker {
+	return &DupeChecker{rules: rules, index: make(map[string][]dupeEntry)}
+}
+
+// Len returns the number of QSOs added.
+func (d *DupeChecker) Len() int {
+	return d.n
+}
+
+// Add adds a QSO to the log and returns its sequence number, counting from zero in the order QSOs
+// are added.
+func (d *DupeChecker) Add(rec ADIFRecord) (int, error) {
+	key, e, err := d.entry(rec)
+	if err != nil {
+		return 0, err
+	}
+	e.seqn = d.n
+	entries := d.index[key]
+	i := len(entries)
+	if d.rules.Window > 0 {
+		// Logs arrive almost in time order, so this rarely moves anything.
+		i = sort.Search(len(entries), func(j int) bool { return entries[j].at.After(e.at) })
+	}
+	entries = append(entries, dupeEntry{})
+	copy(entries[i+1:], entries[i:])
+	entries[i] = e
+	d.index[key] = entries
+	d.n++
+	return e.seqn, nil
+}
+
+// Dupes returns the sequence numbers of the QSOs already added that rec duplicates, in ascending
+// order. rec itself is not added.
+func (d *DupeChecker) Dupes(rec ADIFRecord) ([]int, error) {
+	key, e, err := d.entry(rec)
+	if err != nil {
+		return nil, err
+	}
+	entries := d.index[key]
+	if d.rules.Window > 0 {
+		from := sort.Search(len(entries), func(j int) bool { return !entries[j].at.Before(e.at.Add(-d.rules.Window)) })
+		to := sort.Search(len(entries), func(j int) bool { return entries[j].at.After(e.at.Add(d.rules.Window)) })
+		entries = entries[from:to]
+	}
+	var dupes []int
+	for _, c := range entries {
+		if d.rules.FreqToleranceKHz > 0 && e.khz > 0 && c.khz > 0 && math.Abs(e.khz-c.khz) > d.rules.FreqToleranceKHz {
+			continue
+		}
+		dupes = append(dupes, c.seqn)
+	}
+	sort.Ints(dupes)
+	return dupes, nil
+}
+
+// IsDupe reports whether rec duplicates a QSO already added.
+func (d *DupeChecker) IsDupe(rec ADIFRecord) (bool, error) {
+	dupes, err := d.Dupes(rec)
+	return len(dupes) > 0, err
+}
+
+// entry returns the index key and entry for rec.
+func (d *DupeChecker) entry(rec ADIFRecord) (string, dupeEntry, error) {
+	var e dupeEntry
+	call := strings.ToUpper(strings.TrimSpace(rec.Value("CALL")))
+	if call == emptyString {
+		return emptyString, e, fmt.Errorf("%w: no CALL", ErrDupeCheck)
+	}
+	key := call
+
+	if d.rules.Band {
+		band, ok := RecordBand(rec)
+		if !ok {
+			return emptyString, e, fmt.Errorf("%w: %s: no BAND or FREQ", ErrDupeCheck, call)
+		}
+		key += "|" + band
+	}
+
+	if d.rules.Mode != DupeModeIgnore {
+		mode := rec.Value("MODE")
+		if mode == emptyString {
+			return emptyString, e, fmt.Errorf("%w: %s: no MODE", ErrDupeCheck, call)
+		}
+		if d.rules.Mode == DupeModeFamily {
+			mode = ModeFamily(mode)
+		} else if m, _, err := NormalizeModeSubmode(mode, rec.Value("SUBMODE")); err == nil {
+			mode = m
+		} else {
+			mode = strings.ToUpper(strings.TrimSpace(mode))
+		}
+		key += "|" + mode
+	}
+
+	if d.rules.Window > 0 {
+		at, err := rec.TimeOn()
+		if err != nil {
+			return emptyString, e, fmt.Errorf("%w: %s: %w", ErrDupeCheck, call, err)
+		}
+		e.at = at
+	}
+
+	if d.rules.FreqToleranceKHz > 0 {
+		if mhz, err := strconv.ParseFloat(strings.TrimSpace(rec.Value("FREQ")), 64); err == nil && mhz > 0 {
+			e.khz = mhz * 1000
+		}
+	}
+	return key, e, nil
+}
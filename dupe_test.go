@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func dupeQSO(call, band, mode, date, tm string) ADIFRecord {
+	return ADIFRecord{Fields: []ADIFField{
+		{Name: "CALL", Value: call},
+		{Name: "BAND", Value: band},
+		{Name: "MODE", Value: mode},
+		{Name: "QSO_DATE", Value: date},
+		{Name: "TIME_ON", Value: tm},
+	}}
+}
+
+func addDupeQSOs(t testing.TB, d *DupeChecker, recs ...ADIFRecord) {
+	t.Helper()
+	for _, rec := range recs {
+		if _, err := d.Add(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDupeChecker_Log(t *testing.T) {
+	d := NewDupeChecker(DupeRulesLog)
+	addDupeQSOs(t, d,
+		dupeQSO("W1AW", "20m", "SSB", "20240101", "1200"),
+		dupeQSO("W1AW", "20m", "CW", "20240101", "1205"),
+		dupeQSO("W1AW", "20m", "SSB", "20240101", "1300"),
+	)
+	tests := []struct {
+		rec  ADIFRecord
+		want []int
+	}{
+		{dupeQSO("w1aw", "20M", "USB", "20240101", "1208"), []int{0}},
+		{dupeQSO("W1AW", "20m", "SSB", "20240101", "1255"), []int{2}},
+		{dupeQSO("W1AW", "20m", "CW", "20240101", "1215"), []int{1}},
+		{dupeQSO("W1AW", "20m", "SSB", "20240101", "1230"), nil},
+		{dupeQSO("W1AW", "40m", "SSB", "20240101", "1200"), nil},
+		{dupeQSO("W1AW", "20m", "FM", "20240101", "1200"), nil},
+		{dupeQSO("W1AW/P", "20m", "SSB", "20240101", "1200"), nil},
+	}
+	for _, tt := range tests {
+		got, err := d.Dupes(tt.rec)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("Dupes(%+v) = %v, %v; want %v", tt.rec.Fields, got, err, tt.want)
+		}
+	}
+}
+
+func TestDupeChecker_Contest(t *testing.T) {
+	log := []ADIFRecord{
+		dupeQSO("DL1ABC", "20m", "SSB", "20241123", "0001"),
+		dupeQSO("DL1ABC", "40m", "CW", "20241124", "2300"),
+	}
+	tests := []struct {
+		rules DupeRules
+		rec   ADIFRecord
+		want  bool
+	}{
+		{DupeRulesContestBand, dupeQSO("DL1ABC", "20m", "CW", "20241124", "2359"), true},
+		{DupeRulesContestBand, dupeQSO("DL1ABC", "15m", "SSB", "20241123", "0002"), false},
+		{DupeRulesContestMode, dupeQSO("DL1ABC", "80m", "FM", "20241124", "0000"), true},
+		{DupeRulesContestMode, dupeQSO("DL1ABC", "80m", "RTTY", "20241124", "0000"), false},
+		{DupeRulesContestBandMode, dupeQSO("DL1ABC", "20m", "LSB", "20241124", "0000"), true},
+		{DupeRulesContestBandMode, dupeQSO("DL1ABC", "20m", "CW", "20241124", "0000"), false},
+	}
+	for _, tt := range tests {
+		d := NewDupeChecker(tt.rules)
+		addDupeQSOs(t, d, log...)
+		if got, err := d.IsDupe(tt.rec); err != nil || got != tt.want {
+			t.Fatalf("%+v: IsDupe(%+v) = %v, %v; want %v", tt.rules, tt.rec.Fields, got, err, tt.want)
+		}
+	}
+}
+
+func TestDupeChecker_ImportMerge(t *testing.T) {
+	d := NewDupeChecker(DupeRulesImportMerge)
+	qso := func(freq, mode, tm string) ADIFRecord {
+		return ADIFRecord{Fields: []ADIFField{
+			{Name: "CALL", Value: "JA1XYZ"},
+			{Name: "FREQ", Value: freq},
+			{Name: "MODE", Value: mode},
+			{Name: "QSO_DATE", Value: "20240601"},
+			{Name: "TIME_ON", Value: tm},
+		}}
+	}
+	addDupeQSOs(t, d, qso("14.074", "FT8", "0930"), qso("14.250", "SSB", "0945"))
+
+	tests := []struct {
+		rec  ADIFRecord
+		want []int
+	}{
+		{qso("14.0755", "FT4", "093400"), []int{0}},
+		{qso("14.074", "FT8", "0936"), nil},
+		{qso("14.080", "FT8", "0930"), nil},
+		{qso("14.252", "FM", "0941"), []int{1}},
+		{ADIFRecord{Fields: []ADIFField{{Name: "CALL", Value: "JA1XYZ"}, {Name: "BAND", Value: "20m"}, {Name: "MODE", Value: "DIGITALVOICE"}, {Name: "QSO_DATE", Value: "20240601"}, {Name: "TIME_ON", Value: "0948"}}}, []int{1}},
+	}
+	for _, tt := range tests {
+		got, err := d.Dupes(tt.rec)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("Dupes(%+v) = %v, %v; want %v", tt.rec.Fields, got, err, tt.want)
+		}
+	}
+}
+
+func TestDupeChecker_OutOfOrder(t *testing.T) {
+	d := NewDupeChecker(DupeRules{Window: time.Hour})
+	for _, tm := range []string{"1500", "1000", "1300", "1100"} {
+		addDupeQSOs(t, d, dupeQSO("K1ABC", "20m", "CW", "20240101", tm))
+	}
+	got, err := d.Dupes(dupeQSO("K1ABC", "", "", "20240101", "1200"))
+	if err != nil || !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Fatalf("unexpected dupes %v, %v", got, err)
+	}
+	if d.Len() != 4 {
+		t.Fatalf("unexpected length %d", d.Len())
+	}
+}
+
+func TestDupeChecker_Errors(t *testing.T) {
+	tests := []struct {
+		rules DupeRules
+		rec   ADIFRecord
+	}{
+		{DupeRulesLog, dupeQSO("", "20m", "CW", "20240101", "1200")},
+		{DupeRulesLog, dupeQSO("W1AW", "", "CW", "20240101", "1200")},
+		{DupeRulesLog, dupeQSO("W1AW", "20m", "", "20240101", "1200")},
+		{DupeRulesLog, dupeQSO("W1AW", "20m", "CW", "20240101", "")},
+	}
+	for _, tt := range tests {
+		d := NewDupeChecker(tt.rules)
+		if _, err := d.Add(tt.rec); !errors.Is(err, ErrDupeCheck) {
+			t.Fatalf("Add(%+v): expected ErrDupeCheck, got %v", tt.rec.Fields, err)
+		}
+		if _, err := d.IsDupe(tt.rec); !errors.Is(err, ErrDupeCheck) {
+			t.Fatalf("IsDupe(%+v): expected ErrDupeCheck, got %v", tt.rec.Fields, err)
+		}
+	}
+	if d := NewDupeChecker(DupeRulesContestBand); d.Len() != 0 {
+		t.Fatal("expected an empty checker")
+	}
+	if _, err := NewDupeChecker(DupeRulesContestBand).Add(dupeQSO("W1AW", "20m", "", "", "")); err != nil {
+		t.Fatalf("contest rules need no time or mode: %v", err)
+	}
+}
+
+// dupeLog returns a checker holding n QSOs a minute apart with 500 stations in rotation.
+func dupeLog(t testing.TB, n int) *DupeChecker {
+	d := NewDupeChecker(DupeRulesLog)
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		at := start.Add(time.Duration(i) * time.Minute)
+		addDupeQSOs(t, d, dupeQSO(fmt.Sprintf("K%dAB", i%500), "20m", "CW", at.Format("20060102"), at.Format("1504")))
+	}
+	return d
+}
+
+func TestDupeChecker_ManyQSOs(t *testing.T) {
+	d := dupeLog(t, 5000)
+	// K7AB was worked every 500 minutes; the last time at minute 4507.
+	at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Add(4510 * time.Minute)
+	got, err := d.Dupes(dupeQSO("K7AB", "20m", "CW", at.Format("20060102"), at.Format("1504")))
+	if err != nil || !reflect.DeepEqual(got, []int{4507}) {
+		t.Fatalf("unexpected dupes %v, %v", got, err)
+	}
+}
+
+func BenchmarkDupeChecker(b *testing.B) {
+	d := dupeLog(b, 100000)
+	at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Add(99510 * time.Minute)
+	rec := dupeQSO("K7AB", "20m", "CW", at.Format("20060102"), at.Format("1504"))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.Dupes(rec); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestModeFamily(t *testing.T) {
+	cases := map[string]string{
+		"cw":     ModeFamilyCW,
+		"USB":    ModeFamilyPhone,
+		"FM":     ModeFamilyPhone,
+		"DSTAR":  ModeFamilyPhone,
+		"FT8":    ModeFamilyDigital,
+		"RTTY":   ModeFamilyDigital,
+		"PSK31":  ModeFamilyDigital,
+		"":       emptyString,
+		"BOGUS1": ModeFamilyDigital,
+	}
+	for in, want := range cases {
+		if got := ModeFamily(in); got != want {
+			t.Fatalf("ModeFamily(%q) = %q; want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DXCCEntity describes one ARRL DXCC entity as enumerated by the ADIF DXCC Entity Code list.
+//
+// Continent is the ADIF continent abbreviation (AF, AN, AS, EU, NA, OC or SA). CQZones and ITUZones
+// list every zone the entity lies in; most entities have exactly one of each. Deleted entities
+// remain in the table so that historical QSOs can be resolved. ValidFrom and ValidTo give the
+// DXCC credit dates where the entity was created or deleted after the programme began; a zero
+// value means no such limit is recorded.
+type DXCCEntity struct {
+	Code      int
+	Name      string
+	Prefix    string
+	Continent string
+	CQZones   []int
+	ITUZones  []int
+	Deleted   bool
+	ValidFrom time.Time
+	ValidTo   time.Time
+}
+
+// CodeString returns the entity code as used in ADIF DXCC fields, e.g. "291".
+func (e DXCCEntity) CodeString() string {
+	return strconv.Itoa(e.Code)
+}
+
+// ValidAt reports whether a QSO made at t counts for this entity, taking the deleted flag and
+// validity dates into account.
+func (e DXCCEntity) ValidAt(t time.Time) bool {
+	if !e.ValidFrom.IsZero() && t.Before(e.ValidFrom) {
+		return false
+	}
+	if !e.ValidTo.IsZero() && !t.Before(e.ValidTo.AddDate(0, 0, 1)) {
+		return false
+	}
+	return true
+}
+
+var (
+	dxccByCode map[int]*DXCCEntity
+	dxccByName map[string]*DXCCEntity
+)
+
+func init() {
+	dxccByCode = make(map[int]*DXCCEntity, len(dxccEntities))
+	dxccByName = make(map[string]*DXCCEntity, len(dxccEntities)+len(dxccNameAliases))
+	for i := range dxccEntities {
+		e := &dxccEntities[i]
+		dxccByCode[e.Code] = e
+		// A current entity takes precedence over a deleted one of the same name (e.g. Comoros).
+		key := normalizeEntityName(e.Name)
+		if prev, ok := dxccByName[key]; !ok || (prev.Deleted && !e.Deleted) {
+			dxccByName[key] = e
+		}
+	}
+	for alias, code := range dxccNameAliases {
+		dxccByName[normalizeEntityName(alias)] = dxccByCode[code]
+	}
+}
+
+// LookupDXCC returns the entity with the given ADIF DXCC code.
+func LookupDXCC(code int) (DXCCEntity, bool) {
+	e, ok := dxccByCode[code]
+	if !ok {
+		return DXCCEntity{}, false
+	}
+	return *e, true
+}
+
+// LookupDXCCString is LookupDXCC for a code held as a string, as in ADIF records and DXCCFromISO2.
+func LookupDXCCString(code string) (DXCCEntity, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(code))
+	if err != nil {
+		return DXCCEntity{}, false
+	}
+	return LookupDXCC(n)
+}
+
+// LookupDXCCByName returns the entity with the given name. Matching ignores case, punctuation and
+// the usual abbreviations ("St." for Saint, "Is." for Islands, "&" for and), and accepts common
+// alternative names such as "USA", "Germany" or "Swaziland".
+func LookupDXCCByName(name string) (DXCCEntity, bool) {
+	e, ok := dxccByName[normalizeEntityName(name)]
+	if !ok {
+		return DXCCEntity{}, false
+	}
+	return *e, true
+}
+
+// DXCCEntities returns every entity, current and deleted, ordered by code.
+func DXCCEntities() []DXCCEntity {
+	out := make([]DXCCEntity, len(dxccEntities))
+	copy(out, dxccEntities)
+	sort.Slice(out, func(i, j int) bool { return out[i].Code < out[j].Code })
+	return out
+}
+
+// CurrentDXCCEntities returns the entities that have not been deleted, ordered by code.
+func CurrentDXCCEntities() []DXCCEntity {
+	all := DXCCEntities()
+	out := all[:0]
+	for _, e := range all {
+		if !e.Deleted {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// normalizeEntityName reduces an entity name to lower-case words so that spelling variants compare equal.
+func normalizeEntityName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+	fields := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, f := range fields {
+		switch f {
+		case "is", "i", "isl", "island", "islands":
+			fields[i] = "island"
+		case "st", "ste":
+			fields[i] = "saint"
+		case "rep":
+			fields[i] = "republic"
+		case "n":
+			fields[i] = "north"
+		case "s":
+			fields[i] = "south"
+		case "e":
+			fields[i] = "east"
+		case "w":
+			fields[i] = "west"
+		case "c":
+			fields[i] = "central"
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// current returns a current entity.
+func current(code int, name, prefix, continent string, cq, itu []int) DXCCEntity {
+	return DXCCEntity{Code: code, Name: name, Prefix: prefix, Continent: continent, CQZones: cq, ITUZones: itu}
+}
+
+// deleted returns a deleted entity; validTo is the last date for which it counts (zero if not recorded).
+func deleted(code int, name, prefix, continent string, cq, itu []int, validTo time.Time) DXCCEntity {
+	e := current(code, name, prefix, continent, cq, itu)
+	e.Deleted = true
+	e.ValidTo = validTo
+	return e
+}
+
+// since returns a copy of e that counts from the given date.
+func (e DXCCEntity) since(from time.Time) DXCCEntity {
+	e.ValidFrom = from
+	return e
+}
+
+// z is shorthand for a list of zones in the entity table.
+func z(zones ...int) []int {
+	return zones
+}
+
+// day returns midnight UTC on the given date.
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
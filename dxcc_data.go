@@ -0,0 +1,453 @@
+package utils
+
+import "time"
+
+// dxccEntities is the ADIF DXCC entity list, with the primary prefix, continent and CQ/ITU zones
+// of each entity. Deleted entities keep the zones and prefix in use when they were deleted.
+var dxccEntities = []DXCCEntity{
+	current(1, "Canada", "VE", "NA", z(1, 2, 3, 4, 5), z(2, 3, 4, 9, 75)),
+	deleted(2, "Abu Ail Islands", "", "AS", z(21), z(39), day(1991, time.March, 30)),
+	current(3, "Afghanistan", "YA", "AS", z(21), z(40)),
+	current(4, "Agalega & St. Brandon Islands", "3B6", "AF", z(39), z(53)),
+	current(5, "Aland Islands", "OH0", "EU", z(15), z(18)),
+	current(6, "Alaska", "KL", "NA", z(1), z(1, 2)),
+	current(7, "Albania", "ZA", "EU", z(15), z(28)),
+	deleted(8, "Aldabra", "VQ9/A", "AF", z(39), z(53), day(1976, time.June, 28)),
+	current(9, "American Samoa", "KH8", "OC", z(32), z(62)),
+	current(10, "Amsterdam & St. Paul Islands", "FT5Z", "AF", z(39), z(68)),
+	current(11, "Andaman & Nicobar Islands", "VU4", "AS", z(26), z(49)),
+	current(12, "Anguilla", "VP2E", "NA", z(8), z(11)),
+	current(13, "Antarctica", "CE9", "AN", z(12, 13, 29, 30, 32, 38, 39), z(67, 69, 70, 71, 72, 73, 74)),
+	current(14, "Armenia", "EK", "AS", z(21), z(29)),
+	current(15, "Asiatic Russia", "UA9", "AS", z(16, 17, 18, 19, 23), z(20, 21, 22, 23, 24, 25, 26, 30, 31, 32, 33, 34, 35, 75)),
+	current(16, "New Zealand Subantarctic Islands", "ZL9", "OC", z(32), z(60)),
+	current(17, "Aves Island", "YV0", "NA", z(8), z(11)),
+	current(18, "Azerbaijan", "4J", "AS", z(21), z(29)),
+	deleted(19, "Bajo Nuevo", "HK0/B", "NA", z(8), z(11), time.Time{}),
+	current(20, "Baker & Howland Islands", "KH1", "OC", z(31), z(61)),
+	current(21, "Balearic Islands", "EA6", "EU", z(14), z(37)),
+	current(22, "Palau", "T8", "OC", z(27), z(64)),
+	deleted(23, "Blenheim Reef", "3B8/B", "AF", z(39), z(41), time.Time{}),
+	current(24, "Bouvet", "3Y/B", "AF", z(38), z(67)),
+	deleted(25, "British North Borneo", "ZC5", "OC", z(28), z(54), day(1963, time.September, 15)),
+	deleted(26, "British Somaliland", "VQ6", "AF", z(37), z(48), day(1960, time.June, 30)),
+	current(27, "Belarus", "EU", "EU", z(16), z(29)),
+	deleted(28, "Canal Zone", "KZ5", "NA", z(7), z(11), day(1979, time.September, 30)),
+	current(29, "Canary Islands", "EA8", "AF", z(33), z(36)),
+	deleted(30, "Celebe & Molucca Islands", "PK6", "OC", z(28), z(54), day(1963, time.April, 30)),
+	current(31, "Central Kiribati", "T31", "OC", z(31), z(62)),
+	current(32, "Ceuta & Melilla", "EA9", "AF", z(33), z(37)),
+	current(33, "Chagos Islands", "VQ9", "AF", z(39), z(41)),
+	current(34, "Chatham Islands", "ZL7", "OC", z(32), z(60)),
+	current(35, "Christmas Island", "VK9X", "OC", z(29), z(54)),
+	current(36, "Clipperton Island", "FO/C", "NA", z(7), z(10)),
+	current(37, "Cocos Island", "TI9", "NA", z(7), z(11)),
+	current(38, "Cocos (Keeling) Islands", "VK9C", "OC", z(29), z(54)),
+	deleted(39, "Comoros", "FH8", "AF", z(39), z(53), day(1975, time.July, 5)),
+	current(40, "Crete", "SV9", "EU", z(20), z(28)),
+	current(41, "Crozet Island", "FT5W", "AF", z(39), z(68)),
+	deleted(42, "Damao, Diu", "CR8", "AS", z(22), z(41), day(1961, time.December, 31)),
+	current(43, "Desecheo Island", "KP5", "NA", z(8), z(11)),
+	deleted(44, "Desroches", "VQ9/D", "AF", z(39), z(53), day(1976, time.June, 28)),
+	current(45, "Dodecanese", "SV5", "EU", z(20), z(28)),
+	current(46, "East Malaysia", "9M6", "OC", z(28), z(54)),
+	current(47, "Easter Island", "CE0Y", "SA", z(12), z(63)),
+	current(48, "Eastern Kiribati", "T32", "OC", z(31), z(61, 63)),
+	current(49, "Equatorial Guinea", "3C", "AF", z(36), z(47)),
+	current(50, "Mexico", "XE", "NA", z(6), z(10)),
+	current(51, "Eritrea", "E3", "AF", z(37), z(48)).since(day(1991, time.November, 14)),
+	current(52, "Estonia", "ES", "EU", z(15), z(29)),
+	current(53, "Ethiopia", "ET", "AF", z(37), z(48)),
+	current(54, "European Russia", "UA", "EU", z(16), z(19, 20, 29, 30)),
+	deleted(55, "Farquhar", "VQ9/F", "AF", z(39), z(53), day(1976, time.June, 28)),
+	current(56, "Fernando de Noronha", "PY0F", "SA", z(11), z(13)),
+	deleted(57, "French Equatorial Africa", "FQ8", "AF", z(36), z(47), day(1960, time.August, 16)),
+	deleted(58, "French Indo-China", "FI8", "AS", z(26), z(49), day(1950, time.December, 20)),
+	deleted(59, "French West Africa", "FF8", "AF", z(35), z(46), day(1960, time.June, 19)),
+	current(60, "Bahamas", "C6", "NA", z(8), z(11)),
+	current(61, "Franz Josef Land", "R1FJ", "EU", z(40), z(75)),
+	current(62, "Barbados", "8P", "NA", z(8), z(11)),
+	current(63, "French Guiana", "FY", "SA", z(9), z(12)),
+	current(64, "Bermuda", "VP9", "NA", z(5), z(11)),
+	current(65, "British Virgin Islands", "VP2V", "NA", z(8), z(11)),
+	current(66, "Belize", "V3", "NA", z(7), z(11)),
+	deleted(67, "French India", "FN8", "AS", z(22), z(41), day(1954, time.October, 31)),
+	deleted(68, "Kuwait/Saudi Arabia Neutral Zone", "8Z4", "AS", z(21), z(39), day(1969, time.December, 17)),
+	current(69, "Cayman Islands", "ZF", "NA", z(8), z(11)),
+	current(70, "Cuba", "CM", "NA", z(8), z(11)),
+	current(71, "Galapagos Islands", "HC8", "SA", z(10), z(12)),
+	current(72, "Dominican Republic", "HI", "NA", z(8), z(11)),
+	current(74, "El Salvador", "YS", "NA", z(7), z(11)),
+	current(75, "Georgia", "4L", "AS", z(21), z(29)),
+	current(76, "Guatemala", "TG", "NA", z(7), z(11)),
+	current(77, "Grenada", "J3", "NA", z(8), z(11)),
+	current(78, "Haiti", "HH", "NA", z(8), z(11)),
+	current(79, "Guadeloupe", "FG", "NA", z(8), z(11)),
+	current(80, "Honduras", "HR", "NA", z(7), z(11)),
+	deleted(81, "Germany", "DL", "EU", z(14), z(28), day(1973, time.September, 16)),
+	current(82, "Jamaica", "6Y", "NA", z(8), z(11)),
+	current(84, "Martinique", "FM", "NA", z(8), z(11)),
+	deleted(85, "Bonaire, Curacao", "PJ2", "SA", z(9), z(11), day(2010, time.October, 9)),
+	current(86, "Nicaragua", "YN", "NA", z(7), z(11)),
+	current(88, "Panama", "HP", "NA", z(7), z(11)),
+	current(89, "Turks & Caicos Islands", "VP5", "NA", z(8), z(11)),
+	current(90, "Trinidad & Tobago", "9Y", "SA", z(9), z(11)),
+	current(91, "Aruba", "P4", "SA", z(9), z(11)),
+	deleted(93, "Geyser Reef", "FR7/G", "AF", z(39), z(53), time.Time{}),
+	current(94, "Antigua & Barbuda", "V2", "NA", z(8), z(11)),
+	current(95, "Dominica", "J7", "NA", z(8), z(11)),
+	current(96, "Montserrat", "VP2M", "NA", z(8), z(11)),
+	current(97, "St. Lucia", "J6", "NA", z(8), z(11)),
+	current(98, "St. Vincent", "J8", "NA", z(8), z(11)),
+	current(99, "Glorioso Islands", "FT/G", "AF", z(39), z(53)),
+	current(100, "Argentina", "LU", "SA", z(13), z(14, 16)),
+	deleted(101, "Goa", "CR8", "AS", z(22), z(41), day(1961, time.December, 31)),
+	deleted(102, "Gold Coast, Togoland", "ZD4", "AF", z(35), z(46), day(1957, time.March, 5)),
+	current(103, "Guam", "KH2", "OC", z(27), z(64)),
+	current(104, "Bolivia", "CP", "SA", z(10), z(12, 14)),
+	current(105, "Guantanamo Bay", "KG4", "NA", z(8), z(11)),
+	current(106, "Guernsey", "GU", "EU", z(14), z(27)),
+	current(107, "Guinea", "3X", "AF", z(35), z(46)),
+	current(108, "Brazil", "PY", "SA", z(11), z(12, 13, 15)),
+	current(109, "Guinea-Bissau", "J5", "AF", z(35), z(46)),
+	current(110, "Hawaii", "KH6", "OC", z(31), z(61)),
+	current(111, "Heard Island", "VK0H", "AF", z(39), z(68)),
+	current(112, "Chile", "CE", "SA", z(12), z(14, 16)),
+	deleted(113, "Ifni", "EA9", "AF", z(33), z(37), day(1969, time.May, 13)),
+	current(114, "Isle of Man", "GD", "EU", z(14), z(27)),
+	deleted(115, "Italian Somaliland", "I5", "AF", z(37), z(48), day(1960, time.June, 30)),
+	current(116, "Colombia", "HK", "SA", z(9), z(12)),
+	current(117, "ITU HQ", "4U1I", "EU", z(14), z(28)),
+	current(118, "Jan Mayen", "JX", "EU", z(40), z(18)),
+	deleted(119, "Java", "PK1", "OC", z(28), z(54), day(1963, time.April, 30)),
+	current(120, "Ecuador", "HC", "SA", z(10), z(12)),
+	current(122, "Jersey", "GJ", "EU", z(14), z(27)),
+	current(123, "Johnston Island", "KH3", "OC", z(31), z(61)),
+	current(124, "Juan de Nova, Europa", "FT/J", "AF", z(39), z(53)),
+	current(125, "Juan Fernandez Islands", "CE0Z", "SA", z(12), z(14)),
+	current(126, "Kaliningrad", "UA2", "EU", z(15), z(29)),
+	deleted(127, "Kamaran Islands", "VS9K", "AS", z(21), z(39), day(1982, time.March, 10)),
+	deleted(128, "Karelo-Finnish Republic", "UN1", "EU", z(16), z(19), day(1960, time.June, 30)),
+	current(129, "Guyana", "8R", "SA", z(9), z(12)),
+	current(130, "Kazakhstan", "UN", "AS", z(17), z(29, 30, 31)),
+	current(131, "Kerguelen Islands", "FT5X", "AF", z(39), z(68)),
+	current(132, "Paraguay", "ZP", "SA", z(11), z(14)),
+	current(133, "Kermadec Islands", "ZL8", "OC", z(32), z(60)),
+	deleted(134, "Kingman Reef", "KH5K", "OC", z(31), z(61), time.Time{}),
+	current(135, "Kyrgyzstan", "EX", "AS", z(17), z(30, 31)),
+	current(136, "Peru", "OA", "SA", z(10), z(12)),
+	current(137, "Republic of Korea", "HL", "AS", z(25), z(44)),
+	current(138, "Kure Island", "KH7K", "OC", z(31), z(61)),
+	deleted(139, "Kuria Muria Island", "VS9H", "AS", z(21), z(39), day(1967, time.November, 29)),
+	current(140, "Suriname", "PZ", "SA", z(9), z(12)),
+	current(141, "Falkland Islands", "VP8", "SA", z(13), z(16)),
+	current(142, "Lakshadweep Islands", "VU7", "AS", z(22), z(41)),
+	current(143, "Laos", "XW", "AS", z(26), z(49)),
+	current(144, "Uruguay", "CX", "SA", z(13), z(14)),
+	current(145, "Latvia", "YL", "EU", z(15), z(29)),
+	current(146, "Lithuania", "LY", "EU", z(15), z(29)),
+	current(147, "Lord Howe Island", "VK9L", "OC", z(30), z(60)),
+	current(148, "Venezuela", "YV", "SA", z(9), z(12)),
+	current(149, "Azores", "CU", "EU", z(14), z(36)),
+	current(150, "Australia", "VK", "OC", z(29, 30), z(55, 58, 59)),
+	deleted(151, "Malyj Vysotskij Island", "4J1", "EU", z(16), z(29), time.Time{}),
+	current(152, "Macao", "XX9", "AS", z(24), z(44)),
+	current(153, "Macquarie Island", "VK0M", "OC", z(30), z(60)),
+	deleted(154, "Yemen Arab Republic", "4W", "AS", z(21), z(39), day(1990, time.May, 21)),
+	deleted(155, "Malaya", "VS2", "AS", z(28), z(54), day(1963, time.September, 15)),
+	current(157, "Nauru", "C2", "OC", z(31), z(65)),
+	current(158, "Vanuatu", "YJ", "OC", z(32), z(56)),
+	current(159, "Maldives", "8Q", "AS", z(22), z(41)),
+	current(160, "Tonga", "A3", "OC", z(32), z(62)),
+	current(161, "Malpelo Island", "HK0/M", "SA", z(9), z(12)),
+	current(162, "New Caledonia", "FK", "OC", z(32), z(56)),
+	current(163, "Papua New Guinea", "P2", "OC", z(28), z(51)),
+	deleted(164, "Manchuria", "C9", "AS", z(24), z(33), time.Time{}),
+	current(165, "Mauritius", "3B8", "AF", z(39), z(53)),
+	current(166, "Mariana Islands", "KH0", "OC", z(27), z(64)),
+	current(167, "Market Reef", "OJ0", "EU", z(15), z(18)),
+	current(168, "Marshall Islands", "V7", "OC", z(31), z(65)),
+	current(169, "Mayotte", "FH", "AF", z(39), z(53)),
+	current(170, "New Zealand", "ZL", "OC", z(32), z(60)),
+	current(171, "Mellish Reef", "VK9M", "OC", z(30), z(56)),
+	current(172, "Pitcairn Island", "VP6", "OC", z(32), z(63)),
+	current(173, "Micronesia", "V6", "OC", z(27), z(65)),
+	current(174, "Midway Island", "KH4", "OC", z(31), z(61)),
+	current(175, "French Polynesia", "FO", "OC", z(32), z(63)),
+	current(176, "Fiji", "3D2", "OC", z(32), z(56)),
+	current(177, "Minami Torishima", "JD1/M", "OC", z(27), z(90)),
+	deleted(178, "Minerva Reef", "1M", "OC", z(32), z(62), time.Time{}),
+	current(179, "Moldova", "ER", "EU", z(16), z(29)),
+	current(180, "Mount Athos", "SV/A", "EU", z(20), z(28)),
+	current(181, "Mozambique", "C9", "AF", z(37), z(53)),
+	current(182, "Navassa Island", "KP1", "NA", z(8), z(11)),
+	deleted(183, "Netherlands Borneo", "PK5", "OC", z(28), z(54), time.Time{}),
+	deleted(184, "Netherlands New Guinea", "JZ0", "OC", z(28), z(51), time.Time{}),
+	current(185, "Solomon Islands", "H4", "OC", z(28), z(51)),
+	deleted(186, "Newfoundland, Labrador", "VO", "NA", z(2, 5), z(9), day(1949, time.March, 31)),
+	current(187, "Niger", "5U", "AF", z(35), z(46)),
+	current(188, "Niue", "E6", "OC", z(32), z(62)),
+	current(189, "Norfolk Island", "VK9N", "OC", z(32), z(60)),
+	current(190, "Samoa", "5W", "OC", z(32), z(62)),
+	current(191, "North Cook Islands", "E5/N", "OC", z(32), z(62)),
+	current(192, "Ogasawara", "JD1/O", "AS", z(27), z(45)),
+	deleted(193, "Okinawa (Ryukyu Islands)", "KR6", "AS", z(25), z(45), day(1972, time.May, 14)),
+	deleted(194, "Okino Tori-shima", "7J1", "AS", z(27), z(45), time.Time{}),
+	current(195, "Annobon Island", "3C0", "AF", z(36), z(52)),
+	deleted(196, "Palestine", "ZC6", "AS", z(20), z(39), day(1968, time.June, 30)),
+	current(197, "Palmyra & Jarvis Islands", "KH5", "OC", z(31), z(61, 62)),
+	deleted(198, "Papua Territory", "VK9", "OC", z(28), z(51), day(1975, time.September, 15)),
+	current(199, "Peter 1 Island", "3Y/P", "AN", z(12), z(72)),
+	deleted(200, "Portuguese Timor", "CR10", "OC", z(28), z(54), time.Time{}),
+	current(201, "Prince Edward & Marion Islands", "ZS8", "AF", z(38), z(57)),
+	current(202, "Puerto Rico", "KP4", "NA", z(8), z(11)),
+	current(203, "Andorra", "C3", "EU", z(14), z(27)),
+	current(204, "Revillagigedo", "XF4", "NA", z(6), z(10)),
+	current(205, "Ascension Island", "ZD8", "AF", z(36), z(66)),
+	current(206, "Austria", "OE", "EU", z(15), z(28)),
+	current(207, "Rodriguez Island", "3B9", "AF", z(39), z(53)),
+	deleted(208, "Ruanda-Urundi", "9U5", "AF", z(36), z(52), day(1962, time.June, 30)),
+	current(209, "Belgium", "ON", "EU", z(14), z(27)),
+	deleted(210, "Saar", "9S4", "EU", z(14), z(28), day(1957, time.March, 31)),
+	current(211, "Sable Island", "CY0", "NA", z(5), z(9)),
+	current(212, "Bulgaria", "LZ", "EU", z(20), z(28)),
+	current(213, "Saint Martin", "FS", "NA", z(8), z(11)),
+	current(214, "Corsica", "TK", "EU", z(15), z(28)),
+	current(215, "Cyprus", "5B", "AS", z(20), z(39)),
+	current(216, "San Andres & Providencia", "HK0/A", "NA", z(7), z(11)),
+	current(217, "San Felix & San Ambrosio", "CE0X", "SA", z(12), z(14)),
+	deleted(218, "Czechoslovakia", "OK", "EU", z(15), z(28), day(1992, time.December, 31)),
+	current(219, "Sao Tome & Principe", "S9", "AF", z(36), z(47)),
+	deleted(220, "Sarawak", "VS4", "OC", z(28), z(54), day(1963, time.September, 15)),
+	current(221, "Denmark", "OZ", "EU", z(14), z(18)),
+	current(222, "Faroe Islands", "OY", "EU", z(14), z(18)),
+	current(223, "England", "G", "EU", z(14), z(27)),
+	current(224, "Finland", "OH", "EU", z(15), z(18)),
+	current(225, "Sardinia", "IS0", "EU", z(15), z(28)),
+	deleted(226, "Saudi Arabia/Iraq Neutral Zone", "8Z5", "AS", z(21), z(39), day(1981, time.December, 25)),
+	current(227, "France", "F", "EU", z(14), z(27)),
+	deleted(228, "Serrana Bank & Roncador Cay", "HK0/S", "NA", z(7), z(11), time.Time{}),
+	deleted(229, "German Democratic Republic", "Y2", "EU", z(14), z(28), day(1990, time.October, 2)),
+	current(230, "Federal Republic of Germany", "DL", "EU", z(14), z(28)).since(day(1973, time.September, 17)),
+	deleted(231, "Sikkim", "AC3", "AS", z(22), z(41), day(1975, time.April, 30)),
+	current(232, "Somalia", "T5", "AF", z(37), z(48)),
+	current(233, "Gibraltar", "ZB", "EU", z(14), z(37)),
+	current(234, "South Cook Islands", "E5/S", "OC", z(32), z(62)),
+	current(235, "South Georgia Island", "VP8/G", "SA", z(13), z(73)),
+	current(236, "Greece", "SV", "EU", z(20), z(28)),
+	current(237, "Greenland", "OX", "NA", z(40), z(5, 75)),
+	current(238, "South Orkney Islands", "VP8/O", "SA", z(13), z(73)),
+	current(239, "Hungary", "HA", "EU", z(15), z(28)),
+	current(240, "South Sandwich Islands", "VP8/S", "SA", z(13), z(73)),
+	current(241, "South Shetland Islands", "VP8/H", "SA", z(13), z(73)),
+	current(242, "Iceland", "TF", "EU", z(40), z(17)),
+	deleted(243, "People's Democratic Republic of Yemen", "7O", "AS", z(21), z(39), day(1990, time.May, 21)),
+	deleted(244, "Southern Sudan", "ST0", "AF", z(34), z(48), time.Time{}),
+	current(245, "Ireland", "EI", "EU", z(14), z(27)),
+	current(246, "Sovereign Military Order of Malta", "1A", "EU", z(15), z(28)),
+	current(247, "Spratly Islands", "1S", "AS", z(26), z(50)),
+	current(248, "Italy", "I", "EU", z(15, 33), z(28, 37)),
+	current(249, "St. Kitts & Nevis", "V4", "NA", z(8), z(11)),
+	current(250, "St. Helena", "ZD7", "AF", z(36), z(66)),
+	current(251, "Liechtenstein", "HB0", "EU", z(14), z(28)),
+	current(252, "St. Paul Island", "CY9", "NA", z(5), z(9)),
+	current(253, "St. Peter & St. Paul Rocks", "PY0S", "SA", z(11), z(13)),
+	current(254, "Luxembourg", "LX", "EU", z(14), z(27)),
+	deleted(255, "St. Maarten, Saba, St. Eustatius", "PJ7", "NA", z(8), z(11), day(2010, time.October, 9)),
+	current(256, "Madeira Islands", "CT3", "AF", z(33), z(36)),
+	current(257, "Malta", "9H", "EU", z(15), z(28)),
+	deleted(258, "Sumatra", "PK4", "OC", z(28), z(54), day(1963, time.April, 30)),
+	current(259, "Svalbard", "JW", "EU", z(40), z(18)),
+	current(260, "Monaco", "3A", "EU", z(14), z(27)),
+	deleted(261, "Swan Islands", "KS4", "NA", z(7), z(11), day(1972, time.August, 31)),
+	current(262, "Tajikistan", "EY", "AS", z(17), z(30)),
+	current(263, "Netherlands", "PA", "EU", z(14), z(27)),
+	deleted(264, "Tangier", "CN2", "AF", z(33), z(37), day(1960, time.June, 30)),
+	current(265, "Northern Ireland", "GI", "EU", z(14), z(27)),
+	current(266, "Norway", "LA", "EU", z(14), z(18)),
+	deleted(267, "Territory of New Guinea", "VK9", "OC", z(28), z(51), day(1975, time.September, 15)),
+	deleted(268, "Tibet", "AC4", "AS", z(23), z(41), day(1974, time.May, 30)),
+	current(269, "Poland", "SP", "EU", z(15), z(28)),
+	current(270, "Tokelau Islands", "ZK3", "OC", z(31), z(62)),
+	deleted(271, "Trieste", "MF2", "EU", z(15), z(28), day(1957, time.March, 31)),
+	current(272, "Portugal", "CT", "EU", z(14), z(37)),
+	current(273, "Trindade & Martim Vaz Islands", "PY0T", "SA", z(11), z(15)),
+	current(274, "Tristan da Cunha & Gough Islands", "ZD9", "AF", z(38), z(66)),
+	current(275, "Romania", "YO", "EU", z(20), z(28)),
+	current(276, "Tromelin Island", "FT/T", "AF", z(39), z(53)),
+	current(277, "St. Pierre & Miquelon", "FP", "NA", z(5), z(9)),
+	current(278, "San Marino", "T7", "EU", z(15), z(28)),
+	current(279, "Scotland", "GM", "EU", z(14), z(27)),
+	current(280, "Turkmenistan", "EZ", "AS", z(17), z(30)),
+	current(281, "Spain", "EA", "EU", z(14), z(37)),
+	current(282, "Tuvalu", "T2", "OC", z(31), z(65)),
+	current(283, "UK Sovereign Base Areas on Cyprus", "ZC4", "AS", z(20), z(39)),
+	current(284, "Sweden", "SM", "EU", z(14), z(18)),
+	current(285, "US Virgin Islands", "KP2", "NA", z(8), z(11)),
+	current(286, "Uganda", "5X", "AF", z(37), z(48)),
+	current(287, "Switzerland", "HB", "EU", z(14), z(28)),
+	current(288, "Ukraine", "UR", "EU", z(16), z(29)),
+	current(289, "United Nations HQ", "4U1U", "NA", z(5), z(8)),
+	current(291, "United States of America", "K", "NA", z(3, 4, 5), z(6, 7, 8)),
+	current(292, "Uzbekistan", "UK", "AS", z(17), z(30)),
+	current(293, "Viet Nam", "3W", "AS", z(26), z(49)),
+	current(294, "Wales", "GW", "EU", z(14), z(27)),
+	current(295, "Vatican", "HV", "EU", z(15), z(28)),
+	current(296, "Serbia", "YU", "EU", z(15), z(28)),
+	current(297, "Wake Island", "KH9", "OC", z(31), z(65)),
+	current(298, "Wallis & Futuna Islands", "FW", "OC", z(32), z(62)),
+	current(299, "West Malaysia", "9M2", "AS", z(28), z(54)),
+	current(301, "Western Kiribati", "T30", "OC", z(31), z(65)),
+	current(302, "Western Sahara", "S0", "AF", z(33), z(46)),
+	current(303, "Willis Island", "VK9W", "OC", z(30), z(55)),
+	current(304, "Bahrain", "A9", "AS", z(21), z(39)),
+	current(305, "Bangladesh", "S2", "AS", z(22), z(41)),
+	current(306, "Bhutan", "A5", "AS", z(22), z(41)),
+	deleted(307, "Zanzibar", "VQ1", "AF", z(37), z(53), day(1974, time.May, 31)),
+	current(308, "Costa Rica", "TI", "NA", z(7), z(11)),
+	current(309, "Myanmar", "XZ", "AS", z(26), z(49)),
+	current(312, "Cambodia", "XU", "AS", z(26), z(49)),
+	current(315, "Sri Lanka", "4S", "AS", z(22), z(41)),
+	current(318, "China", "BY", "AS", z(23, 24), z(33, 42, 43, 44)),
+	current(321, "Hong Kong", "VR", "AS", z(24), z(44)),
+	current(324, "India", "VU", "AS", z(22), z(41)),
+	current(327, "Indonesia", "YB", "OC", z(28), z(51, 54)),
+	current(330, "Iran", "EP", "AS", z(21), z(40)),
+	current(333, "Iraq", "YI", "AS", z(21), z(39)),
+	current(336, "Israel", "4X", "AS", z(20), z(39)),
+	current(339, "Japan", "JA", "AS", z(25), z(45)),
+	current(342, "Jordan", "JY", "AS", z(20), z(39)),
+	current(344, "Democratic People's Republic of Korea", "P5", "AS", z(25), z(44)),
+	current(345, "Brunei Darussalam", "V8", "OC", z(28), z(54)),
+	current(348, "Kuwait", "9K", "AS", z(21), z(39)),
+	current(354, "Lebanon", "OD", "AS", z(20), z(39)),
+	current(363, "Mongolia", "JT", "AS", z(23), z(32, 33)),
+	current(369, "Nepal", "9N", "AS", z(22), z(42)),
+	current(370, "Oman", "A4", "AS", z(21), z(39)),
+	current(372, "Pakistan", "AP", "AS", z(21), z(41)),
+	current(375, "Philippines", "DU", "OC", z(27), z(50)),
+	current(376, "Qatar", "A7", "AS", z(21), z(39)),
+	current(378, "Saudi Arabia", "HZ", "AS", z(21), z(39)),
+	current(379, "Seychelles", "S7", "AF", z(39), z(53)),
+	current(381, "Singapore", "9V", "AS", z(28), z(54)),
+	current(382, "Djibouti", "J2", "AF", z(37), z(48)),
+	current(384, "Syria", "YK", "AS", z(20), z(39)),
+	current(386, "Taiwan", "BV", "AS", z(24), z(44)),
+	current(387, "Thailand", "HS", "AS", z(26), z(49)),
+	current(390, "Turkey", "TA", "AS", z(20), z(39)),
+	current(391, "United Arab Emirates", "A6", "AS", z(21), z(39)),
+	current(400, "Algeria", "7X", "AF", z(33), z(37)),
+	current(401, "Angola", "D2", "AF", z(36), z(52)),
+	current(402, "Botswana", "A2", "AF", z(38), z(57)),
+	current(404, "Burundi", "9U", "AF", z(36), z(52)),
+	current(406, "Cameroon", "TJ", "AF", z(36), z(47)),
+	current(408, "Central African Republic", "TL", "AF", z(36), z(47)),
+	current(409, "Cape Verde", "D4", "AF", z(35), z(46)),
+	current(410, "Chad", "TT", "AF", z(36), z(47)),
+	current(411, "Comoros", "D6", "AF", z(39), z(53)).since(day(1975, time.July, 6)),
+	current(412, "Republic of the Congo", "TN", "AF", z(36), z(52)),
+	current(414, "Democratic Republic of the Congo", "9Q", "AF", z(36), z(52)),
+	current(416, "Benin", "TY", "AF", z(35), z(46)),
+	current(420, "Gabon", "TR", "AF", z(36), z(52)),
+	current(422, "The Gambia", "C5", "AF", z(35), z(46)),
+	current(424, "Ghana", "9G", "AF", z(35), z(46)),
+	current(428, "Cote d'Ivoire", "TU", "AF", z(35), z(46)),
+	current(430, "Kenya", "5Z", "AF", z(37), z(48)),
+	current(432, "Lesotho", "7P", "AF", z(38), z(57)),
+	current(434, "Liberia", "EL", "AF", z(35), z(46)),
+	current(436, "Libya", "5A", "AF", z(34), z(38)),
+	current(438, "Madagascar", "5R", "AF", z(39), z(53)),
+	current(440, "Malawi", "7Q", "AF", z(37), z(53)),
+	current(442, "Mali", "TZ", "AF", z(35), z(46)),
+	current(444, "Mauritania", "5T", "AF", z(35), z(46)),
+	current(446, "Morocco", "CN", "AF", z(33), z(37)),
+	current(450, "Nigeria", "5N", "AF", z(35), z(46)),
+	current(452, "Zimbabwe", "Z2", "AF", z(38), z(53)),
+	current(453, "Reunion Island", "FR", "AF", z(39), z(53)),
+	current(454, "Rwanda", "9X", "AF", z(36), z(52)),
+	current(456, "Senegal", "6W", "AF", z(35), z(46)),
+	current(458, "Sierra Leone", "9L", "AF", z(35), z(46)),
+	current(460, "Rotuma Island", "3D2/R", "OC", z(32), z(56)),
+	current(462, "Republic of South Africa", "ZS", "AF", z(38), z(57)),
+	current(464, "Namibia", "V5", "AF", z(38), z(57)).since(day(1990, time.March, 21)),
+	current(466, "Sudan", "ST", "AF", z(34), z(47, 48)),
+	current(468, "Kingdom of Eswatini", "3DA", "AF", z(38), z(57)),
+	current(470, "Tanzania", "5H", "AF", z(37), z(53)),
+	current(474, "Tunisia", "3V", "AF", z(33), z(37)),
+	current(478, "Egypt", "SU", "AF", z(34), z(38)),
+	current(480, "Burkina Faso", "XT", "AF", z(35), z(46)),
+	current(482, "Zambia", "9J", "AF", z(36), z(53)),
+	current(483, "Togo", "5V", "AF", z(35), z(46)),
+	deleted(488, "Walvis Bay", "ZS9", "AF", z(38), z(57), day(1994, time.February, 28)),
+	current(489, "Conway Reef", "3D2/C", "OC", z(32), z(56)),
+	current(490, "Banaba Island", "T33", "OC", z(31), z(65)),
+	current(492, "Yemen", "7O", "AS", z(21), z(39)).since(day(1990, time.May, 22)),
+	deleted(493, "Penguin Islands", "ZS0", "AF", z(38), z(57), day(1994, time.February, 28)),
+	current(497, "Croatia", "9A", "EU", z(15), z(28)).since(day(1991, time.June, 26)),
+	current(499, "Slovenia", "S5", "EU", z(15), z(28)).since(day(1991, time.June, 26)),
+	current(501, "Bosnia-Herzegovina", "E7", "EU", z(15), z(28)).since(day(1991, time.October, 15)),
+	current(502, "North Macedonia", "Z3", "EU", z(15), z(28)).since(day(1991, time.September, 8)),
+	current(503, "Czech Republic", "OK", "EU", z(15), z(28)).since(day(1993, time.January, 1)),
+	current(504, "Slovak Republic", "OM", "EU", z(15), z(28)).since(day(1993, time.January, 1)),
+	current(505, "Pratas Island", "BV9P", "AS", z(24), z(44)),
+	current(506, "Scarborough Reef", "BS7H", "AS", z(27), z(50)),
+	current(507, "Temotu Province", "H40", "OC", z(32), z(51)),
+	current(508, "Austral Islands", "FO/A", "OC", z(32), z(63)),
+	current(509, "Marquesas Islands", "FO/M", "OC", z(31), z(63)),
+	current(510, "Palestine", "E4", "AS", z(20), z(39)),
+	current(511, "Timor-Leste", "4W", "OC", z(28), z(54)),
+	current(512, "Chesterfield Islands", "FK/C", "OC", z(30), z(56)),
+	current(513, "Ducie Island", "VP6/D", "OC", z(32), z(63)),
+	current(514, "Montenegro", "4O", "EU", z(15), z(28)).since(day(2006, time.June, 28)),
+	current(515, "Swains Island", "KH8/S", "OC", z(32), z(62)),
+	current(516, "Saint Barthelemy", "FJ", "NA", z(8), z(11)).since(day(2007, time.December, 14)),
+	current(517, "Curacao", "PJ2", "SA", z(9), z(11)).since(day(2010, time.October, 10)),
+	current(518, "Sint Maarten", "PJ7", "NA", z(8), z(11)).since(day(2010, time.October, 10)),
+	current(519, "Saba & St. Eustatius", "PJ5", "NA", z(8), z(11)).since(day(2010, time.October, 10)),
+	current(520, "Bonaire", "PJ4", "SA", z(9), z(11)).since(day(2010, time.October, 10)),
+	current(521, "Republic of South Sudan", "Z8", "AF", z(34), z(48)).since(day(2011, time.July, 14)),
+	current(522, "Republic of Kosovo", "Z6", "EU", z(15), z(28)).since(day(2018, time.January, 21)),
+}
+
+// dxccNameAliases maps common alternative names to entity codes for LookupDXCCByName.
+var dxccNameAliases = map[string]int{
+	"USA":                         291,
+	"United States":               291,
+	"Germany":                     230,
+	"West Germany":                230,
+	"East Germany":                229,
+	"South Korea":                 137,
+	"Korea":                       137,
+	"North Korea":                 344,
+	"Vietnam":                     293,
+	"Swaziland":                   468,
+	"Eswatini":                    468,
+	"Macedonia":                   502,
+	"Czechia":                     503,
+	"Slovakia":                    504,
+	"Burma":                       309,
+	"Brunei":                      345,
+	"South Africa":                462,
+	"South Sudan":                 521,
+	"Kosovo":                      522,
+	"Congo":                       412,
+	"DR Congo":                    414,
+	"Zaire":                       414,
+	"Central Africa":              408,
+	"Gambia":                      422,
+	"Ivory Coast":                 428,
+	"Bosnia":                      501,
+	"Bosnia and Herzegovina":      501,
+	"UAE":                         391,
+	"Russia":                      54,
+	"East Timor":                  511,
+	"Reunion":                     453,
+	"Pitcairn":                    172,
+	"SMOM":                        246,
+	"UN HQ":                       289,
+	"Sovereign Base Areas":        283,
+	"Virgin Islands":              285,
+	"Cocos Keeling":               38,
+	"Auckland & Campbell Islands": 16,
+	"Kiribati":                    301,
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ISOMapping lists the DXCC entities that make up an ISO 3166-1 country or territory.
+// Entities are ordered with the country's main entity first (the one DXCCFromISO2 returns), then
+// the other entities with that ISO code by DXCC code, then dependencies that have their own ISO code
+// but are commonly grouped with the country (the Isle of Man, Guernsey and Jersey under GB).
+// Unambiguous is true when the country corresponds to exactly one entity.
+type ISOMapping struct {
+	ISO2        string
+	ISO3        string
+	Entities    []DXCCEntity
+	Unambiguous bool
+}
+
+// DXCCForISO returns every current DXCC entity for an ISO 3166-1 alpha-2 or alpha-3 code
+// (case-insensitive). It returns false if the code is unknown or has no DXCC entities.
+func DXCCForISO(code string) (ISOMapping, bool) {
+	iso2, ok := normalizeISO(code)
+	if !ok {
+		return ISOMapping{}, false
+	}
+	entities := isoEntities[iso2]
+	if len(entities) == 0 {
+		return ISOMapping{}, false
+	}
+	out := ISOMapping{ISO2: iso2, ISO3: iso2ToISO3[iso2], Entities: make([]DXCCEntity, 0, len(entities))}
+	for _, e := range entities {
+		out.Entities = append(out.Entities, *e)
+	}
+	out.Unambiguous = len(out.Entities) == 1
+	return out, true
+}
+
+// ISOForDXCC returns the ISO 3166-1 alpha-2 and alpha-3 codes of the country or territory an entity
+// belongs to. It returns false for deleted entities and for entities with no ISO code, such as the
+// UN headquarters, the ITU headquarters, the Sovereign Military Order of Malta or disputed reefs.
+func ISOForDXCC(code int) (iso2, iso3 string, ok bool) {
+	iso2, ok = dxccISO2[code]
+	if !ok {
+		return emptyString, emptyString, false
+	}
+	return iso2, iso2ToISO3[iso2], true
+}
+
+// ISOForDXCCString is ISOForDXCC for a code held as a string, as in ADIF records.
+func ISOForDXCCString(dxcc string) (iso2, iso3 string, ok bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(dxcc))
+	if err != nil {
+		return emptyString, emptyString, false
+	}
+	return ISOForDXCC(n)
+}
+
+// ISO2ToISO3 converts an ISO 3166-1 alpha-2 code to alpha-3 (case-insensitive).
+func ISO2ToISO3(iso2 string) (string, bool) {
+	iso3, ok := iso2ToISO3[strings.ToUpper(strings.TrimSpace(iso2))]
+	return iso3, ok
+}
+
+// ISO3ToISO2 converts an ISO 3166-1 alpha-3 code to alpha-2 (case-insensitive).
+func ISO3ToISO2(iso3 string) (string, bool) {
+	iso2, ok := iso3ToISO2[strings.ToUpper(strings.TrimSpace(iso3))]
+	return iso2, ok
+}
+
+var (
+	iso3ToISO2  map[string]string
+	isoEntities map[string][]*DXCCEntity
+)
+
+func init() {
+	iso3ToISO2 = make(map[string]string, len(iso2ToISO3))
+	for iso2, iso3 := range iso2ToISO3 {
+		iso3ToISO2[iso3] = iso2
+	}
+
+	isoEntities = make(map[string][]*DXCCEntity)
+	for code, iso2 := range dxccISO2 {
+		isoEntities[iso2] = append(isoEntities[iso2], dxccByCode[code])
+	}
+	for iso2, list := range isoEntities {
+		main := iso2ToDXCC[iso2]
+		sort.Slice(list, func(i, j int) bool {
+			if (list[i].Code == main) != (list[j].Code == main) {
+				return list[i].Code == main
+			}
+			return list[i].Code < list[j].Code
+		})
+	}
+	for iso2, codes := range isoDependencies {
+		for _, code := range codes {
+			isoEntities[iso2] = append(isoEntities[iso2], dxccByCode[code])
+		}
+	}
+}
+
+// normalizeISO returns the alpha-2 code for an alpha-2 or alpha-3 code.
+func normalizeISO(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	switch len(code) {
+	case 2:
+		_, ok := iso2ToISO3[code]
+		return code, ok
+	case 3:
+		iso2, ok := iso3ToISO2[code]
+		return iso2, ok
+	}
+	return emptyString, false
+}
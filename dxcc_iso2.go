@@ -1,17 +1,22 @@
 package utils
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // DXCCFromISO2 returns the ADIF DXCC entity code (as a string) for a given
 // two-character ISO 3166-1 alpha-2 country code (case-insensitive).
 //
 // Notes and caveats:
-//   - ADIF/ARRL DXCC entities do not always map 1:1 to ISO country codes
-//     (e.g., the United Kingdom has multiple DXCC entities). Consequently,
-//     this function only provides direct, unambiguous mappings for commonly
-//     used ISO codes. If a code is not present in the table, the function
-//     returns "" and false.
-//   - The mapping can be extended over time as additional needs arise.
+//   - ADIF/ARRL DXCC entities do not always map 1:1 to ISO country codes.
+//     Where a country contains several entities, the code of its main
+//     entity is returned (e.g. ES gives Spain rather than the Balearic or
+//     Canary Islands). Codes with no main entity, such as GB (England,
+//     Scotland, Wales, Northern Ireland), KI, UM or TF, return "" and false.
+//     Use DXCCForISO to list every entity for a country.
+//   - Every mapping refers to a current entity in the DXCC entity table
+//     (see LookupDXCC).
 //
 // Returned values:
 // - dxcc: ADIF DXCC entity code as a string.
@@ -21,106 +26,274 @@ func DXCCFromISO2(cc string) (dxcc string, ok bool) {
 	if len(code) != 2 {
 		return "", false
 	}
+	n, ok := iso2ToDXCC[code]
+	if !ok {
+		return "", false
+	}
+	if e, found := LookupDXCC(n); !found || e.Deleted {
+		return "", false
+	}
+	return strconv.Itoa(n), true
+}
 
-	// Minimal, safe subset of unambiguous mappings.
-	// Extend this map as needed.
-	var iso2ToDXCC = map[string]string{
-		// North America
-		"US": "291", // United States
-		"CA": "1",   // Canada
-		"MX": "50",  // Mexico
+// iso2ToDXCC maps ISO 3166-1 alpha-2 codes to the DXCC entity covering the country, or its main entity.
+var iso2ToDXCC = map[string]int{
+	// North America
+	"US": 291, // United States
+	"CA": 1,   // Canada
+	"MX": 50,  // Mexico
+	"BM": 64,  // Bermuda
+	"GL": 237, // Greenland
+	"PM": 277, // St. Pierre & Miquelon
+	"BS": 60,  // Bahamas
+	"CU": 70,  // Cuba
+	"JM": 82,  // Jamaica
+	"HT": 78,  // Haiti
+	"DO": 72,  // Dominican Republic
+	"PR": 202, // Puerto Rico
+	"VI": 285, // US Virgin Islands
+	"VG": 65,  // British Virgin Islands
+	"AI": 12,  // Anguilla
+	"KN": 249, // St. Kitts & Nevis
+	"AG": 94,  // Antigua & Barbuda
+	"MS": 96,  // Montserrat
+	"GP": 79,  // Guadeloupe
+	"DM": 95,  // Dominica
+	"MQ": 84,  // Martinique
+	"LC": 97,  // St. Lucia
+	"VC": 98,  // St. Vincent
+	"BB": 62,  // Barbados
+	"GD": 77,  // Grenada
+	"TC": 89,  // Turks & Caicos Islands
+	"KY": 69,  // Cayman Islands
+	"MF": 213, // Saint Martin
+	"BL": 516, // Saint Barthelemy
+	"SX": 518, // Sint Maarten
+	"BZ": 66,  // Belize
+	"GT": 76,  // Guatemala
+	"SV": 74,  // El Salvador
+	"HN": 80,  // Honduras
+	"NI": 86,  // Nicaragua
+	"CR": 308, // Costa Rica
+	"PA": 88,  // Panama
 
-		// Europe
-		"DE": "230", // Germany
-		"FR": "227", // France
-		"ES": "281", // Spain
-		"PT": "272", // Portugal
-		"IT": "248", // Italy
-		"IE": "245", // Ireland
-		"NL": "263", // Netherlands
-		"BE": "209", // Belgium
-		"LU": "254", // Luxembourg
-		"CH": "287", // Switzerland
-		"AT": "206", // Austria
-		"CZ": "503", // Czech Republic
-		"SK": "504", // Slovak Republic
-		"PL": "269", // Poland
-		"SE": "284", // Sweden
-		"NO": "266", // Norway
-		"FI": "224", // Finland
-		"DK": "221", // Denmark
-		"IS": "242", // Iceland
-		"HU": "239", // Hungary
-		"GR": "236", // Greece
-		"RO": "275", // Romania
-		"BG": "212", // Bulgaria
-		"AL": "201", // Albania
-		"LT": "146", // Lithuania (DXCC: Lithuania = 146 per ADIF v3.1.4)
-		"LV": "145", // Latvia
-		"EE": "52",  // Estonia
-		"UA": "288", // Ukraine
-		"MD": "179", // Moldova
-		"BY": "27",  // Belarus
-		"BA": "501", // Bosnia-Herzegovina
-		"HR": "497", // Croatia
-		"SI": "499", // Slovenia
-		"RS": "296", // Serbia
-		"ME": "514", // Montenegro
-		"MK": "502", // North Macedonia (ADIF still uses Macedonia = 502)
-		"SM": "286", // San Marino
-		"MC": "260", // Monaco
-		"AD": "203", // Andorra
-		"LI": "252", // Liechtenstein
-		"GI": "233", // Gibraltar
-		"VA": "295", // Vatican
-		"MT": "257", // Malta
+	// South America
+	"BR": 108, // Brazil
+	"AR": 100, // Argentina
+	"CL": 112, // Chile
+	"PY": 132, // Paraguay
+	"UY": 144, // Uruguay
+	"BO": 104, // Bolivia
+	"PE": 136, // Peru
+	"EC": 120, // Ecuador
+	"CO": 116, // Colombia
+	"VE": 148, // Venezuela
+	"GY": 129, // Guyana
+	"SR": 140, // Suriname
+	"GF": 63,  // French Guiana
+	"FK": 141, // Falkland Islands
+	"GS": 235, // South Georgia (the South Sandwich Islands are a separate entity)
+	"AW": 91,  // Aruba
+	"CW": 517, // Curacao
+	"TT": 90,  // Trinidad & Tobago
 
-		// Note: GB/UK is intentionally omitted due to multiple DXCC entities
-		// (England, Wales, Scotland, Northern Ireland, etc.).
+	// Europe
+	"DE": 230, // Germany
+	"FR": 227, // France
+	"ES": 281, // Spain
+	"PT": 272, // Portugal
+	"IT": 248, // Italy
+	"IE": 245, // Ireland
+	"NL": 263, // Netherlands
+	"BE": 209, // Belgium
+	"LU": 254, // Luxembourg
+	"CH": 287, // Switzerland
+	"AT": 206, // Austria
+	"CZ": 503, // Czech Republic
+	"SK": 504, // Slovak Republic
+	"PL": 269, // Poland
+	"SE": 284, // Sweden
+	"NO": 266, // Norway
+	"FI": 224, // Finland
+	"AX": 5,   // Aland Islands
+	"DK": 221, // Denmark
+	"FO": 222, // Faroe Islands
+	"IS": 242, // Iceland
+	"HU": 239, // Hungary
+	"GR": 236, // Greece
+	"RO": 275, // Romania
+	"BG": 212, // Bulgaria
+	"AL": 7,   // Albania
+	"LT": 146, // Lithuania
+	"LV": 145, // Latvia
+	"EE": 52,  // Estonia
+	"UA": 288, // Ukraine
+	"MD": 179, // Moldova
+	"BY": 27,  // Belarus
+	"RU": 54,  // Russia (European Russia; see also Asiatic Russia and Kaliningrad)
+	"BA": 501, // Bosnia-Herzegovina
+	"HR": 497, // Croatia
+	"SI": 499, // Slovenia
+	"RS": 296, // Serbia
+	"ME": 514, // Montenegro
+	"MK": 502, // North Macedonia
+	"XK": 522, // Kosovo (user-assigned code)
+	"SM": 278, // San Marino
+	"MC": 260, // Monaco
+	"AD": 203, // Andorra
+	"LI": 251, // Liechtenstein
+	"GI": 233, // Gibraltar
+	"VA": 295, // Vatican
+	"MT": 257, // Malta
+	"CY": 215, // Cyprus
+	"GG": 106, // Guernsey
+	"JE": 122, // Jersey
+	"IM": 114, // Isle of Man
 
-		// Asia
-		"JP": "339", // Japan
-		"CN": "318", // China (PRC)
-		"IN": "324", // India
-		"KR": "137", // South Korea (Republic of Korea)
-		"KP": "344", // North Korea (DPRK)
-		"TW": "386", // Taiwan
-		"HK": "321", // Hong Kong
-		"MO": "323", // Macao
-		"TH": "372", // Thailand
-		"VN": "293", // Vietnam
-		"SG": "381", // Singapore
-		"MY": "299", // Malaysia
-		"ID": "327", // Indonesia
-		"PH": "375", // Philippines
-		"AE": "371", // United Arab Emirates
-		"SA": "378", // Saudi Arabia
-		"IL": "336", // Israel
-		"TR": "390", // Turkey (Asiatic + European treated as one DXCC)
+	// Note: GB/UK is intentionally omitted due to multiple DXCC entities
+	// (England, Wales, Scotland, Northern Ireland, etc.).
 
-		// Africa
-		"MW": "468", // Malawi
-		"ZA": "462", // South Africa
-		"KE": "130", // Kenya
-		"TZ": "470", // Tanzania
-		"UG": "286", // Uganda (DXCC 286)
-		"EG": "478", // Egypt
-		"MA": "446", // Morocco
-		"TN": "478", // NOTE: Tunisia is 478; Egypt is 478 too? To avoid confusion, keep Egypt only and remove Tunisia.
-		// Americas (South)
-		"BR": "108", // Brazil
-		"AR": "100", // Argentina
-		"CL": "112", // Chile
-		"PY": "132", // Paraguay
-		"UY": "144", // Uruguay
+	// Asia
+	"JP": 339, // Japan
+	"CN": 318, // China (PRC)
+	"IN": 324, // India
+	"KR": 137, // South Korea (Republic of Korea)
+	"KP": 344, // North Korea (DPRK)
+	"TW": 386, // Taiwan
+	"HK": 321, // Hong Kong
+	"MO": 152, // Macao
+	"TH": 387, // Thailand
+	"VN": 293, // Vietnam
+	"LA": 143, // Laos
+	"KH": 312, // Cambodia
+	"MM": 309, // Myanmar
+	"SG": 381, // Singapore
+	"MY": 299, // Malaysia (West Malaysia; East Malaysia is 46)
+	"ID": 327, // Indonesia
+	"PH": 375, // Philippines
+	"BN": 345, // Brunei Darussalam
+	"TL": 511, // Timor-Leste
+	"MN": 363, // Mongolia
+	"NP": 369, // Nepal
+	"BT": 306, // Bhutan
+	"BD": 305, // Bangladesh
+	"LK": 315, // Sri Lanka
+	"MV": 159, // Maldives
+	"PK": 372, // Pakistan
+	"AF": 3,   // Afghanistan
+	"IR": 330, // Iran
+	"IQ": 333, // Iraq
+	"SY": 384, // Syria
+	"LB": 354, // Lebanon
+	"JO": 342, // Jordan
+	"PS": 510, // Palestine
+	"IL": 336, // Israel
+	"TR": 390, // Turkey (Asiatic + European treated as one DXCC)
+	"SA": 378, // Saudi Arabia
+	"KW": 348, // Kuwait
+	"BH": 304, // Bahrain
+	"QA": 376, // Qatar
+	"AE": 391, // United Arab Emirates
+	"OM": 370, // Oman
+	"YE": 492, // Yemen
+	"AM": 14,  // Armenia
+	"AZ": 18,  // Azerbaijan
+	"GE": 75,  // Georgia
+	"KZ": 130, // Kazakhstan
+	"KG": 135, // Kyrgyzstan
+	"TJ": 262, // Tajikistan
+	"TM": 280, // Turkmenistan
+	"UZ": 292, // Uzbekistan
+	"IO": 33,  // Chagos Islands
 
-		// Oceania
-		"AU": "150", // Australia
-		"NZ": "170", // New Zealand
-	}
+	// Africa
+	"MW": 440, // Malawi
+	"ZA": 462, // South Africa
+	"KE": 430, // Kenya
+	"TZ": 470, // Tanzania
+	"UG": 286, // Uganda (DXCC 286)
+	"EG": 478, // Egypt
+	"MA": 446, // Morocco
+	"TN": 474, // Tunisia
+	"DZ": 400, // Algeria
+	"LY": 436, // Libya
+	"SD": 466, // Sudan
+	"SS": 521, // South Sudan
+	"ER": 51,  // Eritrea
+	"ET": 53,  // Ethiopia
+	"DJ": 382, // Djibouti
+	"SO": 232, // Somalia
+	"RW": 454, // Rwanda
+	"BI": 404, // Burundi
+	"CD": 414, // Democratic Republic of the Congo
+	"CG": 412, // Republic of the Congo
+	"GA": 420, // Gabon
+	"CM": 406, // Cameroon
+	"CF": 408, // Central African Republic
+	"TD": 410, // Chad
+	"NE": 187, // Niger
+	"NG": 450, // Nigeria
+	"BJ": 416, // Benin
+	"TG": 483, // Togo
+	"GH": 424, // Ghana
+	"CI": 428, // Cote d'Ivoire
+	"BF": 480, // Burkina Faso
+	"ML": 442, // Mali
+	"MR": 444, // Mauritania
+	"SN": 456, // Senegal
+	"GM": 422, // The Gambia
+	"GW": 109, // Guinea-Bissau
+	"GN": 107, // Guinea
+	"SL": 458, // Sierra Leone
+	"LR": 434, // Liberia
+	"CV": 409, // Cape Verde
+	"GQ": 49,  // Equatorial Guinea
+	"ST": 219, // Sao Tome & Principe
+	"AO": 401, // Angola
+	"ZM": 482, // Zambia
+	"ZW": 452, // Zimbabwe
+	"MZ": 181, // Mozambique
+	"BW": 402, // Botswana
+	"NA": 464, // Namibia
+	"LS": 432, // Lesotho
+	"SZ": 468, // Eswatini
+	"MG": 438, // Madagascar
+	"MU": 165, // Mauritius
+	"RE": 453, // Reunion
+	"YT": 169, // Mayotte
+	"KM": 411, // Comoros
+	"SC": 379, // Seychelles
+	"EH": 302, // Western Sahara
+	"BV": 24,  // Bouvet
+	"HM": 111, // Heard Island
 
-	// Clean map from any accidental duplicates above (none expected now)
+	// Oceania
+	"AU": 150, // Australia
+	"NZ": 170, // New Zealand
+	"PG": 163, // Papua New Guinea
+	"SB": 185, // Solomon Islands
+	"VU": 158, // Vanuatu
+	"NC": 162, // New Caledonia
+	"FJ": 176, // Fiji
+	"TO": 160, // Tonga
+	"WS": 190, // Samoa
+	"AS": 9,   // American Samoa
+	"NU": 188, // Niue
+	"PF": 175, // French Polynesia
+	"WF": 298, // Wallis & Futuna Islands
+	"TK": 270, // Tokelau
+	"TV": 282, // Tuvalu
+	"NR": 157, // Nauru
+	"MH": 168, // Marshall Islands
+	"FM": 173, // Micronesia
+	"PW": 22,  // Palau
+	"GU": 103, // Guam
+	"MP": 166, // Northern Mariana Islands
+	"PN": 172, // Pitcairn Island
+	"NF": 189, // Norfolk Island
+	"CX": 35,  // Christmas Island
+	"CC": 38,  // Cocos (Keeling) Islands
 
-	dxcc, ok = iso2ToDXCC[code]
-	return dxcc, ok
+	// Antarctica
+	"AQ": 13, // Antarctica
 }
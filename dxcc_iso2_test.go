@@ -11,7 +11,12 @@ func TestDXCCFromISO2_KnownMappings(t *testing.T) {
 		{"US", "291"},
 		{"DE", "230"},
 		{"JP", "339"},
-		{"MW", "468"}, // Malawi
+		{"MW", "440"}, // Malawi
+		{"SZ", "468"}, // Eswatini
+		{"SM", "278"}, // San Marino
+		{"UG", "286"}, // Uganda
+		{"TN", "474"}, // Tunisia
+		{"EG", "478"}, // Egypt
 	}
 	for _, c := range cases {
 		got, ok := DXCCFromISO2(c.in)
@@ -25,10 +30,23 @@ func TestDXCCFromISO2_KnownMappings(t *testing.T) {
 }
 
 func TestDXCCFromISO2_UnknownOrInvalid(t *testing.T) {
-	cases := []string{"", "X", "XXX", "GB", "ZZ"}
+	cases := []string{"", "X", "XXX", "GB", "UM", "KI", "ZZ"}
 	for _, in := range cases {
 		if got, ok := DXCCFromISO2(in); ok || got != "" {
 			t.Fatalf("expected no result for %q got %q ok=%v", in, got, ok)
 		}
 	}
 }
+
+func TestDXCCFromISO2_MatchesEntityTable(t *testing.T) {
+	for iso, code := range iso2ToDXCC {
+		got, ok := DXCCFromISO2(iso)
+		if !ok {
+			t.Fatalf("%s: mapping to %d not returned", iso, code)
+		}
+		e, found := LookupDXCCString(got)
+		if !found || e.Deleted {
+			t.Fatalf("%s maps to %s, which is not a current entity", iso, got)
+		}
+	}
+}
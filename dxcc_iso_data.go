@@ -0,0 +1,382 @@
+package utils
+
+// dxccISO2 maps each current DXCC entity to the ISO 3166-1 alpha-2 code of the country or
+// territory it belongs to. Entities with no ISO code are omitted.
+var dxccISO2 = map[int]string{
+	1:   "CA", // Canada
+	3:   "AF", // Afghanistan
+	4:   "MU", // Agalega & St. Brandon Islands
+	5:   "AX", // Aland Islands
+	6:   "US", // Alaska
+	7:   "AL", // Albania
+	9:   "AS", // American Samoa
+	10:  "TF", // Amsterdam & St. Paul Islands
+	11:  "IN", // Andaman & Nicobar Islands
+	12:  "AI", // Anguilla
+	13:  "AQ", // Antarctica
+	14:  "AM", // Armenia
+	15:  "RU", // Asiatic Russia
+	16:  "NZ", // New Zealand Subantarctic Islands
+	17:  "VE", // Aves Island
+	18:  "AZ", // Azerbaijan
+	20:  "UM", // Baker & Howland Islands
+	21:  "ES", // Balearic Islands
+	22:  "PW", // Palau
+	24:  "BV", // Bouvet
+	27:  "BY", // Belarus
+	29:  "ES", // Canary Islands
+	31:  "KI", // Central Kiribati
+	32:  "ES", // Ceuta & Melilla
+	33:  "IO", // Chagos Islands
+	34:  "NZ", // Chatham Islands
+	35:  "CX", // Christmas Island
+	36:  "FR", // Clipperton Island
+	37:  "CR", // Cocos Island
+	38:  "CC", // Cocos (Keeling) Islands
+	40:  "GR", // Crete
+	41:  "TF", // Crozet Island
+	43:  "PR", // Desecheo Island
+	45:  "GR", // Dodecanese
+	46:  "MY", // East Malaysia
+	47:  "CL", // Easter Island
+	48:  "KI", // Eastern Kiribati
+	49:  "GQ", // Equatorial Guinea
+	50:  "MX", // Mexico
+	51:  "ER", // Eritrea
+	52:  "EE", // Estonia
+	53:  "ET", // Ethiopia
+	54:  "RU", // European Russia
+	56:  "BR", // Fernando de Noronha
+	60:  "BS", // Bahamas
+	61:  "RU", // Franz Josef Land
+	62:  "BB", // Barbados
+	63:  "GF", // French Guiana
+	64:  "BM", // Bermuda
+	65:  "VG", // British Virgin Islands
+	66:  "BZ", // Belize
+	69:  "KY", // Cayman Islands
+	70:  "CU", // Cuba
+	71:  "EC", // Galapagos Islands
+	72:  "DO", // Dominican Republic
+	74:  "SV", // El Salvador
+	75:  "GE", // Georgia
+	76:  "GT", // Guatemala
+	77:  "GD", // Grenada
+	78:  "HT", // Haiti
+	79:  "GP", // Guadeloupe
+	80:  "HN", // Honduras
+	82:  "JM", // Jamaica
+	84:  "MQ", // Martinique
+	86:  "NI", // Nicaragua
+	88:  "PA", // Panama
+	89:  "TC", // Turks & Caicos Islands
+	90:  "TT", // Trinidad & Tobago
+	91:  "AW", // Aruba
+	94:  "AG", // Antigua & Barbuda
+	95:  "DM", // Dominica
+	96:  "MS", // Montserrat
+	97:  "LC", // St. Lucia
+	98:  "VC", // St. Vincent
+	99:  "TF", // Glorioso Islands
+	100: "AR", // Argentina
+	103: "GU", // Guam
+	104: "BO", // Bolivia
+	105: "CU", // Guantanamo Bay
+	106: "GG", // Guernsey
+	107: "GN", // Guinea
+	108: "BR", // Brazil
+	109: "GW", // Guinea-Bissau
+	110: "US", // Hawaii
+	111: "HM", // Heard Island
+	112: "CL", // Chile
+	114: "IM", // Isle of Man
+	116: "CO", // Colombia
+	118: "SJ", // Jan Mayen
+	120: "EC", // Ecuador
+	122: "JE", // Jersey
+	123: "UM", // Johnston Island
+	124: "TF", // Juan de Nova, Europa
+	125: "CL", // Juan Fernandez Islands
+	126: "RU", // Kaliningrad
+	129: "GY", // Guyana
+	130: "KZ", // Kazakhstan
+	131: "TF", // Kerguelen Islands
+	132: "PY", // Paraguay
+	133: "NZ", // Kermadec Islands
+	135: "KG", // Kyrgyzstan
+	136: "PE", // Peru
+	137: "KR", // Republic of Korea
+	138: "US", // Kure Island
+	140: "SR", // Suriname
+	141: "FK", // Falkland Islands
+	142: "IN", // Lakshadweep Islands
+	143: "LA", // Laos
+	144: "UY", // Uruguay
+	145: "LV", // Latvia
+	146: "LT", // Lithuania
+	147: "AU", // Lord Howe Island
+	148: "VE", // Venezuela
+	149: "PT", // Azores
+	150: "AU", // Australia
+	152: "MO", // Macao
+	153: "AU", // Macquarie Island
+	157: "NR", // Nauru
+	158: "VU", // Vanuatu
+	159: "MV", // Maldives
+	160: "TO", // Tonga
+	161: "CO", // Malpelo Island
+	162: "NC", // New Caledonia
+	163: "PG", // Papua New Guinea
+	165: "MU", // Mauritius
+	166: "MP", // Mariana Islands
+	167: "AX", // Market Reef
+	168: "MH", // Marshall Islands
+	169: "YT", // Mayotte
+	170: "NZ", // New Zealand
+	171: "AU", // Mellish Reef
+	172: "PN", // Pitcairn Island
+	173: "FM", // Micronesia
+	174: "UM", // Midway Island
+	175: "PF", // French Polynesia
+	176: "FJ", // Fiji
+	177: "JP", // Minami Torishima
+	179: "MD", // Moldova
+	180: "GR", // Mount Athos
+	181: "MZ", // Mozambique
+	182: "UM", // Navassa Island
+	185: "SB", // Solomon Islands
+	187: "NE", // Niger
+	188: "NU", // Niue
+	189: "NF", // Norfolk Island
+	190: "WS", // Samoa
+	191: "CK", // North Cook Islands
+	192: "JP", // Ogasawara
+	195: "GQ", // Annobon Island
+	197: "UM", // Palmyra & Jarvis Islands
+	199: "AQ", // Peter 1 Island
+	201: "ZA", // Prince Edward & Marion Islands
+	202: "PR", // Puerto Rico
+	203: "AD", // Andorra
+	204: "MX", // Revillagigedo
+	205: "SH", // Ascension Island
+	206: "AT", // Austria
+	207: "MU", // Rodriguez Island
+	209: "BE", // Belgium
+	211: "CA", // Sable Island
+	212: "BG", // Bulgaria
+	213: "MF", // Saint Martin
+	214: "FR", // Corsica
+	215: "CY", // Cyprus
+	216: "CO", // San Andres & Providencia
+	217: "CL", // San Felix & San Ambrosio
+	219: "ST", // Sao Tome & Principe
+	221: "DK", // Denmark
+	222: "FO", // Faroe Islands
+	223: "GB", // England
+	224: "FI", // Finland
+	225: "IT", // Sardinia
+	227: "FR", // France
+	230: "DE", // Federal Republic of Germany
+	232: "SO", // Somalia
+	233: "GI", // Gibraltar
+	234: "CK", // South Cook Islands
+	235: "GS", // South Georgia Island
+	236: "GR", // Greece
+	237: "GL", // Greenland
+	238: "AQ", // South Orkney Islands
+	239: "HU", // Hungary
+	240: "GS", // South Sandwich Islands
+	241: "AQ", // South Shetland Islands
+	242: "IS", // Iceland
+	245: "IE", // Ireland
+	248: "IT", // Italy
+	249: "KN", // St. Kitts & Nevis
+	250: "SH", // St. Helena
+	251: "LI", // Liechtenstein
+	252: "CA", // St. Paul Island
+	253: "BR", // St. Peter & St. Paul Rocks
+	254: "LU", // Luxembourg
+	256: "PT", // Madeira Islands
+	257: "MT", // Malta
+	259: "SJ", // Svalbard
+	260: "MC", // Monaco
+	262: "TJ", // Tajikistan
+	263: "NL", // Netherlands
+	265: "GB", // Northern Ireland
+	266: "NO", // Norway
+	269: "PL", // Poland
+	270: "TK", // Tokelau Islands
+	272: "PT", // Portugal
+	273: "BR", // Trindade & Martim Vaz Islands
+	274: "SH", // Tristan da Cunha & Gough Islands
+	275: "RO", // Romania
+	276: "TF", // Tromelin Island
+	277: "PM", // St. Pierre & Miquelon
+	278: "SM", // San Marino
+	279: "GB", // Scotland
+	280: "TM", // Turkmenistan
+	281: "ES", // Spain
+	282: "TV", // Tuvalu
+	283: "GB", // UK Sovereign Base Areas on Cyprus
+	284: "SE", // Sweden
+	285: "VI", // US Virgin Islands
+	286: "UG", // Uganda
+	287: "CH", // Switzerland
+	288: "UA", // Ukraine
+	291: "US", // United States of America
+	292: "UZ", // Uzbekistan
+	293: "VN", // Viet Nam
+	294: "GB", // Wales
+	295: "VA", // Vatican
+	296: "RS", // Serbia
+	297: "UM", // Wake Island
+	298: "WF", // Wallis & Futuna Islands
+	299: "MY", // West Malaysia
+	301: "KI", // Western Kiribati
+	302: "EH", // Western Sahara
+	303: "AU", // Willis Island
+	304: "BH", // Bahrain
+	305: "BD", // Bangladesh
+	306: "BT", // Bhutan
+	308: "CR", // Costa Rica
+	309: "MM", // Myanmar
+	312: "KH", // Cambodia
+	315: "LK", // Sri Lanka
+	318: "CN", // China
+	321: "HK", // Hong Kong
+	324: "IN", // India
+	327: "ID", // Indonesia
+	330: "IR", // Iran
+	333: "IQ", // Iraq
+	336: "IL", // Israel
+	339: "JP", // Japan
+	342: "JO", // Jordan
+	344: "KP", // Democratic People's Republic of Korea
+	345: "BN", // Brunei Darussalam
+	348: "KW", // Kuwait
+	354: "LB", // Lebanon
+	363: "MN", // Mongolia
+	369: "NP", // Nepal
+	370: "OM", // Oman
+	372: "PK", // Pakistan
+	375: "PH", // Philippines
+	376: "QA", // Qatar
+	378: "SA", // Saudi Arabia
+	379: "SC", // Seychelles
+	381: "SG", // Singapore
+	382: "DJ", // Djibouti
+	384: "SY", // Syria
+	386: "TW", // Taiwan
+	387: "TH", // Thailand
+	390: "TR", // Turkey
+	391: "AE", // United Arab Emirates
+	400: "DZ", // Algeria
+	401: "AO", // Angola
+	402: "BW", // Botswana
+	404: "BI", // Burundi
+	406: "CM", // Cameroon
+	408: "CF", // Central African Republic
+	409: "CV", // Cape Verde
+	410: "TD", // Chad
+	411: "KM", // Comoros
+	412: "CG", // Republic of the Congo
+	414: "CD", // Democratic Republic of the Congo
+	416: "BJ", // Benin
+	420: "GA", // Gabon
+	422: "GM", // The Gambia
+	424: "GH", // Ghana
+	428: "CI", // Cote d'Ivoire
+	430: "KE", // Kenya
+	432: "LS", // Lesotho
+	434: "LR", // Liberia
+	436: "LY", // Libya
+	438: "MG", // Madagascar
+	440: "MW", // Malawi
+	442: "ML", // Mali
+	444: "MR", // Mauritania
+	446: "MA", // Morocco
+	450: "NG", // Nigeria
+	452: "ZW", // Zimbabwe
+	453: "RE", // Reunion Island
+	454: "RW", // Rwanda
+	456: "SN", // Senegal
+	458: "SL", // Sierra Leone
+	460: "FJ", // Rotuma Island
+	462: "ZA", // Republic of South Africa
+	464: "NA", // Namibia
+	466: "SD", // Sudan
+	468: "SZ", // Kingdom of Eswatini
+	470: "TZ", // Tanzania
+	474: "TN", // Tunisia
+	478: "EG", // Egypt
+	480: "BF", // Burkina Faso
+	482: "ZM", // Zambia
+	483: "TG", // Togo
+	489: "FJ", // Conway Reef
+	490: "KI", // Banaba Island
+	492: "YE", // Yemen
+	497: "HR", // Croatia
+	499: "SI", // Slovenia
+	501: "BA", // Bosnia-Herzegovina
+	502: "MK", // North Macedonia
+	503: "CZ", // Czech Republic
+	504: "SK", // Slovak Republic
+	505: "TW", // Pratas Island
+	507: "SB", // Temotu Province
+	508: "PF", // Austral Islands
+	509: "PF", // Marquesas Islands
+	510: "PS", // Palestine
+	511: "TL", // Timor-Leste
+	512: "NC", // Chesterfield Islands
+	513: "PN", // Ducie Island
+	514: "ME", // Montenegro
+	515: "AS", // Swains Island
+	516: "BL", // Saint Barthelemy
+	517: "CW", // Curacao
+	518: "SX", // Sint Maarten
+	519: "BQ", // Saba & St. Eustatius
+	520: "BQ", // Bonaire
+	521: "SS", // Republic of South Sudan
+	522: "XK", // Republic of Kosovo
+}
+
+// isoDependencies lists entities with their own ISO code that are also reported under another code.
+var isoDependencies = map[string][]int{
+	"GB": {114, 106, 122}, // Isle of Man, Guernsey, Jersey
+}
+
+// iso2ToISO3 maps ISO 3166-1 alpha-2 codes to alpha-3 codes. XK (Kosovo) is a user-assigned code.
+var iso2ToISO3 = map[string]string{
+	"AD": "AND", "AE": "ARE", "AF": "AFG", "AG": "ATG", "AI": "AIA", "AL": "ALB", "AM": "ARM", "AO": "AGO",
+	"AQ": "ATA", "AR": "ARG", "AS": "ASM", "AT": "AUT", "AU": "AUS", "AW": "ABW", "AX": "ALA", "AZ": "AZE",
+	"BA": "BIH", "BB": "BRB", "BD": "BGD", "BE": "BEL", "BF": "BFA", "BG": "BGR", "BH": "BHR", "BI": "BDI",
+	"BJ": "BEN", "BL": "BLM", "BM": "BMU", "BN": "BRN", "BO": "BOL", "BQ": "BES", "BR": "BRA", "BS": "BHS",
+	"BT": "BTN", "BV": "BVT", "BW": "BWA", "BY": "BLR", "BZ": "BLZ", "CA": "CAN", "CC": "CCK", "CD": "COD",
+	"CF": "CAF", "CG": "COG", "CH": "CHE", "CI": "CIV", "CK": "COK", "CL": "CHL", "CM": "CMR", "CN": "CHN",
+	"CO": "COL", "CR": "CRI", "CU": "CUB", "CV": "CPV", "CW": "CUW", "CX": "CXR", "CY": "CYP", "CZ": "CZE",
+	"DE": "DEU", "DJ": "DJI", "DK": "DNK", "DM": "DMA", "DO": "DOM", "DZ": "DZA", "EC": "ECU", "EE": "EST",
+	"EG": "EGY", "EH": "ESH", "ER": "ERI", "ES": "ESP", "ET": "ETH", "FI": "FIN", "FJ": "FJI", "FK": "FLK",
+	"FM": "FSM", "FO": "FRO", "FR": "FRA", "GA": "GAB", "GB": "GBR", "GD": "GRD", "GE": "GEO", "GF": "GUF",
+	"GG": "GGY", "GH": "GHA", "GI": "GIB", "GL": "GRL", "GM": "GMB", "GN": "GIN", "GP": "GLP", "GQ": "GNQ",
+	"GR": "GRC", "GS": "SGS", "GT": "GTM", "GU": "GUM", "GW": "GNB", "GY": "GUY", "HK": "HKG", "HM": "HMD",
+	"HN": "HND", "HR": "HRV", "HT": "HTI", "HU": "HUN", "ID": "IDN", "IE": "IRL", "IL": "ISR", "IM": "IMN",
+	"IN": "IND", "IO": "IOT", "IQ": "IRQ", "IR": "IRN", "IS": "ISL", "IT": "ITA", "JE": "JEY", "JM": "JAM",
+	"JO": "JOR", "JP": "JPN", "KE": "KEN", "KG": "KGZ", "KH": "KHM", "KI": "KIR", "KM": "COM", "KN": "KNA",
+	"KP": "PRK", "KR": "KOR", "KW": "KWT", "KY": "CYM", "KZ": "KAZ", "LA": "LAO", "LB": "LBN", "LC": "LCA",
+	"LI": "LIE", "LK": "LKA", "LR": "LBR", "LS": "LSO", "LT": "LTU", "LU": "LUX", "LV": "LVA", "LY": "LBY",
+	"MA": "MAR", "MC": "MCO", "MD": "MDA", "ME": "MNE", "MF": "MAF", "MG": "MDG", "MH": "MHL", "MK": "MKD",
+	"ML": "MLI", "MM": "MMR", "MN": "MNG", "MO": "MAC", "MP": "MNP", "MQ": "MTQ", "MR": "MRT", "MS": "MSR",
+	"MT": "MLT", "MU": "MUS", "MV": "MDV", "MW": "MWI", "MX": "MEX", "MY": "MYS", "MZ": "MOZ", "NA": "NAM",
+	"NC": "NCL", "NE": "NER", "NF": "NFK", "NG": "NGA", "NI": "NIC", "NL": "NLD", "NO": "NOR", "NP": "NPL",
+	"NR": "NRU", "NU": "NIU", "NZ": "NZL", "OM": "OMN", "PA": "PAN", "PE": "PER", "PF": "PYF", "PG": "PNG",
+	"PH": "PHL", "PK": "PAK", "PL": "POL", "PM": "SPM", "PN": "PCN", "PR": "PRI", "PS": "PSE", "PT": "PRT",
+	"PW": "PLW", "PY": "PRY", "QA": "QAT", "RE": "REU", "RO": "ROU", "RS": "SRB", "RU": "RUS", "RW": "RWA",
+	"SA": "SAU", "SB": "SLB", "SC": "SYC", "SD": "SDN", "SE": "SWE", "SG": "SGP", "SH": "SHN", "SI": "SVN",
+	"SJ": "SJM", "SK": "SVK", "SL": "SLE", "SM": "SMR", "SN": "SEN", "SO": "SOM", "SR": "SUR", "SS": "SSD",
+	"ST": "STP", "SV": "SLV", "SX": "SXM", "SY": "SYR", "SZ": "SWZ", "TC": "TCA", "TD": "TCD", "TF": "ATF",
+	"TG": "TGO", "TH": "THA", "TJ": "TJK", "TK": "TKL", "TL": "TLS", "TM": "TKM", "TN": "TUN", "TO": "TON",
+	"TR": "TUR", "TT": "TTO", "TV": "TUV", "TW": "TWN", "TZ": "TZA", "UA": "UKR", "UG": "UGA", "UM": "UMI",
+	"US": "USA", "UY": "URY", "UZ": "UZB", "VA": "VAT", "VC": "VCT", "VE": "VEN", "VG": "VGB", "VI": "VIR",
+	"VN": "VNM", "VU": "VUT", "WF": "WLF", "WS": "WSM", "XK": "XKX", "YE": "YEM", "YT": "MYT", "ZA": "ZAF",
+	"ZM": "ZMB", "ZW": "ZWE",
+}
@@ -0,0 +1,122 @@
+package utils
+
+import "testing"
+
+func TestDXCCForISO_UnitedKingdom(t *testing.T) {
+	m, ok := DXCCForISO("gb")
+	if !ok {
+		t.Fatalf("expected mapping for GB")
+	}
+	if m.Unambiguous || m.ISO2 != "GB" || m.ISO3 != "GBR" {
+		t.Fatalf("unexpected mapping header %+v", m)
+	}
+	got := make(map[int]bool)
+	for _, e := range m.Entities {
+		got[e.Code] = true
+	}
+	for _, code := range []int{223, 279, 294, 265, 114, 106, 122} {
+		if !got[code] {
+			t.Fatalf("GB mapping missing entity %d: %+v", code, m.Entities)
+		}
+	}
+	// The alpha-3 code gives the same result.
+	if m3, ok := DXCCForISO("GBR"); !ok || len(m3.Entities) != len(m.Entities) {
+		t.Fatalf("DXCCForISO(GBR) = %+v, %v", m3, ok)
+	}
+}
+
+func TestDXCCForISO_MainEntityFirst(t *testing.T) {
+	cases := []struct {
+		iso         string
+		main        int
+		unambiguous bool
+	}{
+		{"US", 291, false},
+		{"ES", 281, false},
+		{"RU", 54, false},
+		{"MW", 440, true},
+		{"jpn", 339, false},
+		{"DE", 230, true},
+		{"SM", 278, true},
+	}
+	for _, c := range cases {
+		m, ok := DXCCForISO(c.iso)
+		if !ok || m.Entities[0].Code != c.main || m.Unambiguous != c.unambiguous {
+			t.Fatalf("DXCCForISO(%q) = %+v, %v", c.iso, m, ok)
+		}
+	}
+	for _, in := range []string{"", "X", "ZZ", "ZZZ", "ABCD"} {
+		if _, ok := DXCCForISO(in); ok {
+			t.Fatalf("expected no mapping for %q", in)
+		}
+	}
+}
+
+func TestISOForDXCC(t *testing.T) {
+	cases := []struct {
+		code       int
+		iso2, iso3 string
+	}{
+		{291, "US", "USA"},
+		{6, "US", "USA"},
+		{223, "GB", "GBR"},
+		{114, "IM", "IMN"},
+		{286, "UG", "UGA"},
+		{278, "SM", "SMR"},
+		{522, "XK", "XKX"},
+	}
+	for _, c := range cases {
+		iso2, iso3, ok := ISOForDXCC(c.code)
+		if !ok || iso2 != c.iso2 || iso3 != c.iso3 {
+			t.Fatalf("ISOForDXCC(%d) = %q, %q, %v", c.code, iso2, iso3, ok)
+		}
+	}
+	// No ISO code for international organisations, disputed reefs or deleted entities.
+	for _, code := range []int{289, 117, 246, 247, 506, 81, 0} {
+		if iso2, _, ok := ISOForDXCC(code); ok {
+			t.Fatalf("expected no ISO code for %d, got %q", code, iso2)
+		}
+	}
+	if iso2, iso3, ok := ISOForDXCCString(" 230 "); !ok || iso2 != "DE" || iso3 != "DEU" {
+		t.Fatalf("ISOForDXCCString(230) = %q, %q, %v", iso2, iso3, ok)
+	}
+}
+
+func TestISOMappings_Consistent(t *testing.T) {
+	for code, iso2 := range dxccISO2 {
+		e, ok := LookupDXCC(code)
+		if !ok || e.Deleted {
+			t.Fatalf("dxccISO2 entity %d is not a current entity", code)
+		}
+		if _, ok := iso2ToISO3[iso2]; !ok {
+			t.Fatalf("entity %d has ISO code %s with no alpha-3 code", code, iso2)
+		}
+	}
+	for iso2, code := range iso2ToDXCC {
+		if dxccISO2[code] != iso2 {
+			t.Fatalf("main entity %d of %s has ISO code %q", code, iso2, dxccISO2[code])
+		}
+	}
+	for iso2, iso3 := range iso2ToISO3 {
+		if back, ok := ISO3ToISO2(iso3); !ok || back != iso2 {
+			t.Fatalf("ISO3ToISO2(%s) = %q, %v want %s", iso3, back, ok, iso2)
+		}
+		if got, ok := ISO2ToISO3(iso2); !ok || got != iso3 {
+			t.Fatalf("ISO2ToISO3(%s) = %q, %v", iso2, got, ok)
+		}
+	}
+	for _, e := range CurrentDXCCEntities() {
+		iso2, _, ok := ISOForDXCC(e.Code)
+		if !ok {
+			continue
+		}
+		m, _ := DXCCForISO(iso2)
+		found := false
+		for _, me := range m.Entities {
+			found = found || me.Code == e.Code
+		}
+		if !found {
+			t.Fatalf("entity %d not listed under %s", e.Code, iso2)
+		}
+	}
+}
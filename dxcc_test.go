@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDXCCEntities_Table(t *testing.T) {
+	continents := map[string]bool{"AF": true, "AN": true, "AS": true, "EU": true, "NA": true, "OC": true, "SA": true}
+	seen := make(map[int]bool)
+	var currentCount int
+	for _, e := range dxccEntities {
+		if seen[e.Code] {
+			t.Fatalf("duplicate entity code %d", e.Code)
+		}
+		seen[e.Code] = true
+		if e.Name == emptyString {
+			t.Fatalf("entity %d has no name", e.Code)
+		}
+		if !continents[e.Continent] {
+			t.Fatalf("entity %d (%s) has invalid continent %q", e.Code, e.Name, e.Continent)
+		}
+		if len(e.CQZones) == 0 || len(e.ITUZones) == 0 {
+			t.Fatalf("entity %d (%s) has no zones", e.Code, e.Name)
+		}
+		for _, z := range e.CQZones {
+			if z < 1 || z > 40 {
+				t.Fatalf("entity %d has invalid CQ zone %d", e.Code, z)
+			}
+		}
+		for _, z := range e.ITUZones {
+			if z < 1 || z > 90 {
+				t.Fatalf("entity %d has invalid ITU zone %d", e.Code, z)
+			}
+		}
+		if !e.Deleted {
+			currentCount++
+			if e.Prefix == emptyString || !e.ValidTo.IsZero() {
+				t.Fatalf("current entity %d (%s) has no prefix or an end date", e.Code, e.Name)
+			}
+		}
+	}
+	if currentCount != 340 {
+		t.Fatalf("expected 340 current entities, got %d", currentCount)
+	}
+	if n := len(CurrentDXCCEntities()); n != currentCount {
+		t.Fatalf("CurrentDXCCEntities returned %d entities, want %d", n, currentCount)
+	}
+	all := DXCCEntities()
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Code >= all[i].Code {
+			t.Fatalf("DXCCEntities not ordered by code at %d", i)
+		}
+	}
+}
+
+func TestLookupDXCC(t *testing.T) {
+	e, ok := LookupDXCC(291)
+	if !ok || e.Name != "United States of America" || e.Prefix != "K" || e.Continent != "NA" {
+		t.Fatalf("LookupDXCC(291) = %+v, %v", e, ok)
+	}
+	if e, ok := LookupDXCCString(" 286 "); !ok || e.Name != "Uganda" {
+		t.Fatalf("LookupDXCCString(286) = %+v, %v", e, ok)
+	}
+	if e, ok := LookupDXCC(81); !ok || !e.Deleted {
+		t.Fatalf("expected deleted entity 81, got %+v, %v", e, ok)
+	}
+	for _, code := range []int{0, 73, 999} {
+		if _, ok := LookupDXCC(code); ok {
+			t.Fatalf("expected no entity for %d", code)
+		}
+	}
+	if _, ok := LookupDXCCString("abc"); ok {
+		t.Fatalf("expected no entity for non-numeric code")
+	}
+}
+
+func TestLookupDXCCByName(t *testing.T) {
+	cases := []struct {
+		name string
+		want int
+	}{
+		{"England", 223},
+		{"SCOTLAND", 279},
+		{"St. Kitts & Nevis", 249},
+		{"Saint Kitts and Nevis", 249},
+		{"Fernando de Noronha", 56},
+		{"Comoros", 411},
+		{"Palestine", 510},
+		{"Peter 1 Is.", 199},
+		{"USA", 291},
+		{"Germany", 230},
+		{"Swaziland", 468},
+		{"Vietnam", 293},
+		{"Czechia", 503},
+	}
+	for _, c := range cases {
+		e, ok := LookupDXCCByName(c.name)
+		if !ok || e.Code != c.want {
+			t.Fatalf("LookupDXCCByName(%q) = %d, %v want %d", c.name, e.Code, ok, c.want)
+		}
+	}
+	if _, ok := LookupDXCCByName("Atlantis"); ok {
+		t.Fatalf("expected no entity for unknown name")
+	}
+}
+
+func TestDXCCEntity_ValidAt(t *testing.T) {
+	germany, _ := LookupDXCC(81)
+	frg, _ := LookupDXCC(230)
+	before := time.Date(1973, 9, 16, 23, 59, 0, 0, time.UTC)
+	after := time.Date(1973, 9, 17, 0, 1, 0, 0, time.UTC)
+	if !germany.ValidAt(before) || germany.ValidAt(after) {
+		t.Fatalf("entity 81 validity wrong around 1973-09-17")
+	}
+	if frg.ValidAt(before) || !frg.ValidAt(after) {
+		t.Fatalf("entity 230 validity wrong around 1973-09-17")
+	}
+	if usa, _ := LookupDXCC(291); !usa.ValidAt(time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("entity 291 should have no date limits")
+	}
+}
+
+func TestDXCCEntities_GeocodeRegions(t *testing.T) {
+	for _, r := range geoRegions {
+		e, ok := LookupDXCCString(r.dxcc)
+		if !ok || e.Deleted {
+			t.Fatalf("geocode region %s is not a current entity", r.dxcc)
+		}
+	}
+}
@@ -78,15 +78,15 @@ func GetFrequencyRange(freq string) (float64, float64) {
 	return 0, 0
 }
 
-// FrequencyToBand determines the band corresponding to a given frequency string using predefined mappings.
+// FrequencyToBand determines the ADIF band for a frequency in any form accepted by ParseFrequencyMHz.
 // It returns the band name if a match is found or an empty string if no match exists.
 func FrequencyToBand(freq string) string {
-	for prefix, band := range BandNames {
-		if strings.HasPrefix(freq, prefix) {
-			return band
-		}
+	mhz, err := ParseFrequencyMHz(freq)
+	if err != nil {
+		return emptyString
 	}
-	return emptyString
+	band, _ := BandForMHz(mhz)
+	return band
 }
 
 // FormatFrequencyToMhz formats a raw frequency string (e.g., "014.074.000" or "14.074") into MHz format "14.074".
@@ -126,3 +126,59 @@ func IsValidFrequencyMHz(s string) bool {
 	}
 	return f > 0
 }
+
+// ParseFrequencyMHz parses a frequency in any of the forms used by this package and returns it in MHz:
+// MHz with decimals ("144.050") or dotted MHz.kHz.Hz ("144.050.000"). A whole number is read as
+// Hz ("14074000"), kHz ("14074") or MHz ("144"), whichever falls in an ADIF band first, and is
+// rejected if none does. An ADIF FREQ field is always MHz; parse it with strconv.ParseFloat instead.
+func ParseFrequencyMHz(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.Split(s, dotString)
+	var mhz float64
+	switch len(parts) {
+	case 1:
+		n, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return 0, ErrFrequencySyntax
+		}
+		f, ok := wholeFrequencyMHz(n)
+		if !ok {
+			return 0, ErrFrequencySyntax
+		}
+		mhz = f
+	case 2:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || strings.ContainsAny(s, "+-eE") {
+			return 0, ErrFrequencySyntax
+		}
+		mhz = f
+	case 3:
+		var units [3]uint64
+		for i, p := range parts {
+			v, err := strconv.ParseUint(p, 10, 64)
+			if err != nil || (i > 0 && len(p) != 3) {
+				return 0, ErrFrequencySyntax
+			}
+			units[i] = v
+		}
+		mhz = float64(units[0]) + float64(units[1])/1e3 + float64(units[2])/1e6
+	default:
+		return 0, ErrFrequencySyntax
+	}
+	if mhz <= 0 {
+		return 0, ErrFrequencySyntax
+	}
+	return mhz, nil
+}
+
+// wholeFrequencyMHz reads a whole-number frequency as Hz, kHz or MHz, in that order, and returns the
+// first reading that falls in an ADIF band.
+func wholeFrequencyMHz(n uint64) (float64, bool) {
+	for _, scale := range []float64{1e6, 1e3, 1} {
+		mhz := float64(n) / scale
+		if _, ok := BandForMHz(mhz); ok {
+			return mhz, true
+		}
+	}
+	return 0, false
+}
@@ -18,11 +18,15 @@ func TestFormatFrequencyToKhz(t *testing.T) {
 
 func TestFrequencyToBand(t *testing.T) {
 	cases := map[string]string{
-		"14.074":  "20m",
-		"7.050":   "40m",
-		"50.313":  "6m",
-		"1.900":   "160m",
-		"999.999": "",
+		"14.074":      "20m",
+		"7.050":       "40m",
+		"50.313":      "6m",
+		"1.900":       "160m",
+		"144.050":     "2m",
+		"144.300.000": "2m",
+		"2.500":       "",
+		"999.999":     "",
+		"abc":         "",
 	}
 	for in, want := range cases {
 		if got := FrequencyToBand(in); got != want {
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrNoEntity = errors.New("position is not within any DXCC entity (maritime or unclaimed)")
+
+// GeoEntity is the result of reverse geocoding a position.
+// DXCC is the ADIF DXCC entity code (as returned by DXCCFromISO2) and ISO2 the ISO 3166-1 alpha-2
+// code of the country or territory (see ISOForDXCC). ISO2 is empty for entities with no ISO code, such as the UN
+// headquarters or disputed reefs. Maritime is true when the position is not on or near any entity.
+type GeoEntity struct {
+	DXCC     string
+	ISO2     string
+	Maritime bool
+}
+
+// geoRegion is one simplified entity boundary: either a polygon with vertices given as
+// {longitude, latitude}, or, for small islands and enclaves, a circle of radiusKm around centre.
+// Polygons never cross the antimeridian; circles may.
+type geoRegion struct {
+	dxcc     string
+	points   [][2]float64
+	centre   LatLong
+	radiusKm float64
+}
+
+// contains reports whether p lies within the region.
+func (r geoRegion) contains(p LatLong) bool {
+	if r.points == nil {
+		return DistanceKm(r.centre, p) <= r.radiusKm
+	}
+	return pointInPolygon(p, r.points)
+}
+
+// area returns the approximate size of the region in square degrees of latitude, used to prefer
+// enclaves and islands over the larger regions that surround them.
+func (r geoRegion) area() float64 {
+	if r.points == nil {
+		rad := r.radiusKm / (EarthRadiusKm * degToRad)
+		return math.Pi * rad * rad
+	}
+	var sum, lat float64
+	for i, j := 0, len(r.points)-1; i < len(r.points); j, i = i, i+1 {
+		sum += r.points[j][0]*r.points[i][1] - r.points[i][0]*r.points[j][1]
+		lat += r.points[i][1]
+	}
+	lat /= float64(len(r.points))
+	return math.Abs(sum) / 2 * math.Cos(lat*degToRad)
+}
+
+// EntityForLatLong returns the DXCC entity and ISO 3166-1 country containing p, using embedded,
+// simplified boundaries. Where regions overlap (enclaves such as the Vatican, or offshore islands
+// close to a mainland) the smallest containing region wins.
+//
+// Positions at sea or otherwise outside every entity return a GeoEntity with Maritime set,
+// together with ErrNoEntity. The boundaries are coarse (typically accurate to a few tens of
+// kilometres), so results near borders and coastlines should be treated as a suggestion.
+func EntityForLatLong(p LatLong) (GeoEntity, error) {
+	if err := p.Validate(); err != nil {
+		return GeoEntity{}, err
+	}
+	var best *geoRegion
+	bestArea := math.Inf(1)
+	for i := range geoRegions {
+		r := &geoRegions[i]
+		if !r.contains(p) {
+			continue
+		}
+		if a := r.area(); a < bestArea {
+			best, bestArea = r, a
+		}
+	}
+	if best == nil {
+		return GeoEntity{Maritime: true}, ErrNoEntity
+	}
+	iso2, _, _ := ISOForDXCCString(best.dxcc)
+	return GeoEntity{DXCC: best.dxcc, ISO2: iso2}, nil
+}
+
+// EntityForGridSquare returns the DXCC entity and ISO 3166-1 country at the centre of a
+// Maidenhead locator. Short locators cover a large area, so a 6 or 8 character locator
+// should be used where possible.
+func EntityForGridSquare(grid string) (GeoEntity, error) {
+	p, err := GridSquareToLatLong(grid)
+	if err != nil {
+		return GeoEntity{}, err
+	}
+	return EntityForLatLong(p)
+}
+
+// circle returns a circular region, used for small islands and enclaves.
+func circle(dxcc string, lat, long, radiusKm float64) geoRegion {
+	return geoRegion{dxcc: dxcc, centre: LatLong{Latitude: lat, Longitude: long}, radiusKm: radiusKm}
+}
+
+// outline returns a polygonal region.
+func outline(dxcc string, points [][2]float64) geoRegion {
+	return geoRegion{dxcc: dxcc, points: points}
+}
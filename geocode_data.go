@@ -0,0 +1,914 @@
+package utils
+
+// Simplified DXCC entity boundaries used by EntityForLatLong.
+//
+// Countries are drawn as coarse outlines (vertices as {longitude, latitude}) and small islands and
+// enclaves as circles. Outlines include nearby coastal waters and small offshore islands; shared
+// borders are approximate, and where two regions overlap the smaller one wins. The data is meant
+// for suggesting an entity from a logged position, not for adjudicating border or territorial
+// claims.
+var geoRegions = []geoRegion{
+	// North America
+	outline("291", [][2]float64{
+		{-124.7, 48.4}, {-123, 49}, {-95.15, 49}, {-95, 49.4}, {-89.6, 48}, {-84.5, 46.5}, {-82.5, 45.3},
+		{-82.5, 42}, {-79, 43.3}, {-76.5, 43.8}, {-75, 45}, {-71.5, 45}, {-70, 46.5}, {-69, 47.4},
+		{-67.8, 47}, {-67.8, 45.2}, {-66.9, 44.8}, {-70, 43.5}, {-69.9, 41.2}, {-74, 40.2}, {-75.3, 35.2},
+		{-81, 31.5}, {-80, 25.2}, {-81.8, 24.4}, {-82.8, 27.8}, {-84.5, 29.8}, {-89, 30.2}, {-89.4, 28.9},
+		{-94, 29.5}, {-97.2, 26}, {-99.5, 27.5}, {-101.4, 29.8}, {-103, 29}, {-104.5, 29.6}, {-106.5, 31.8},
+		{-108.2, 31.33}, {-111, 31.33}, {-114.8, 32.5}, {-117.1, 32.5}, {-120.6, 34.3}, {-122.6, 37.5},
+		{-124.4, 40.4}, {-124.2, 46.3},
+	}),
+	outline("1", [][2]float64{
+		{-141, 60.3}, {-141, 69.6}, {-125, 76}, {-100, 79}, {-75, 83.1}, {-61, 82.3}, {-72, 78}, {-80, 73},
+		{-62, 66.5}, {-64, 60}, {-55.6, 52}, {-52.6, 47.5}, {-53, 46.6}, {-59.5, 47.5}, {-60, 45.8},
+		{-66, 43.5}, {-66.9, 44.8}, {-67.8, 45.2}, {-67.8, 47}, {-69, 47.4}, {-70, 46.5}, {-71.5, 45},
+		{-75, 45}, {-76.5, 43.8}, {-79, 43.3}, {-82.5, 42}, {-82.5, 45.3}, {-84.5, 46.5}, {-89.6, 48},
+		{-95, 49.4}, {-95.15, 49}, {-123, 49}, {-123.3, 48.3}, {-125.5, 48.7}, {-128.5, 50.6},
+		{-133.2, 54.2}, {-130, 54.7}, {-130, 55.9}, {-133, 58.4}, {-137.5, 59.1}, {-139, 60.3},
+	}),
+	outline("6", [][2]float64{
+		{-141, 60.3}, {-139, 60.3}, {-137.5, 59.1}, {-133, 58.4}, {-130, 55.9}, {-130, 54.7}, {-134, 54.5},
+		{-140, 59.5}, {-150, 59}, {-155, 57}, {-162, 54.5}, {-165, 54}, {-168, 53.5}, {-168, 55},
+		{-162, 56}, {-157, 58.7}, {-162, 59.5}, {-166, 60.5}, {-165, 63}, {-168.2, 65.6}, {-166, 68.9},
+		{-156.8, 71.4}, {-141, 69.6},
+	}),
+	outline("6", [][2]float64{{-180, 51}, {-168, 52.5}, {-168, 54}, {-180, 52.5}}),
+	outline("6", [][2]float64{{172, 52.3}, {180, 51}, {180, 52.5}, {172, 53.2}}),
+	circle("110", 20.7, -157.5, 320),
+	outline("50", [][2]float64{
+		{-117.1, 32.5}, {-114.8, 32.5}, {-111, 31.33}, {-108.2, 31.33}, {-106.5, 31.8}, {-104.5, 29.6},
+		{-103, 29}, {-101.4, 29.8}, {-99.5, 27.5}, {-97.2, 26}, {-97.7, 21.8}, {-96, 19}, {-94.5, 18.2},
+		{-91.5, 18.4}, {-90.4, 21}, {-87, 21.6}, {-86.7, 21}, {-87.5, 18.3}, {-88.3, 18.5}, {-89.15, 17.8},
+		{-91.4, 17.25}, {-90.4, 16.1}, {-91.7, 16.1}, {-92.2, 15.2}, {-92.2, 14.5}, {-94, 16},
+		{-96.5, 15.6}, {-101, 17.3}, {-105.5, 20.5}, {-105.7, 22.5}, {-109.4, 22.9}, {-110.3, 23.5},
+		{-114.1, 27.7}, {-115.8, 30.4},
+	}),
+	outline("237", [][2]float64{
+		{-73, 78}, {-60, 82.2}, {-30, 83.7}, {-12, 81.6}, {-18, 76}, {-22, 70}, {-32, 68}, {-40, 65},
+		{-43, 59.7}, {-48, 61}, {-53.5, 66}, {-56, 72}, {-66, 76},
+	}),
+	circle("211", 43.95, -59.9, 20),
+	circle("252", 47.22, -60.14, 4),
+	circle("277", 46.9, -56.3, 25),
+	circle("289", 40.749, -73.968, 0.2),
+
+	// Central America and the Caribbean
+	outline("76", [][2]float64{
+		{-92.2, 14.5}, {-92.2, 15.2}, {-91.7, 16.1}, {-90.4, 16.1}, {-91.4, 17.25}, {-89.15, 17.8},
+		{-89.2, 15.9}, {-88.2, 15.7}, {-89.2, 14.4}, {-90.1, 13.7}, {-91.4, 13.9},
+	}),
+	outline("66", [][2]float64{{-89.15, 17.8}, {-88.3, 18.5}, {-87.7, 17}, {-88.9, 15.9}, {-89.2, 15.9}}),
+	outline("74", [][2]float64{{-90.1, 13.7}, {-89.2, 14.4}, {-87.7, 13.8}, {-87.8, 13.2}, {-89.8, 13.4}}),
+	outline("80", [][2]float64{
+		{-89.2, 14.4}, {-88.2, 15.7}, {-86, 16}, {-83.2, 15}, {-84.7, 14.6}, {-86.8, 13.3}, {-87.8, 13.2},
+		{-87.7, 13.8},
+	}),
+	outline("86", [][2]float64{
+		{-87.7, 13}, {-86.8, 13.3}, {-84.7, 14.6}, {-83.2, 15}, {-83.5, 11}, {-83.7, 10.9}, {-85.7, 11.1},
+		{-87.7, 12.9},
+	}),
+	outline("308", [][2]float64{{-85.7, 11.1}, {-83.7, 10.9}, {-82.6, 9.6}, {-82.9, 8}, {-85.9, 9.9}}),
+	outline("88", [][2]float64{
+		{-82.6, 9.6}, {-79, 9.6}, {-77.4, 8.7}, {-77.2, 7.9}, {-78.2, 7.2}, {-80.4, 7.2}, {-82.9, 8},
+	}),
+	outline("70", [][2]float64{
+		{-85, 21.8}, {-84, 23}, {-80, 23.3}, {-77.6, 21.8}, {-74.1, 20.2}, {-77.7, 19.8}, {-79, 21.5},
+		{-82, 21.5}, {-83.3, 22},
+	}),
+	outline("78", [][2]float64{{-74.5, 18.3}, {-72.8, 20}, {-71.7, 19.7}, {-71.7, 18}, {-74.5, 18.1}}),
+	outline("72", [][2]float64{{-71.7, 19.7}, {-69.9, 19.9}, {-68.3, 18.6}, {-71.1, 17.6}, {-71.7, 18}}),
+	outline("60", [][2]float64{
+		{-79.3, 26.9}, {-77, 27.2}, {-75.3, 24.2}, {-72.7, 22.3}, {-73.5, 20.9}, {-75.5, 22}, {-77.8, 22.8},
+		{-79.6, 24.8},
+	}),
+	circle("105", 19.9, -75.15, 12),
+	circle("82", 18.1, -77.3, 130),
+	circle("69", 19.5, -80.5, 110),
+	circle("89", 21.7, -71.8, 70),
+	circle("64", 32.3, -64.75, 25),
+	circle("202", 18.2, -66.5, 100),
+	circle("43", 18.38, -67.48, 3),
+	circle("182", 18.4, -75.02, 3),
+	circle("285", 18.34, -64.93, 15),
+	circle("285", 18.33, -64.74, 7),
+	circle("285", 17.73, -64.75, 25),
+	circle("65", 18.43, -64.6, 12),
+	circle("65", 18.72, -64.35, 10),
+	circle("12", 18.22, -63.05, 15),
+	circle("213", 18.08, -63.06, 5),
+	circle("518", 18.03, -63.06, 4),
+	circle("516", 17.9, -62.83, 6),
+	circle("519", 17.55, -63.1, 20),
+	circle("249", 17.25, -62.7, 30),
+	circle("94", 17.3, -61.8, 50),
+	circle("96", 16.75, -62.2, 10),
+	circle("79", 16.2, -61.5, 60),
+	circle("95", 15.4, -61.35, 30),
+	circle("84", 14.65, -61, 35),
+	circle("97", 13.9, -60.97, 25),
+	circle("62", 13.15, -59.55, 25),
+	circle("98", 13.2, -61.2, 60),
+	circle("77", 12.1, -61.7, 40),
+	circle("90", 10.7, -61.2, 80),
+	circle("91", 12.52, -69.97, 20),
+	circle("517", 12.17, -68.97, 35),
+	circle("520", 12.15, -68.27, 25),
+	circle("17", 15.67, -63.62, 3),
+	circle("216", 12.9, -81.5, 60),
+
+	// Eastern Pacific islands
+	circle("204", 18.8, -112.7, 230),
+	circle("36", 10.3, -109.2, 5),
+	circle("37", 5.53, -87.05, 10),
+	circle("161", 4, -81.6, 5),
+	circle("71", -0.6, -90.5, 250),
+	circle("47", -27.1, -109.35, 25),
+	circle("125", -33.7, -79.7, 130),
+	circle("217", -26.3, -80.1, 20),
+
+	// South America
+	outline("116", [][2]float64{
+		{-77.4, 8.7}, {-75.5, 10.5}, {-72, 12.4}, {-71.1, 11.8}, {-72.4, 11.1}, {-72.5, 8.3}, {-70, 7},
+		{-67.5, 6.2}, {-67.8, 4.5}, {-67.3, 2.2}, {-66.9, 1.2}, {-69.8, 1.1}, {-69.4, -1.1}, {-69.9, -4.2},
+		{-70.7, -3.8}, {-73, -2.5}, {-75.5, -0.1}, {-77.4, 0.8}, {-78.8, 1.4}, {-77.9, 7.2}, {-77.2, 7.9},
+	}),
+	outline("148", [][2]float64{
+		{-71.1, 11.8}, {-68, 10.7}, {-64, 11.2}, {-62, 10.7}, {-60.7, 8.4}, {-61.4, 5.9}, {-60.7, 5.2},
+		{-62.8, 4}, {-64.8, 4.2}, {-64, 1.5}, {-66.9, 1.2}, {-67.3, 2.2}, {-67.8, 4.5}, {-67.5, 6.2},
+		{-70, 7}, {-72.5, 8.3}, {-72.4, 11.1},
+	}),
+	outline("129", [][2]float64{
+		{-60.7, 8.4}, {-57.2, 6}, {-58, 4.5}, {-57.3, 1.9}, {-58.8, 1.2}, {-60.2, 1.9}, {-59.8, 3.6},
+		{-60.7, 5.2}, {-61.4, 5.9},
+	}),
+	outline("140", [][2]float64{
+		{-57.2, 6}, {-54, 5.9}, {-54, 3.6}, {-54.6, 2.3}, {-56, 1.9}, {-57.3, 1.9}, {-58, 4.5},
+	}),
+	outline("63", [][2]float64{{-54, 5.9}, {-51.6, 4.2}, {-52.9, 2.2}, {-54.6, 2.3}, {-54, 3.6}}),
+	outline("120", [][2]float64{
+		{-78.8, 1.4}, {-77.4, 0.8}, {-75.5, -0.1}, {-75.2, -1.6}, {-78.5, -5}, {-80.3, -3.4}, {-81, -2.2},
+		{-80, 1},
+	}),
+	outline("136", [][2]float64{
+		{-80.3, -3.4}, {-78.5, -5}, {-75.2, -1.6}, {-73, -2.5}, {-70.7, -3.8}, {-69.9, -4.2}, {-73, -7.3},
+		{-70.6, -11}, {-69, -11}, {-68.7, -12.5}, {-69.5, -14.5}, {-69, -16.2}, {-69.5, -17.5},
+		{-70.4, -18.35}, {-76.3, -13.9}, {-81.3, -5.5},
+	}),
+	outline("104", [][2]float64{
+		{-69, -11}, {-65.4, -9.7}, {-60, -13.5}, {-58.2, -16.3}, {-58, -20}, {-62.6, -22.2}, {-67.7, -22.8},
+		{-68.7, -20}, {-69.5, -17.5}, {-69, -16.2}, {-69.5, -14.5}, {-68.7, -12.5},
+	}),
+	outline("108", [][2]float64{
+		{-51.6, 4.2}, {-50, 1.8}, {-44, -2.4}, {-35, -5.2}, {-34.8, -7.5}, {-39, -17.7}, {-41, -22},
+		{-48.6, -26}, {-50.1, -30}, {-53.4, -33.7}, {-55.9, -30.9}, {-57.6, -30.2}, {-55.8, -28},
+		{-54.8, -27.3}, {-54.6, -25.6}, {-55.9, -22.3}, {-57.9, -22.1}, {-58, -20}, {-58.2, -16.3},
+		{-60, -13.5}, {-65.4, -9.7}, {-69, -11}, {-70.6, -11}, {-73, -7.3}, {-69.9, -4.2}, {-69.4, -1.1},
+		{-69.8, 1.1}, {-66.9, 1.2}, {-64, 1.5}, {-64.8, 4.2}, {-62.8, 4}, {-60.7, 5.2}, {-59.8, 3.6},
+		{-60.2, 1.9}, {-58.8, 1.2}, {-57.3, 1.9}, {-56, 1.9}, {-54.6, 2.3}, {-52.9, 2.2},
+	}),
+	outline("132", [][2]float64{
+		{-62.6, -22.2}, {-58, -20}, {-57.9, -22.1}, {-55.9, -22.3}, {-54.6, -25.6}, {-54.8, -27.3},
+		{-58.6, -27.3}, {-57.6, -25.4}, {-60, -24},
+	}),
+	outline("144", [][2]float64{
+		{-58.4, -33.9}, {-57.6, -30.2}, {-55.9, -30.9}, {-53.4, -33.7}, {-54, -35}, {-56.3, -35.05},
+		{-57.9, -34.55},
+	}),
+	outline("100", [][2]float64{
+		{-65.7, -22}, {-62.6, -22.2}, {-60, -24}, {-57.6, -25.4}, {-58.6, -27.3}, {-54.8, -27.3},
+		{-53.6, -26.2}, {-55.8, -28}, {-57.6, -30.2}, {-58.4, -33.9}, {-58.4, -34.3}, {-56.7, -36.4},
+		{-57.7, -38.2}, {-62.3, -38.9}, {-65, -41}, {-63.6, -42.8}, {-67.5, -46}, {-65.8, -47.8},
+		{-69, -51}, {-68.4, -52.3}, {-71.3, -52}, {-72.3, -51.6}, {-73.3, -50.7}, {-72.4, -48.5},
+		{-71.3, -46.5}, {-71.7, -44}, {-71.8, -42}, {-71.1, -39}, {-70.4, -36.2}, {-70, -33},
+		{-69.7, -30}, {-68.3, -26.9}, {-67, -23},
+	}),
+	outline("100", [][2]float64{{-68.6, -52.7}, {-68.6, -54.9}, {-66.5, -55.1}, {-65.2, -54.6}, {-68.3, -52.6}}),
+	outline("112", [][2]float64{
+		{-70.4, -18.35}, {-69.5, -17.5}, {-68.7, -20}, {-67.7, -22.8}, {-67, -23}, {-68.3, -26.9},
+		{-69.7, -30}, {-70, -33}, {-70.4, -36.2}, {-71.1, -39}, {-71.8, -42}, {-71.7, -44}, {-71.3, -46.5},
+		{-72.4, -48.5}, {-73.3, -50.7}, {-72.3, -51.6}, {-71.3, -52}, {-68.4, -52.3}, {-68.6, -52.7},
+		{-68.6, -54.9}, {-67, -55.9}, {-70, -56}, {-75, -53}, {-75.7, -48}, {-74, -43}, {-73.7, -37},
+		{-71.7, -33}, {-71.5, -28}, {-70.6, -23.5},
+	}),
+	circle("141", -51.75, -59.3, 140),
+	circle("235", -54.4, -36.7, 100),
+	circle("240", -57.8, -26.5, 200),
+	circle("238", -60.7, -45.5, 100),
+	circle("241", -62.3, -59, 150),
+	circle("56", -3.85, -32.42, 10),
+	circle("253", 0.92, -29.35, 3),
+	circle("273", -20.5, -29.3, 30),
+
+	// Antarctica
+	outline("13", box(-180, -90, 180, -66)),
+	outline("13", [][2]float64{{-68, -66}, {-63, -64.5}, {-57, -63.3}, {-55, -63.3}, {-60, -66}}),
+	circle("199", -68.8, -90.6, 15),
+	circle("24", -54.42, 3.36, 10),
+
+	// Europe
+	outline("223", [][2]float64{
+		{-5.8, 50}, {1.8, 51}, {1.8, 52.7}, {0.2, 53.5}, {-1.5, 55.5}, {-2, 55.8}, {-2.6, 55.1},
+		{-3.05, 54.98}, {-3.6, 54.6}, {-3, 53.4}, {-3, 53.2}, {-3.1, 52.5}, {-2.65, 51.6}, {-3.2, 51.2},
+		{-4.3, 51.1},
+	}),
+	outline("294", [][2]float64{
+		{-3, 53.3}, {-4.7, 53.4}, {-4.8, 52.8}, {-4.1, 52.3}, {-5.3, 51.8}, {-4.2, 51.5}, {-3.2, 51.4},
+		{-2.65, 51.6}, {-3.1, 52.5},
+	}),
+	outline("279", [][2]float64{
+		{-2, 55.8}, {-1.7, 57.5}, {-3, 58.7}, {-5, 58.7}, {-6.5, 58.3}, {-7.7, 57}, {-6.2, 55.6},
+		{-5, 54.6}, {-3.05, 54.98}, {-2.6, 55.1},
+	}),
+	circle("279", 60.3, -1.3, 80),
+	circle("279", 59, -3, 50),
+	outline("265", [][2]float64{
+		{-8.2, 54.5}, {-7.2, 55.3}, {-6, 55.2}, {-5.4, 54.3}, {-6.2, 54}, {-7.5, 54.1},
+	}),
+	outline("245", [][2]float64{
+		{-10.5, 51.5}, {-6, 52}, {-6.1, 53.5}, {-6.2, 54}, {-7.5, 54.1}, {-8.2, 54.5}, {-7.2, 55.3},
+		{-8.5, 55.2}, {-10.2, 54.2}, {-9.5, 53},
+	}),
+	circle("114", 54.23, -4.53, 30),
+	circle("106", 49.45, -2.58, 20),
+	circle("122", 49.21, -2.13, 15),
+	outline("227", [][2]float64{
+		{-4.8, 48.4}, {-1.6, 49.7}, {1.6, 50.9}, {2.5, 51.1}, {4.2, 50}, {5.8, 49.5}, {8.2, 49},
+		{7.6, 47.6}, {6, 46.2}, {7, 45.9}, {7.5, 43.8}, {3.2, 42.4}, {-1.8, 43.4}, {-1.2, 46.2}, {-2.5, 47.3},
+	}),
+	circle("214", 42.15, 9.1, 90),
+	outline("209", [][2]float64{{2.5, 51.1}, {3.4, 51.4}, {4.3, 51.4}, {5.8, 51.2}, {6.1, 50.8}, {6.1, 50.1}, {5.8, 49.5}, {4.2, 50}}),
+	outline("263", [][2]float64{
+		{3.4, 51.4}, {4.3, 51.4}, {5.8, 51.2}, {6.1, 50.8}, {6, 51.8}, {7.2, 53.3}, {6.8, 53.6}, {4.7, 53},
+	}),
+	circle("254", 49.8, 6.1, 45),
+	outline("230", [][2]float64{
+		{6, 51.8}, {6.1, 50.8}, {6.1, 50.1}, {6.5, 49.5}, {8.2, 49}, {7.6, 47.6}, {9.6, 47.5}, {13, 47.5},
+		{13.8, 48.8}, {12.1, 50.3}, {14.8, 50.9}, {14.2, 53.9}, {11, 54.3}, {9.6, 54.9}, {8.6, 55},
+		{8.6, 53.9}, {7.2, 53.3},
+	}),
+	outline("221", [][2]float64{
+		{8.6, 55}, {9.6, 54.9}, {11, 54.5}, {12.3, 54.9}, {12.75, 55.6}, {12.5, 56.1}, {10.6, 57.8}, {8.2, 56.9},
+		{8.1, 55.5},
+	}),
+	circle("221", 55.1, 14.9, 30),
+	outline("287", [][2]float64{
+		{6, 46.2}, {7, 45.9}, {8.4, 46.2}, {9, 45.8}, {10.5, 46.5}, {10.5, 46.9}, {9.6, 47.5}, {7.6, 47.6},
+	}),
+	circle("251", 47.15, 9.55, 12),
+	circle("117", 46.21, 6.135, 0.3),
+	outline("206", [][2]float64{
+		{9.6, 47.5}, {10.5, 46.9}, {12.4, 46.7}, {13.7, 46.5}, {16.5, 46.5}, {16.1, 46.9}, {17.1, 48},
+		{16.9, 48.7}, {15, 49}, {13.8, 48.8}, {13, 47.5},
+	}),
+	outline("248", [][2]float64{
+		{7.5, 43.8}, {7, 45.9}, {8.4, 46.2}, {9, 45.8}, {10.5, 46.5}, {12.4, 46.7}, {13.7, 46.5},
+		{13.6, 45.6}, {12.3, 44.9}, {13.6, 43.6}, {16, 41.9}, {18.5, 40.1}, {16.5, 38.5}, {15.6, 37.9},
+		{15.6, 40.1}, {12, 41.8}, {10.5, 43}, {8.7, 44.4},
+	}),
+	outline("248", [][2]float64{{12.3, 38.1}, {13.4, 38.25}, {15.65, 38.3}, {15.1, 36.6}, {12.4, 37.6}}),
+	circle("248", 35.5, 12.6, 20),
+	circle("248", 36.8, 12, 12),
+	circle("225", 40.1, 9, 140),
+	circle("278", 43.94, 12.46, 8),
+	circle("295", 41.903, 12.453, 1),
+	circle("246", 41.9057, 12.4784, 0.3),
+	circle("260", 43.74, 7.42, 2.5),
+	circle("203", 42.55, 1.58, 15),
+	circle("257", 35.9, 14.4, 25),
+	outline("281", [][2]float64{
+		{-9.3, 43.2}, {-1.8, 43.4}, {3.2, 42.4}, {3.2, 41.9}, {0.9, 41}, {0, 39.5}, {-0.7, 37.6},
+		{-2.1, 36.7}, {-5.6, 36}, {-6.4, 36.8}, {-7.4, 37.2}, {-7, 38.2}, {-7.3, 39.5}, {-6.2, 41.6},
+		{-6.9, 41.9}, {-8.9, 42},
+	}),
+	circle("21", 39.6, 2.9, 120),
+	circle("29", 28.3, -15.8, 260),
+	circle("32", 35.89, -5.32, 5),
+	circle("32", 35.29, -2.94, 5),
+	circle("233", 36.14, -5.35, 3),
+	outline("272", [][2]float64{
+		{-8.9, 42}, {-6.9, 41.9}, {-6.2, 41.6}, {-7.3, 39.5}, {-7, 38.2}, {-7.4, 37.2}, {-8.9, 37},
+		{-9.5, 38.8},
+	}),
+	circle("149", 38.5, -28, 330),
+	circle("256", 32.75, -16.9, 130),
+	outline("242", [][2]float64{
+		{-24, 65.5}, {-22.5, 66.5}, {-16, 66.6}, {-13.5, 65.2}, {-14.5, 64.4}, {-18.7, 63.4}, {-22.7, 63.8},
+	}),
+	circle("222", 62.05, -6.9, 70),
+	circle("118", 71, -8.3, 35),
+	outline("259", [][2]float64{{10, 76.4}, {28, 76.4}, {33, 80.5}, {10, 80.5}}),
+	circle("259", 74.45, 19.1, 15),
+	outline("266", [][2]float64{
+		{4.6, 58}, {7, 57.9}, {10.5, 59}, {11.4, 58.9}, {12.5, 61}, {12.2, 63.5}, {14.5, 66}, {16, 68},
+		{18, 69}, {21, 69.1}, {25, 68.6}, {28.9, 69}, {30.8, 69.8}, {28, 71.2}, {15, 69.5}, {12, 67},
+		{9, 63.5}, {5, 62.2}, {4.6, 60},
+	}),
+	outline("284", [][2]float64{
+		{11, 58.9}, {11.4, 58.9}, {12.5, 61}, {12.2, 63.5}, {14.5, 66}, {16, 68}, {18, 69}, {20.5, 69.1},
+		{23.5, 68}, {24.1, 65.8}, {21, 64}, {17.3, 61}, {19, 59.9}, {16.5, 56.2}, {14.2, 55.4}, {12.85, 55.35},
+		{12.9, 55.6}, {12.45, 56.3},
+	}),
+	outline("224", [][2]float64{
+		{22, 59.8}, {25, 60}, {27.8, 60.5}, {31.5, 62.9}, {30, 64}, {29.5, 66}, {30, 67}, {28.9, 69}, {25, 68.6},
+		{20.5, 69.1}, {23.5, 68}, {24.1, 65.8}, {25.3, 64.8}, {21.4, 63}, {21.3, 61},
+	}),
+	circle("5", 60.2, 20, 40),
+	circle("167", 60.3, 19.13, 1),
+	outline("52", [][2]float64{
+		{23.4, 59.45}, {24.8, 59.6}, {28, 59.5}, {27.4, 57.5}, {26, 57.8}, {24.3, 57.9}, {21.8, 58.3}, {21.8, 59},
+	}),
+	outline("145", [][2]float64{
+		{21, 56.8}, {21.6, 57.6}, {24.3, 57.9}, {26, 57.8}, {27.4, 57.5}, {28.2, 56.1}, {26.6, 55.7}, {21, 56.1},
+	}),
+	outline("146", [][2]float64{{21, 56.1}, {26.6, 55.7}, {26.6, 55.2}, {25.8, 54.2}, {23.5, 53.9}, {22.8, 54.4}, {21, 55.3}}),
+	outline("126", [][2]float64{{19.6, 54.4}, {22.8, 54.4}, {21, 55.3}, {19.9, 54.9}}),
+	outline("269", [][2]float64{
+		{14.2, 53.9}, {14.8, 50.9}, {16.3, 50.7}, {18.8, 49.5}, {22.9, 49.1}, {24.1, 50.5}, {23.2, 52.2},
+		{23.5, 53.9}, {22.8, 54.4}, {19.6, 54.4}, {18.5, 54.8}, {16.5, 54.6},
+	}),
+	outline("503", [][2]float64{
+		{12.1, 50.3}, {14.8, 50.9}, {16.3, 50.7}, {18.8, 49.5}, {17.1, 48.8}, {16.9, 48.7}, {15, 49}, {13.8, 48.8},
+	}),
+	outline("504", [][2]float64{
+		{16.9, 48.6}, {17.1, 48}, {18.8, 47.8}, {20.5, 48.5}, {22.1, 48.4}, {22.5, 49.1}, {18.8, 49.5}, {17.1, 48.8},
+	}),
+	outline("239", [][2]float64{
+		{16.1, 46.9}, {17.1, 48}, {18.8, 47.8}, {20.5, 48.5}, {22.1, 48.4}, {22.9, 48}, {21, 46.2},
+		{18.8, 45.9}, {16.5, 46.5},
+	}),
+	outline("499", [][2]float64{{13.6, 45.6}, {13.7, 46.5}, {16.1, 46.9}, {16.5, 46.5}, {15.6, 45.8}, {15.2, 45.4}, {13.6, 45.5}}),
+	outline("497", [][2]float64{
+		{13.6, 45.5}, {15.2, 45.4}, {15.6, 45.8}, {16.5, 46.5}, {18.8, 45.9}, {19.4, 45.2}, {19, 44.9},
+		{15.8, 45.2}, {16.2, 44.2}, {17.6, 43}, {18.5, 42.4}, {16, 43.5}, {14.3, 45.3},
+	}),
+	outline("501", [][2]float64{
+		{15.8, 45.2}, {19, 44.9}, {19.4, 44.2}, {19.6, 43.2}, {18.5, 42.5}, {17.6, 43}, {16.2, 44.2},
+	}),
+	outline("296", [][2]float64{
+		{18.8, 45.9}, {21, 46.2}, {22.5, 44.5}, {22.4, 42.3}, {21.8, 42.6}, {20.6, 43.2}, {19.6, 43.2},
+		{19.4, 44.2}, {19, 44.9}, {19.4, 45.2},
+	}),
+	outline("522", [][2]float64{{20, 42.5}, {20.6, 43.2}, {21.8, 42.6}, {21.5, 42.2}, {20.6, 41.9}, {20.1, 42.2}}),
+	outline("514", [][2]float64{{18.5, 42.4}, {18.5, 42.5}, {19.6, 43.2}, {20.6, 43.2}, {20, 42.5}, {19.4, 41.9}}),
+	outline("7", [][2]float64{{19.4, 41.9}, {20, 42.5}, {20.6, 41.9}, {20.9, 40.9}, {20.2, 39.6}, {19.3, 40.4}}),
+	outline("502", [][2]float64{{20.6, 41.9}, {21.5, 42.2}, {22.4, 42.3}, {23, 41.4}, {20.9, 40.9}}),
+	outline("236", [][2]float64{
+		{20.2, 39.6}, {20.9, 40.9}, {23, 41.4}, {26.6, 41.7}, {26, 40.8}, {23.5, 40}, {24.5, 38},
+		{22.5, 36.4}, {21.1, 37}, {21, 38.5},
+	}),
+	circle("236", 39.2, 26.3, 35),
+	circle("236", 38.4, 26, 25),
+	circle("236", 37.7, 26.8, 20),
+	circle("40", 35.25, 24.9, 130),
+	circle("45", 36.5, 27.7, 110),
+	circle("180", 40.2, 24.25, 25),
+	outline("212", [][2]float64{
+		{22.4, 42.3}, {23, 41.4}, {26.6, 41.7}, {28, 42}, {28.6, 43.7}, {27.5, 44}, {25, 43.7}, {22.7, 44.2},
+		{22.5, 44.5},
+	}),
+	outline("275", [][2]float64{
+		{20.3, 46.1}, {22.9, 48}, {24.9, 47.9}, {26.6, 48.3}, {28.2, 46.5}, {28.2, 45.5}, {29.7, 45.2},
+		{28.6, 43.7}, {27.5, 44}, {25, 43.7}, {22.7, 44.2}, {22.5, 44.5}, {21, 45.1},
+	}),
+	outline("179", [][2]float64{{26.6, 48.3}, {27.6, 48.5}, {29.2, 47.9}, {30.1, 46.4}, {28.2, 45.5}, {28.2, 46.5}}),
+	outline("288", [][2]float64{
+		{22.1, 48.4}, {22.5, 49.1}, {24.1, 50.5}, {23.6, 51.5}, {30.5, 51.5}, {32, 52.3}, {34.4, 51.8},
+		{35.4, 50.6}, {38.2, 50}, {40, 49.6}, {39.7, 47.8}, {38.2, 47.1}, {36.6, 45.3}, {33, 44.4},
+		{30.2, 45.8}, {29.7, 45.2}, {28.2, 45.5}, {30.1, 46.4}, {29.2, 47.9}, {27.6, 48.5}, {26.6, 48.3},
+		{24.9, 47.9}, {22.9, 48},
+	}),
+	outline("27", [][2]float64{
+		{23.2, 52.2}, {23.6, 51.5}, {30.5, 51.5}, {32, 52.3}, {31.8, 53.8}, {30.9, 55.6}, {28.2, 56.1},
+		{26.6, 55.7}, {26.6, 55.2}, {25.8, 54.2}, {23.5, 53.9},
+	}),
+	outline("54", [][2]float64{
+		{28, 59.4}, {27.8, 60.5}, {31.5, 62.9}, {30, 64}, {29.5, 66}, {30, 67}, {28.9, 69}, {30.8, 69.8},
+		{33, 69.4}, {41, 67.5}, {44, 68.5}, {46, 68.5}, {46, 62}, {51, 60}, {52, 56.5}, {53, 54.5},
+		{50.8, 51.5}, {48.5, 49.8}, {46.6, 48.5}, {47.5, 46}, {49, 46.3}, {48, 44.5}, {47.5, 42},
+		{46.5, 41.9}, {43.5, 42.9}, {40, 43.4}, {36.6, 45.3}, {38.2, 47.1}, {39.7, 47.8}, {40, 49.6},
+		{38.2, 50}, {35.4, 50.6}, {34.4, 51.8}, {32, 52.3}, {31.8, 53.8}, {30.9, 55.6}, {28.2, 56.1},
+		{27.4, 57.5},
+	}),
+	outline("54", [][2]float64{{52, 70.5}, {60, 76.9}, {69, 77}, {57, 70.5}}),
+	circle("61", 80.8, 54, 250),
+
+	// Asia
+	outline("15", [][2]float64{
+		{46, 68.5}, {60, 69.5}, {66, 69}, {70, 73}, {80, 73.5}, {95, 76}, {104, 77.7}, {113, 74},
+		{130, 71.5}, {140, 73}, {180, 71}, {180, 65.5}, {170, 60}, {163, 60}, {162.5, 56}, {156.5, 51},
+		{155.5, 57}, {156, 61.7}, {155, 59.5}, {143, 59.4}, {141.5, 59.3}, {141, 53}, {140.5, 48.5},
+		{136, 44.5}, {132, 43}, {130.6, 42.4}, {131.2, 43.4}, {135, 48.4}, {127.5, 49.8}, {121, 53.3},
+		{120, 53.3}, {116, 49.9}, {107, 50.3}, {102, 51.6}, {98.2, 52}, {98, 50.2}, {92, 50.7},
+		{87.8, 49.2}, {87.3, 49.1}, {81, 50.8}, {76.8, 54}, {73, 54}, {69, 55.4}, {65, 54.5}, {61, 53.9},
+		{61.5, 50.8}, {55, 50.5}, {50.8, 51.5}, {53, 54.5}, {52, 56.5}, {51, 60}, {46, 62},
+	}),
+	outline("15", [][2]float64{{-180, 65.5}, {-180, 71.5}, {-175, 67.5}, {-169.7, 66}, {-172.5, 64.3}, {-180, 65}}),
+	outline("15", [][2]float64{{142, 46}, {143.5, 46.5}, {144.5, 49}, {143, 54.4}, {142.2, 54}, {142, 49}}),
+	outline("15", [][2]float64{{145.5, 43.5}, {156.5, 50.9}, {155.8, 51.2}, {145, 44}}),
+	outline("390", [][2]float64{
+		{26, 40}, {26.2, 41.8}, {28, 42}, {29, 41.2}, {31, 41.1}, {35, 42}, {38, 41}, {41.5, 41.5},
+		{42.8, 41.6}, {43.6, 41.1}, {44.8, 39.7}, {44.3, 37.2}, {42.4, 37.1}, {41.2, 37.1}, {38.8, 36.7},
+		{36.6, 36.9}, {36.1, 35.8}, {35.9, 36.9}, {32.5, 36.1}, {30.6, 36.6}, {28.2, 36.7}, {26.5, 38.3},
+	}),
+	circle("215", 35.1, 33.4, 110),
+	circle("283", 34.6, 32.98, 10),
+	circle("283", 35, 33.7, 10),
+	outline("75", [][2]float64{{40, 43.4}, {43.5, 42.9}, {46.5, 41.9}, {45, 41.2}, {43.6, 41.1}, {42.8, 41.6}, {41.5, 41.5}}),
+	outline("14", [][2]float64{{43.6, 41.1}, {45, 41.2}, {45.6, 40.5}, {46.6, 39.2}, {46.1, 38.85}, {44.8, 39.7}}),
+	outline("18", [][2]float64{
+		{45, 41.2}, {46.5, 41.9}, {47.5, 42}, {48.6, 41.8}, {49.5, 40.5}, {48.9, 38.4}, {46.1, 38.85},
+		{46.6, 39.2}, {45.6, 40.5},
+	}),
+	outline("130", [][2]float64{
+		{46.6, 48.5}, {48.5, 49.8}, {50.8, 51.5}, {55, 50.5}, {61.5, 50.8}, {61, 53.9}, {65, 54.5},
+		{69, 55.4}, {73, 54}, {76.8, 54}, {81, 50.8}, {87.3, 49.1}, {85.5, 47}, {82.5, 45.5}, {80.2, 42.2},
+		{79.2, 42.8}, {74.3, 43.2}, {71, 42.3}, {68, 40.8}, {66, 42.9}, {64.5, 43.6}, {62, 43.5},
+		{61, 44.4}, {58.6, 45.6}, {56, 45}, {53, 42.3}, {52.5, 42.8}, {51.3, 43.2}, {51, 44.5}, {53, 45.3},
+		{53, 46.8}, {49, 46.3}, {47.5, 46},
+	}),
+	outline("292", [][2]float64{
+		{56, 45}, {58.6, 45.6}, {61, 44.4}, {62, 43.5}, {64.5, 43.6}, {66, 42.9}, {68, 40.8}, {71, 42.3},
+		{73, 40.8}, {71, 40.2}, {70.5, 41}, {68.4, 38.2}, {67.8, 37.2}, {66.5, 37.4}, {64.4, 38.9},
+		{62, 40.4}, {61, 41.2}, {58.6, 42.8}, {56, 41.3},
+	}),
+	outline("280", [][2]float64{
+		{52.5, 41.8}, {53, 42.3}, {56, 41.3}, {58.6, 42.8}, {61, 41.2}, {62, 40.4}, {64.4, 38.9},
+		{66.5, 37.4}, {64.8, 37.1}, {62.5, 35.3}, {61.2, 36.6}, {53.9, 37.3},
+	}),
+	outline("135", [][2]float64{
+		{69.3, 40}, {71, 40.2}, {73, 40.8}, {71, 42.3}, {74.3, 43.2}, {79.2, 42.8}, {80.2, 42.2}, {75, 40.5},
+		{73.6, 39.5},
+	}),
+	outline("262", [][2]float64{
+		{67.8, 37.2}, {68.4, 38.2}, {70.5, 41}, {71, 40.2}, {69.3, 40}, {73.6, 39.5}, {75, 38.4}, {74.9, 37.2},
+		{71.5, 37}, {70.9, 38.4},
+	}),
+	outline("363", [][2]float64{
+		{87.8, 49.2}, {92, 50.7}, {98, 50.2}, {98.2, 52}, {102, 51.6}, {107, 50.3}, {116, 49.9}, {119.9, 46.7},
+		{111.9, 43.6}, {105, 41.6}, {96.4, 42.7}, {90.7, 45.5},
+	}),
+	outline("318", [][2]float64{
+		{73.5, 39.5}, {75, 40.5}, {80.2, 42.2}, {82.5, 45.5}, {85.5, 47}, {87.3, 49.1}, {87.8, 49.2},
+		{90.7, 45.5}, {96.4, 42.7}, {105, 41.6}, {111.9, 43.6}, {119.9, 46.7}, {116, 49.9}, {120, 53.3},
+		{121, 53.3}, {127.5, 49.8}, {135, 48.4}, {131.2, 43.4}, {130.6, 42.4}, {129.7, 42.4}, {128, 42},
+		{125, 41.2}, {124.4, 40}, {121.5, 38.7}, {119, 37.3}, {122.7, 37.4}, {120.5, 34.4}, {122, 31.5},
+		{122.2, 29.5}, {119.8, 25.5}, {117.3, 23.5}, {114.2, 22.2}, {111, 21.3}, {110.2, 20.2},
+		{108.6, 21.6}, {108, 21.5}, {106.7, 22.8}, {105.3, 23.3}, {103.9, 22.5}, {102.2, 22.4},
+		{101.2, 21.2}, {100.1, 21.5}, {99.2, 22.1}, {97.5, 24}, {98.5, 26}, {97.3, 28.2}, {92, 27.9},
+		{91.6, 27.9}, {89.6, 28.2}, {88.8, 27.1}, {88.2, 27.9}, {86, 28}, {84, 28.9}, {81.5, 30.4},
+		{80.5, 30.3}, {79, 32.5}, {79, 34.3}, {77.8, 35.5}, {74.9, 37.2}, {75, 38.4}, {73.6, 39.5},
+	}),
+	outline("318", [][2]float64{{108.6, 19.2}, {110.5, 18.2}, {111.1, 19.7}, {110.6, 20.2}, {109.3, 20}}),
+	outline("386", [][2]float64{{120, 23}, {120.7, 21.8}, {121.1, 21.9}, {122.1, 24.6}, {121.6, 25.4}, {120.3, 24.5}}),
+	circle("386", 23.55, 119.6, 35),
+	circle("321", 22.35, 114.15, 25),
+	circle("152", 22.17, 113.55, 5),
+	circle("505", 20.7, 116.7, 10),
+	circle("506", 15.15, 117.76, 10),
+	circle("247", 10, 114, 400),
+	outline("137", [][2]float64{
+		{126.1, 37.7}, {126.7, 37.9}, {128.4, 38.6}, {129.5, 36}, {129.3, 35.2}, {126.3, 34.3}, {126, 35.2},
+	}),
+	circle("137", 33.4, 126.5, 45),
+	outline("344", [][2]float64{
+		{124.4, 40}, {125, 41.2}, {128, 42}, {129.7, 42.4}, {130.6, 42.4}, {129.8, 41}, {128.4, 38.6},
+		{126.7, 37.9}, {126.1, 37.7}, {124.6, 38},
+	}),
+	outline("339", [][2]float64{
+		{129.5, 33.2}, {130, 31.2}, {131.1, 31.4}, {132, 33}, {135, 33.5}, {136.5, 34}, {139, 34.6},
+		{140.9, 35.7}, {141, 38.3}, {142, 39.5}, {141.5, 41.5}, {140, 41.4}, {139.8, 40}, {139.5, 38.3},
+		{136.8, 37.4}, {136, 35.8}, {133, 35.6}, {131, 34.4},
+	}),
+	outline("339", [][2]float64{
+		{140, 41.4}, {141.5, 41.5}, {143.3, 42}, {145.8, 43.3}, {145.2, 44.3}, {141.9, 45.5}, {141, 43.3},
+		{139.8, 42.2},
+	}),
+	circle("339", 26.5, 127.9, 60),
+	circle("339", 24.8, 125.3, 30),
+	circle("339", 24.4, 124, 50),
+	circle("339", 28.3, 129.5, 60),
+	circle("339", 34, 139.5, 60),
+	circle("192", 27.1, 142.2, 60),
+	circle("177", 24.29, 153.98, 5),
+	outline("375", [][2]float64{
+		{120, 18.5}, {122.3, 18.6}, {122.2, 16}, {124.3, 13}, {124, 12.6}, {121.6, 13.8}, {120.6, 14.2},
+		{119.8, 16},
+	}),
+	outline("375", [][2]float64{
+		{121.8, 12.1}, {125.3, 12.5}, {126.6, 7.3}, {125.4, 5.6}, {122, 6.9}, {121.9, 10.5},
+	}),
+	outline("375", [][2]float64{{117, 8}, {119.9, 11.5}, {119.3, 10.2}, {117.9, 8.3}}),
+	outline("293", [][2]float64{
+		{102.2, 22.4}, {103.9, 22.5}, {105.3, 23.3}, {106.7, 22.8}, {108, 21.5}, {106.6, 20.2},
+		{105.6, 18.8}, {107.1, 16.8}, {109.3, 13.5}, {109.2, 11.6}, {105, 8.6}, {104.5, 10.4}, {106, 11},
+		{107.5, 12.3}, {107.6, 14.7}, {107.2, 16.2}, {106.3, 17}, {105.2, 18.6}, {104, 19.3},
+		{104.2, 20.4}, {102.5, 21.7},
+	}),
+	outline("143", [][2]float64{
+		{100.1, 20.4}, {101.2, 21.2}, {102.2, 22.4}, {102.5, 21.7}, {104.2, 20.4}, {104, 19.3},
+		{105.2, 18.6}, {106.3, 17}, {107.2, 16.2}, {107.6, 14.7}, {106, 14.4}, {105.5, 14.2},
+		{105.6, 15.8}, {104.7, 17.5}, {102.8, 17.9}, {101, 17.8}, {100.6, 19.5},
+	}),
+	outline("312", [][2]float64{
+		{102.3, 13.6}, {103, 14.4}, {105.5, 14.2}, {106, 14.4}, {107.6, 14.7}, {107.5, 12.3}, {106, 11},
+		{104.5, 10.4}, {102.9, 11.6},
+	}),
+	outline("387", [][2]float64{
+		{98.5, 19.7}, {100.1, 20.4}, {100.6, 19.5}, {101, 17.8}, {102.8, 17.9}, {104.7, 17.5},
+		{105.6, 15.8}, {105.5, 14.2}, {103, 14.4}, {102.3, 13.6}, {102.9, 11.6}, {100.9, 12.7},
+		{100, 13.4}, {99.2, 10}, {100.4, 7.2}, {101.2, 6.8}, {102.1, 6.2}, {101, 5.7}, {100.1, 6.5},
+		{99.6, 7}, {98.3, 7.8}, {98.5, 10.1}, {99.1, 11}, {98.2, 15.1}, {98.6, 16.1}, {97.4, 18.5}, {98, 19.8},
+	}),
+	outline("309", [][2]float64{
+		{92.3, 20.7}, {92.7, 22}, {93.3, 24}, {94.5, 26.6}, {97.3, 28.2}, {98.5, 26}, {97.5, 24},
+		{99.2, 22.1}, {100.1, 21.5}, {100.1, 20.4}, {98.5, 19.7}, {98, 19.8}, {97.4, 18.5}, {98.6, 16.1},
+		{98.2, 15.1}, {99.1, 11}, {98.5, 10.1}, {98.2, 10.1}, {97.6, 16.5}, {94.3, 16}, {94.4, 18.8},
+	}),
+	outline("299", [][2]float64{
+		{100.1, 6.5}, {101, 5.7}, {102.1, 6.2}, {103.4, 4.5}, {104.3, 1.5}, {103.5, 1.3}, {101.3, 2.8},
+		{100.3, 5.3},
+	}),
+	circle("381", 1.35, 103.82, 20),
+	outline("46", [][2]float64{
+		{109.6, 1.9}, {111.2, 2.5}, {113, 3.2}, {114, 4.6}, {115.4, 4.9}, {116.2, 6.9}, {117.7, 6.4},
+		{119.3, 5.2}, {117.9, 4.2}, {115.8, 4.3}, {115.5, 3}, {114.6, 1.4}, {112.5, 1.5}, {111, 1}, {109.6, 1.4},
+	}),
+	circle("345", 4.6, 114.7, 40),
+	outline("327", [][2]float64{
+		{95.2, 5.6}, {97.5, 5.2}, {100.3, 2.3}, {104.5, -1.5}, {106, -3.2}, {105.9, -5.8}, {104.5, -5.9},
+		{101, -2.7}, {98.7, 1.5}, {96.2, 2.4},
+	}),
+	outline("327", [][2]float64{
+		{105.2, -6.8}, {106, -5.9}, {108.3, -6.2}, {111, -6.4}, {112.7, -6.9}, {114.6, -7.7}, {114.4, -8.7},
+		{111, -8.2}, {108, -7.8}, {106.4, -7.3},
+	}),
+	outline("327", [][2]float64{
+		{108.9, -1}, {109.6, 1.9}, {109.6, 1.4}, {111, 1}, {112.5, 1.5}, {114.6, 1.4}, {115.5, 3},
+		{115.8, 4.3}, {117.9, 4.2}, {118.9, 1.2}, {117.5, 0.2}, {116.5, -1.8}, {116, -3.8}, {114.5, -4},
+		{111, -3.5}, {110.1, -2.5},
+	}),
+	outline("327", [][2]float64{
+		{118.8, -5.6}, {118.8, -2.6}, {120.1, 0.7}, {125.2, 1.6}, {122, -1}, {123.3, -4.5}, {121, -5.7},
+	}),
+	outline("327", [][2]float64{
+		{114.4, -8}, {116, -8.1}, {119, -8.2}, {122.8, -8.2}, {124, -8.3}, {124.5, -9}, {124, -10.4},
+		{119, -10}, {114.4, -8.8},
+	}),
+	outline("327", [][2]float64{
+		{131, -1}, {134.2, -0.8}, {137.8, -1.5}, {141, -2.6}, {141, -9.1}, {138.7, -8.3}, {136, -4.6},
+		{132, -3}, {130, -4},
+	}),
+	circle("327", -3.5, 128.3, 150),
+	circle("327", 1, 127.8, 150),
+	outline("511", [][2]float64{{124.05, -9.3}, {125, -8.4}, {127.3, -8.4}, {125, -9.5}}),
+	outline("163", [][2]float64{
+		{141, -2.6}, {144, -3.5}, {146, -5.5}, {147.9, -6.1}, {148.2, -8.6}, {150.8, -10.7}, {147.2, -9.6}, {146.5, -8.9},
+		{143.3, -9}, {141, -9.1},
+	}),
+	circle("163", -5.5, 151, 300),
+	circle("163", -6, 155.2, 130),
+	circle("163", -2.1, 147, 60),
+	outline("185", [][2]float64{{155.5, -6.5}, {160, -7.5}, {162.4, -10.8}, {159.5, -11.3}, {155.8, -8}}),
+	circle("507", -10.7, 166, 120),
+
+	// Middle East and South Asia
+	outline("336", [][2]float64{
+		{34.2, 31.3}, {34.9, 32.8}, {35.1, 33.1}, {35.8, 33.3}, {35.6, 32.7}, {35.55, 32.4}, {35, 32.5},
+		{34.95, 31.8}, {34.95, 31.35}, {35.5, 31.5}, {35.4, 30.9}, {35, 29.5}, {34.9, 29.5},
+	}),
+	outline("510", [][2]float64{{34.95, 31.35}, {35.55, 31.5}, {35.55, 32.4}, {35, 32.5}, {34.95, 31.8}}),
+	outline("510", [][2]float64{{34.2, 31.3}, {34.5, 31.6}, {34.55, 31.5}, {34.27, 31.22}}),
+	outline("342", [][2]float64{
+		{35, 29.5}, {35.4, 30.9}, {35.55, 31.5}, {35.6, 32.7}, {36.8, 32.3}, {39.2, 32.2}, {37, 31.5},
+		{38, 30.5}, {37.5, 30}, {36.1, 29.2},
+	}),
+	outline("354", [][2]float64{{35.1, 33.1}, {35.6, 34.6}, {36.6, 34.6}, {36.1, 33.8}, {35.8, 33.3}}),
+	outline("384", [][2]float64{
+		{35.6, 34.6}, {35.8, 35.8}, {36.1, 35.8}, {36.6, 36.9}, {38.8, 36.7}, {41.2, 37.1}, {42.4, 37.1},
+		{41.2, 34.4}, {38.8, 33.4}, {36.8, 32.3}, {35.8, 33.3}, {36.1, 33.8}, {36.6, 34.6},
+	}),
+	outline("333", [][2]float64{
+		{38.8, 33.4}, {41.2, 34.4}, {42.4, 37.1}, {44.3, 37.2}, {45.5, 35.5}, {46, 33}, {48.5, 30},
+		{47.7, 30.1}, {47, 29.1}, {46.5, 29.1}, {44.7, 29.2}, {42, 31.1}, {39.2, 32.2},
+	}),
+	outline("348", [][2]float64{{46.5, 29.1}, {47.7, 30.1}, {48.1, 29.9}, {48.4, 28.5}}),
+	outline("378", [][2]float64{
+		{34.6, 28.1}, {36.1, 29.2}, {37.5, 30}, {38, 30.5}, {37, 31.5}, {39.2, 32.2}, {42, 31.1},
+		{44.7, 29.2}, {46.5, 29.1}, {48.4, 28.5}, {50, 26.7}, {50.8, 24.8}, {51.6, 24.3}, {52, 23},
+		{55.2, 22.7}, {55.7, 22}, {55, 20}, {52, 19}, {49, 18.6}, {47, 17}, {46.3, 17.3}, {44, 17.4},
+		{43.3, 16.7}, {42.8, 16.4}, {40, 20}, {38.5, 23.5}, {36.6, 26}, {35.2, 28},
+	}),
+	outline("492", [][2]float64{
+		{42.8, 16.4}, {43.3, 16.7}, {44, 17.4}, {46.3, 17.3}, {47, 17}, {49, 18.6}, {52, 19}, {53.1, 16.6},
+		{52.2, 15.6}, {49.6, 14.6}, {45.1, 12.9}, {43.5, 12.6}, {43.2, 13.3},
+	}),
+	circle("492", 12.5, 54, 90),
+	outline("370", [][2]float64{
+		{52, 19}, {55, 20}, {55.7, 22}, {55.2, 22.7}, {56.4, 24.9}, {57.2, 23.9}, {59.8, 22.5}, {58.5, 20.4},
+		{57.7, 19}, {56.8, 18.7}, {55, 17}, {53.1, 16.6},
+	}),
+	circle("370", 26.2, 56.3, 35),
+	outline("391", [][2]float64{{51.6, 24.3}, {52, 23}, {55.2, 22.7}, {56.4, 24.9}, {56.1, 26.1}, {55.5, 25.5}, {54.2, 24.2}}),
+	outline("376", [][2]float64{{50.75, 24.55}, {51.6, 24.6}, {51.6, 26.2}, {50.95, 26.1}}),
+	circle("304", 26.05, 50.55, 25),
+	outline("330", [][2]float64{
+		{44.8, 39.7}, {46.1, 38.85}, {48.9, 38.4}, {49, 37.5}, {53.9, 37.3}, {61.2, 36.6}, {60.6, 33.5},
+		{60.9, 31.5}, {61.8, 31}, {61, 29.8}, {62.8, 28.2}, {61.6, 25.2}, {57.3, 25.8}, {56.3, 27.1},
+		{54.2, 26.7}, {51.4, 27.9}, {50, 30}, {48.6, 29.9}, {48.5, 30}, {46, 33}, {45.5, 35.5}, {44.3, 37.2},
+	}),
+	outline("3", [][2]float64{
+		{61.2, 36.6}, {62.5, 35.3}, {64.8, 37.1}, {66.5, 37.4}, {67.8, 37.2}, {70.9, 38.4}, {74.9, 37.2},
+		{71.5, 36.5}, {70, 34}, {69, 31}, {66.5, 29.5}, {61, 29.8}, {61.8, 31}, {60.9, 31.5}, {60.6, 33.5},
+	}),
+	outline("372", [][2]float64{
+		{61.6, 25.2}, {66.6, 25}, {67.2, 24.6}, {68.2, 23.7}, {71, 24.3}, {70.5, 27.9}, {73.5, 29.9}, {74.5, 31},
+		{75, 32.5}, {77.8, 35.5}, {74.9, 37.2}, {71.5, 36.5}, {70, 34}, {69, 31}, {66.5, 29.5}, {61, 29.8},
+		{62.8, 28.2},
+	}),
+	outline("324", [][2]float64{
+		{68.2, 23.7}, {71, 24.3}, {70.5, 27.9}, {73.5, 29.9}, {74.5, 31}, {75, 32.5}, {77.8, 35.5},
+		{79, 34.3}, {79, 32.5}, {80.5, 30.3}, {80.1, 28.8}, {81.3, 27.3}, {83.3, 27.3}, {85, 26.6},
+		{88.1, 26.4}, {88.2, 27.9}, {88.8, 27.1}, {92.1, 26.8}, {91.6, 27.9}, {92, 27.9}, {97.3, 28.2},
+		{94.5, 26.6}, {93.3, 24}, {92.7, 22}, {92.3, 25}, {89.8, 26}, {88.5, 26.5}, {88, 24.3}, {89, 21.7},
+		{87, 21.5}, {85, 19.5}, {82, 16.5}, {80.3, 15.5}, {80, 13}, {79.8, 10.3}, {77.5, 8.1}, {76.2, 10},
+		{74.8, 13}, {73, 18}, {72.6, 21.5}, {70, 22.4},
+	}),
+	outline("369", [][2]float64{
+		{80.1, 28.8}, {80.5, 29.8}, {81.5, 30.4}, {84, 28.9}, {86, 28}, {88.2, 27.9}, {88.1, 26.4}, {85, 26.6},
+		{83.3, 27.3}, {81.3, 27.3},
+	}),
+	outline("306", [][2]float64{{88.8, 27.1}, {89.6, 28.2}, {91.6, 27.9}, {92.1, 26.8}}),
+	outline("305", [][2]float64{{88, 24.3}, {88.5, 26.5}, {89.8, 26}, {92.3, 25}, {92.6, 22}, {92.3, 20.7}, {91, 22}, {89, 21.7}}),
+	circle("315", 7.8, 80.7, 230),
+	circle("159", 3.2, 73.2, 450),
+	circle("142", 10.5, 72.7, 200),
+	circle("11", 10.3, 93, 430),
+
+	// Africa
+	outline("446", [][2]float64{
+		{-5.9, 35.8}, {-2.2, 35.1}, {-1.7, 34.7}, {-1.2, 32.1}, {-3.6, 31.6}, {-5, 29.9}, {-8.7, 28.7},
+		{-8.7, 27.7}, {-13.2, 27.7}, {-11.5, 28.3}, {-9.8, 30.5}, {-9.3, 32.5}, {-6.8, 34.1},
+	}),
+	outline("302", [][2]float64{
+		{-13.2, 27.7}, {-8.7, 27.7}, {-8.7, 26}, {-12, 26}, {-12, 23.5}, {-13, 21.3}, {-17, 21}, {-16, 23.8},
+		{-14.5, 26.1},
+	}),
+	outline("400", [][2]float64{
+		{-2.2, 35.1}, {0, 35.9}, {3, 36.9}, {6, 37.1}, {8.6, 36.9}, {8.4, 36.5}, {8.3, 34.6}, {8.1, 33.1}, {9.5, 30.2}, {9.5, 26.4},
+		{12, 23.5}, {5.8, 19.4}, {4.2, 19.1}, {3.2, 19}, {-4.8, 25}, {-8.7, 27.3}, {-8.7, 28.7}, {-5, 29.9},
+		{-3.6, 31.6}, {-1.2, 32.1}, {-1.7, 34.7},
+	}),
+	outline("474", [][2]float64{
+		{8.4, 36.5}, {8.6, 36.9}, {10.2, 37.3}, {11.1, 36.9}, {10.2, 34.2}, {11.6, 33.1}, {10.2, 30.5},
+		{9.5, 30.2}, {8.1, 33.1}, {8.3, 34.6},
+	}),
+	outline("436", [][2]float64{
+		{11.6, 33.1}, {15.2, 32.4}, {20.1, 32}, {23.2, 32.5}, {25.1, 31.6}, {25, 22}, {25, 20}, {24, 19.5},
+		{16, 23.5}, {14, 23}, {12, 23.5}, {9.5, 26.4}, {9.5, 30.2}, {10.2, 30.5},
+	}),
+	outline("478", [][2]float64{
+		{25.1, 31.6}, {29, 30.9}, {32.3, 31.3}, {34.2, 31.3}, {34.9, 29.5}, {34.3, 27.7}, {33, 28.5},
+		{33.5, 27}, {35.6, 23.1}, {36.9, 22}, {31.4, 22}, {25, 22},
+	}),
+	outline("466", [][2]float64{
+		{25, 22}, {31.4, 22}, {36.9, 22}, {38.6, 18}, {37.4, 17}, {36.4, 14.3}, {35.9, 12.5}, {35, 10.5},
+		{34.1, 10.3}, {33.2, 10.2}, {31.4, 9.8}, {27.3, 9.6}, {24, 8.7}, {22.9, 10.9}, {22, 12.9},
+		{23.5, 15.7}, {24, 19.5}, {25, 20},
+	}),
+	outline("521", [][2]float64{
+		{24, 8.7}, {27.4, 5}, {30.8, 3.5}, {33.9, 3.9}, {34, 4.2}, {35.9, 4.6}, {34, 8.6}, {34.1, 10.3},
+		{33.2, 10.2}, {31.4, 9.8}, {27.3, 9.6},
+	}),
+	outline("51", [][2]float64{{36.4, 14.3}, {37.4, 17}, {38.6, 18}, {39.3, 15.9}, {43.1, 12.7}, {41.7, 13.9}, {40, 14.5}, {36.6, 14.5}}),
+	outline("53", [][2]float64{
+		{36.4, 14.3}, {36.6, 14.5}, {40, 14.5}, {41.7, 13.9}, {42.4, 12.5}, {41.8, 11}, {42.8, 10.9},
+		{44, 9}, {48, 8}, {45, 5}, {41.9, 4}, {40.9, 4}, {39, 3.5}, {35.9, 4.6}, {34, 8.6}, {34.1, 10.3},
+		{35, 10.5}, {35.9, 12.5},
+	}),
+	outline("382", [][2]float64{{41.8, 11}, {42.4, 12.5}, {43.4, 12.1}, {43.2, 11.4}, {42.8, 10.9}}),
+	outline("232", [][2]float64{
+		{43.2, 11.4}, {51.3, 11.8}, {51, 10.4}, {48, 4.5}, {43.5, 0.2}, {41, -1.6}, {41, 2.8}, {41.9, 4},
+		{45, 5}, {48, 8}, {44, 9}, {42.8, 10.9},
+	}),
+	outline("430", [][2]float64{
+		{34, 4.2}, {35.9, 4.6}, {39, 3.5}, {40.9, 4}, {41.9, 4}, {41, 2.8}, {41, -1.6}, {39.2, -4.7},
+		{37.6, -3}, {33.9, -1}, {34, 0.5}, {35, 1.5},
+	}),
+	outline("286", [][2]float64{
+		{29.6, -1.4}, {29.9, 0.5}, {31.2, 2.2}, {30.8, 3.5}, {33.9, 3.9}, {35, 1.5}, {34, 0.5}, {33.9, -1}, {30.5, -1},
+	}),
+	outline("454", [][2]float64{{28.9, -2.4}, {29.6, -1.4}, {30.5, -1}, {30.9, -2.4}, {29.4, -2.8}}),
+	outline("404", [][2]float64{{29, -2.7}, {29.4, -2.8}, {30.9, -2.4}, {30.4, -3.5}, {29.5, -4.5}, {29.2, -3.3}}),
+	outline("470", [][2]float64{
+		{30.4, -3.5}, {30.9, -2.4}, {30.5, -1}, {33.9, -1}, {37.6, -3}, {39.2, -4.7}, {39.9, -6.5},
+		{39.3, -8}, {40.4, -10.5}, {37.5, -11.6}, {34.6, -11.5}, {33.9, -9.7}, {32.9, -9.4}, {30.5, -8.3},
+		{29.5, -5}, {29.5, -4.5},
+	}),
+	outline("440", [][2]float64{
+		{32.9, -9.4}, {33.9, -9.7}, {34.6, -11.5}, {34.5, -12.5}, {35.3, -14.5}, {35.9, -16.1}, {35.1, -17.1},
+		{34.3, -15.5}, {33.2, -14}, {32.7, -13.6}, {33.4, -11},
+	}),
+	outline("181", [][2]float64{
+		{34.6, -11.5}, {37.5, -11.6}, {40.4, -10.5}, {40.8, -14.5}, {39, -17}, {35.3, -21.5}, {35.5, -24},
+		{32.9, -26.8}, {32, -25.9}, {31.9, -24.5}, {31.3, -22.4}, {32.5, -20}, {33, -17}, {30.4, -15.6},
+		{30.2, -14.9}, {33.2, -14}, {34.3, -15.5}, {35.1, -17.1}, {35.9, -16.1}, {35.3, -14.5}, {34.5, -12.5},
+	}),
+	outline("482", [][2]float64{
+		{22, -13}, {24, -11}, {25.4, -11.3}, {27.4, -12}, {28.5, -12.7}, {29.8, -13.4}, {29.6, -12.2},
+		{28.9, -8.5}, {30.5, -8.3}, {32.9, -9.4}, {33.4, -11}, {32.7, -13.6}, {33.2, -14}, {30.2, -14.9},
+		{30.4, -15.6}, {28, -16.8}, {25.2, -17.8}, {23.4, -17.6}, {22, -16.2},
+	}),
+	outline("414", [][2]float64{
+		{12.2, -6}, {13.2, -5.9}, {16.3, -5.9}, {16.6, -7.5}, {18.9, -8}, {21.8, -7.3}, {22.3, -11},
+		{24, -11}, {25.4, -11.3}, {27.4, -12}, {28.5, -12.7}, {29.8, -13.4}, {29.6, -12.2}, {28.9, -8.5},
+		{30.5, -8.3}, {29.5, -5}, {29.2, -3.3}, {29, -2.7}, {28.9, -2.4}, {29.6, -1.4}, {29.9, 0.5},
+		{31.2, 2.2}, {30.8, 3.5}, {27.4, 5}, {25, 5}, {22.4, 4.1}, {18.6, 3.5}, {17.2, -0.3}, {16.2, -2.2},
+		{15.3, -4.3}, {13.1, -4.7}, {12.4, -5},
+	}),
+	outline("412", [][2]float64{
+		{11.1, -3.9}, {12.4, -5}, {13.1, -4.7}, {15.3, -4.3}, {16.2, -2.2}, {17.2, -0.3}, {18.6, 3.5},
+		{16.2, 3.6}, {16.1, 2.2}, {14.2, 1.5}, {13.3, 2.2}, {14.4, -0.1}, {13, -2.4}, {11.9, -3.3},
+	}),
+	outline("420", [][2]float64{
+		{9.3, 1}, {11.3, 1}, {11.3, 2.3}, {13.3, 2.2}, {14.2, 1.5}, {14.4, -0.1}, {13, -2.4}, {11.9, -3.3},
+		{11.1, -3.9}, {9.4, -2.4}, {8.7, -0.7},
+	}),
+	outline("49", [][2]float64{{9.3, 1}, {11.3, 1}, {11.3, 2.3}, {9.8, 2.3}}),
+	circle("49", 3.5, 8.7, 40),
+	circle("195", -1.43, 5.63, 5),
+	circle("219", 0.9, 7, 90),
+	outline("406", [][2]float64{
+		{8.5, 4.5}, {9.8, 2.3}, {11.3, 2.3}, {13.3, 2.2}, {16.1, 2.2}, {16.2, 3.6}, {15, 6}, {14.4, 9},
+		{15.7, 10}, {14.5, 12.7}, {14.1, 13.1}, {14, 12}, {13.3, 10.8}, {12, 8}, {10.6, 7}, {9.6, 6.5}, {8.6, 4.9},
+	}),
+	outline("450", [][2]float64{
+		{2.7, 6.4}, {2.7, 9}, {3.6, 10.3}, {3.6, 11.7}, {4.1, 13.5}, {6.9, 13.1}, {9.6, 12.8}, {12.5, 13.1},
+		{14.1, 13.1}, {14, 12}, {13.3, 10.8}, {12, 8}, {10.6, 7}, {9.6, 6.5}, {8.6, 4.9}, {6, 4.3}, {4.5, 6.3},
+	}),
+	outline("187", [][2]float64{
+		{0.2, 14.9}, {1.3, 15.3}, {3.6, 15.5}, {4.2, 19.1}, {5.8, 19.4}, {12, 23.5}, {14, 23}, {15.5, 20.9},
+		{15.6, 16.3}, {13.6, 13.7}, {12.5, 13.1}, {9.6, 12.8}, {6.9, 13.1}, {4.1, 13.5}, {3.6, 11.7},
+		{2.4, 12}, {0.9, 13},
+	}),
+	outline("410", [][2]float64{
+		{13.6, 13.7}, {15.6, 16.3}, {15.5, 20.9}, {14, 23}, {16, 23.5}, {24, 19.5}, {23.5, 15.7}, {22, 12.9},
+		{22.9, 10.9}, {21.7, 10.6}, {19, 9}, {15.5, 7.5}, {14.4, 9}, {15.7, 10}, {14.5, 12.7}, {14.1, 13.1},
+	}),
+	outline("408", [][2]float64{
+		{15, 6}, {16.2, 3.6}, {18.6, 3.5}, {22.4, 4.1}, {25, 5}, {27.4, 5}, {24, 8.7}, {22.9, 10.9},
+		{21.7, 10.6}, {19, 9}, {15.5, 7.5},
+	}),
+	outline("416", [][2]float64{{1.6, 6.2}, {2.7, 6.4}, {2.7, 9}, {3.6, 10.3}, {3.6, 11.7}, {2.4, 12}, {0.9, 11}, {1.6, 9.3}}),
+	outline("483", [][2]float64{{-0.1, 11.1}, {0.9, 11}, {1.6, 9.3}, {1.6, 6.2}, {1.2, 6.1}, {0.1, 8.2}}),
+	outline("424", [][2]float64{
+		{-3.2, 4.9}, {-1, 4.7}, {1.2, 5.9}, {1.2, 6.1}, {0.1, 8.2}, {-0.1, 11.1}, {-2.8, 11}, {-2.9, 9.5}, {-2.6, 8.1}, {-3.1, 5.1},
+	}),
+	outline("428", [][2]float64{
+		{-7.5, 4.4}, {-3.2, 5}, {-3.1, 5.1}, {-2.6, 8.1}, {-2.9, 9.5}, {-2.8, 11}, {-5.5, 10.4}, {-8.2, 10.5},
+		{-8.5, 7.6}, {-7.3, 5.9},
+	}),
+	outline("434", [][2]float64{{-11.5, 6.9}, {-7.5, 4.4}, {-7.3, 5.9}, {-8.5, 7.6}, {-9.6, 8.5}, {-10.3, 8.5}}),
+	outline("458", [][2]float64{{-13.3, 8.5}, {-11.5, 6.9}, {-10.3, 8.5}, {-10.7, 9.3}, {-11.2, 10}, {-12.7, 9.9}}),
+	outline("107", [][2]float64{
+		{-15, 10.9}, {-13.3, 8.5}, {-12.7, 9.9}, {-11.2, 10}, {-10.7, 9.3}, {-10.3, 8.5}, {-9.6, 8.5},
+		{-8.5, 7.6}, {-8.2, 10.5}, {-7.9, 10.2}, {-8.6, 11.5}, {-11.4, 12.4}, {-13.7, 12.7}, {-13.7, 11.5},
+	}),
+	outline("109", [][2]float64{{-16.7, 12.4}, {-13.7, 12.7}, {-13.7, 11.5}, {-15, 10.9}, {-16.6, 11.9}}),
+	outline("456", [][2]float64{
+		{-17.5, 14.7}, {-16.7, 12.4}, {-13.7, 12.7}, {-11.4, 12.4}, {-12.2, 14.7}, {-16.5, 16.2},
+	}),
+	outline("422", [][2]float64{{-16.8, 13.8}, {-13.8, 13.6}, {-13.8, 13.3}, {-16.8, 13.1}}),
+	outline("444", [][2]float64{
+		{-17, 21}, {-13, 21.3}, {-12, 23.5}, {-12, 26}, {-8.7, 26}, {-8.7, 27.3}, {-4.8, 25}, {-5.5, 15.5},
+		{-11.4, 15.6}, {-12.2, 14.7}, {-16.5, 16.2}, {-16.5, 19.6},
+	}),
+	outline("442", [][2]float64{
+		{-12.2, 14.7}, {-11.4, 15.6}, {-5.5, 15.5}, {-4.8, 25}, {1.1, 21}, {4.2, 19.1}, {3.6, 15.5},
+		{1.3, 15.3}, {0.2, 14.9}, {-0.5, 15.1}, {-2, 14.2}, {-5.3, 11.1}, {-5.5, 10.4}, {-7.9, 10.2},
+		{-8.6, 11.5}, {-11.4, 12.4},
+	}),
+	outline("480", [][2]float64{
+		{-5.5, 10.4}, {-2.8, 11}, {-0.1, 11.1}, {0.9, 11}, {2.4, 12}, {0.9, 13}, {0.2, 14.9}, {-0.5, 15.1},
+		{-2, 14.2}, {-5.3, 11.1},
+	}),
+	circle("409", 16, -24, 250),
+	outline("401", [][2]float64{
+		{11.7, -17.25}, {13.4, -17}, {20.5, -17.8}, {23.4, -17.6}, {22, -16.2}, {22, -13}, {24, -11},
+		{22.3, -11}, {21.8, -7.3}, {18.9, -8}, {16.6, -7.5}, {16.3, -5.9}, {12.2, -6}, {13.3, -8.5},
+		{13.6, -11.8}, {12, -15},
+	}),
+	outline("401", [][2]float64{{12, -4.4}, {12.8, -4.4}, {13.1, -4.7}, {12.4, -5}, {12.2, -5.8}, {11.8, -5}}),
+	outline("464", [][2]float64{
+		{11.7, -17.25}, {13.4, -17}, {20.5, -17.8}, {23.4, -17.6}, {25.2, -17.8}, {24.2, -17.5}, {21, -18.3},
+		{20, -22}, {20, -24.8}, {20, -28.4}, {16.4, -28.6}, {15.1, -27}, {14.4, -22.9},
+	}),
+	outline("402", [][2]float64{
+		{20, -22}, {21, -18.3}, {24.2, -17.5}, {25.2, -17.8}, {27.5, -20.5}, {29, -22.2}, {27, -23.6},
+		{25.6, -25.5}, {22.8, -25.8}, {20.8, -26.9}, {20, -24.8},
+	}),
+	outline("452", [][2]float64{
+		{25.2, -17.8}, {28, -16.8}, {30.4, -15.6}, {33, -17}, {32.5, -20}, {31.3, -22.4}, {29, -22.2}, {27.5, -20.5},
+	}),
+	outline("462", [][2]float64{
+		{16.4, -28.6}, {20, -28.4}, {20, -24.8}, {20.8, -26.9}, {22.8, -25.8}, {25.6, -25.5}, {27, -23.6},
+		{29, -22.2}, {31.3, -22.4}, {31.9, -24.5}, {32, -25.9}, {32.9, -26.8}, {32.4, -28.6}, {31, -29.9},
+		{28, -32.8}, {25.6, -34}, {22, -34.4}, {18.5, -34.4}, {17.9, -32},
+	}),
+	outline("432", [][2]float64{{27, -29.7}, {27.4, -29.25}, {28.5, -28.6}, {29.4, -29.4}, {27.8, -30.6}}),
+	circle("468", -26.5, 31.5, 70),
+	circle("201", -46.9, 37.75, 20),
+	outline("438", [][2]float64{
+		{49.3, -12}, {50.5, -15.5}, {49.8, -16.8}, {47.5, -24.8}, {45.2, -25.6}, {43.6, -23.5}, {43.3, -21},
+		{44.4, -17}, {47, -15.5}, {48, -13.5},
+	}),
+	circle("411", -11.9, 43.7, 120),
+	circle("169", -12.8, 45.15, 25),
+	circle("453", -21.1, 55.5, 40),
+	circle("165", -20.25, 57.55, 40),
+	circle("207", -19.7, 63.4, 15),
+	circle("4", -10.4, 56.6, 20),
+	circle("4", -16.5, 59.6, 30),
+	circle("276", -15.89, 54.52, 3),
+	circle("99", -11.55, 47.3, 5),
+	circle("124", -17.05, 42.72, 5),
+	circle("124", -22.35, 40.37, 5),
+	circle("379", -4.7, 55.5, 120),
+	circle("379", -6, 53, 250),
+	circle("379", -9.5, 46.5, 60),
+	circle("33", -7, 72, 250),
+	circle("41", -46.4, 51.5, 80),
+	circle("131", -49.3, 69.5, 150),
+	circle("10", -38.3, 77.55, 60),
+	circle("250", -15.96, -5.7, 20),
+	circle("205", -7.95, -14.37, 15),
+	circle("274", -37.1, -12.3, 50),
+	circle("274", -40.3, -9.9, 15),
+
+	// Oceania
+	outline("150", [][2]float64{
+		{113.2, -22}, {114, -26.5}, {115, -34.3}, {118, -35.1}, {123.5, -33.9}, {129, -31.6}, {131.2, -31.5},
+		{134.2, -32.7}, {138, -35.6}, {140.6, -38}, {146.4, -39.1}, {150, -37.5}, {153.6, -28.2},
+		{153.2, -25}, {149.5, -22.3}, {145.3, -15}, {142.5, -10.7}, {141.6, -12.8}, {141.5, -15.1},
+		{140.6, -17.6}, {139.2, -17.3}, {136.5, -15.5}, {137, -12.3}, {136.8, -11.9}, {132.6, -11.4},
+		{130.1, -12.4}, {129, -14.9}, {126.8, -13.7}, {123.4, -16.4}, {122.2, -18}, {119.1, -20}, {114.1, -21.8},
+	}),
+	outline("150", [][2]float64{{144.6, -40.7}, {148.3, -40.9}, {148.3, -42.2}, {146.8, -43.6}, {145.3, -42.4}}),
+	circle("147", -31.55, 159.08, 10),
+	circle("189", -29.03, 167.95, 10),
+	circle("303", -16.29, 149.97, 3),
+	circle("171", -17.41, 155.86, 5),
+	circle("38", -12.17, 96.84, 20),
+	circle("35", -10.49, 105.62, 20),
+	circle("111", -53.1, 73.5, 40),
+	circle("153", -54.6, 158.86, 25),
+	outline("170", [][2]float64{
+		{172.6, -34.4}, {178.6, -37.6}, {177.9, -39.3}, {176.9, -39.6}, {174.8, -41.4}, {174.6, -39.8},
+		{173.8, -39.2}, {174.8, -37.3},
+	}),
+	outline("170", [][2]float64{
+		{172.7, -40.5}, {174.3, -41.7}, {173.1, -43.1}, {173.2, -43.9}, {171.2, -44.5}, {169.7, -46.6}, {168, -47.3},
+		{166.5, -46.1}, {166.5, -45.3}, {168.3, -44}, {171.3, -41.7},
+	}),
+	circle("34", -43.9, -176.5, 60),
+	circle("133", -29.3, -177.9, 80),
+	circle("16", -50.7, 166.1, 40),
+	circle("16", -52.55, 169.15, 20),
+	circle("176", -17.7, 178.2, 300),
+	circle("489", -21.75, 174.63, 5),
+	circle("460", -12.5, 177.07, 15),
+	circle("160", -18.5, -174.8, 370),
+	circle("190", -13.8, -172, 120),
+	circle("9", -14.3, -170.7, 40),
+	circle("515", -11.05, -171.08, 5),
+	circle("188", -19.05, -169.87, 20),
+	circle("270", -9, -171.8, 80),
+	circle("298", -13.8, -177.2, 130),
+	circle("191", -10, -162, 450),
+	circle("234", -20, -159.5, 400),
+	circle("282", -8, 178.5, 400),
+	circle("301", 0.5, 174, 450),
+	circle("490", -0.86, 169.53, 5),
+	circle("31", -3.7, -171.7, 300),
+	circle("48", -1.5, -155.5, 1000),
+	circle("157", -0.53, 166.93, 8),
+	circle("168", 9, 168.5, 700),
+	circle("173", 7, 152, 1100),
+	circle("22", 7.5, 134.5, 200),
+	circle("103", 13.4, 144.8, 30),
+	circle("166", 16.5, 145.7, 300),
+	circle("297", 19.3, 166.6, 10),
+	circle("123", 16.73, -169.53, 10),
+	circle("197", 5.88, -162.08, 15),
+	circle("197", -0.37, -160.02, 10),
+	circle("20", 0.2, -176.5, 25),
+	circle("174", 28.2, -177.37, 15),
+	circle("138", 28.4, -178.3, 10),
+	circle("162", -21.3, 165.5, 250),
+	circle("512", -19.9, 158.3, 80),
+	circle("158", -16.5, 167.5, 450),
+	circle("175", -17, -145, 900),
+	circle("508", -23.5, -148, 400),
+	circle("509", -9.3, -139.8, 250),
+	circle("172", -25.07, -130.1, 15),
+	circle("513", -24.67, -124.78, 5),
+}
@@ -0,0 +1,221 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEntityForLatLong_Cities(t *testing.T) {
+	cases := []struct {
+		name     string
+		lat, lon float64
+		dxcc     string
+		iso2     string
+	}{
+		{"New York", 40.71, -74.0, "291", "US"},
+		{"Denver", 39.74, -104.99, "291", "US"},
+		{"Seattle", 47.61, -122.33, "291", "US"},
+		{"Miami", 25.76, -80.19, "291", "US"},
+		{"Toronto", 43.65, -79.38, "1", "CA"},
+		{"Vancouver", 49.28, -123.12, "1", "CA"},
+		{"Anchorage", 61.22, -149.9, "6", "US"},
+		{"Honolulu", 21.31, -157.86, "110", "US"},
+		{"Mexico City", 19.43, -99.13, "50", "MX"},
+		{"Havana", 23.11, -82.37, "70", "CU"},
+		{"San Juan", 18.47, -66.11, "202", "PR"},
+		{"Bogota", 4.71, -74.07, "116", "CO"},
+		{"Lima", -12.05, -77.04, "136", "PE"},
+		{"Sao Paulo", -23.55, -46.63, "108", "BR"},
+		{"Buenos Aires", -34.6, -58.38, "100", "AR"},
+		{"Santiago", -33.45, -70.67, "112", "CL"},
+		{"Montevideo", -34.9, -56.16, "144", "UY"},
+		{"London", 51.51, -0.13, "223", "GB"},
+		{"Cardiff", 51.48, -3.18, "294", "GB"},
+		{"Edinburgh", 55.95, -3.19, "279", "GB"},
+		{"Belfast", 54.6, -5.93, "265", "GB"},
+		{"Dublin", 53.35, -6.26, "245", "IE"},
+		{"Douglas", 54.15, -4.48, "114", "IM"},
+		{"St Helier", 49.19, -2.11, "122", "JE"},
+		{"Paris", 48.86, 2.35, "227", "FR"},
+		{"Ajaccio", 41.92, 8.74, "214", "FR"},
+		{"Brussels", 50.85, 4.35, "209", "BE"},
+		{"Amsterdam", 52.37, 4.9, "263", "NL"},
+		{"Berlin", 52.52, 13.4, "230", "DE"},
+		{"Munich", 48.14, 11.58, "230", "DE"},
+		{"Copenhagen", 55.68, 12.57, "221", "DK"},
+		{"Oslo", 59.91, 10.75, "266", "NO"},
+		{"Stockholm", 59.33, 18.07, "284", "SE"},
+		{"Helsinki", 60.17, 24.94, "224", "FI"},
+		{"Zurich", 47.37, 8.54, "287", "CH"},
+		{"Geneva ITU", 46.21, 6.135, "117", ""},
+		{"Vienna", 48.21, 16.37, "206", "AT"},
+		{"Rome", 41.9, 12.5, "248", "IT"},
+		{"Vatican", 41.903, 12.453, "295", "VA"},
+		{"Palermo", 38.12, 13.36, "248", "IT"},
+		{"Cagliari", 39.22, 9.12, "225", "IT"},
+		{"Madrid", 40.42, -3.7, "281", "ES"},
+		{"Palma", 39.57, 2.65, "21", "ES"},
+		{"Las Palmas", 28.12, -15.43, "29", "ES"},
+		{"Gibraltar", 36.14, -5.35, "233", "GI"},
+		{"Lisbon", 38.72, -9.14, "272", "PT"},
+		{"Ponta Delgada", 37.74, -25.67, "149", "PT"},
+		{"Reykjavik", 64.15, -21.94, "242", "IS"},
+		{"Warsaw", 52.23, 21.01, "269", "PL"},
+		{"Prague", 50.08, 14.44, "503", "CZ"},
+		{"Budapest", 47.5, 19.04, "239", "HU"},
+		{"Zagreb", 45.81, 15.98, "497", "HR"},
+		{"Belgrade", 44.79, 20.45, "296", "RS"},
+		{"Athens", 37.98, 23.73, "236", "GR"},
+		{"Heraklion", 35.34, 25.13, "40", "GR"},
+		{"Rhodes", 36.43, 28.22, "45", "GR"},
+		{"Sofia", 42.7, 23.32, "212", "BG"},
+		{"Bucharest", 44.43, 26.1, "275", "RO"},
+		{"Kyiv", 50.45, 30.52, "288", "UA"},
+		{"Minsk", 53.9, 27.57, "27", "BY"},
+		{"Vilnius", 54.69, 25.28, "146", "LT"},
+		{"Riga", 56.95, 24.11, "145", "LV"},
+		{"Tallinn", 59.44, 24.75, "52", "EE"},
+		{"Kaliningrad", 54.71, 20.51, "126", "RU"},
+		{"Moscow", 55.76, 37.62, "54", "RU"},
+		{"Novosibirsk", 55.01, 82.93, "15", "RU"},
+		{"Vladivostok", 43.12, 131.89, "15", "RU"},
+		{"Istanbul", 41.01, 28.98, "390", "TR"},
+		{"Ankara", 39.93, 32.86, "390", "TR"},
+		{"Nicosia", 35.17, 33.36, "215", "CY"},
+		{"Tbilisi", 41.72, 44.79, "75", "GE"},
+		{"Almaty", 43.24, 76.89, "130", "KZ"},
+		{"Tashkent", 41.3, 69.24, "292", "UZ"},
+		{"Ulaanbaatar", 47.89, 106.91, "363", "MN"},
+		{"Beijing", 39.9, 116.4, "318", "CN"},
+		{"Shanghai", 31.23, 121.47, "318", "CN"},
+		{"Hong Kong", 22.32, 114.17, "321", "HK"},
+		{"Taipei", 25.03, 121.57, "386", "TW"},
+		{"Seoul", 37.57, 126.98, "137", "KR"},
+		{"Pyongyang", 39.04, 125.76, "344", "KP"},
+		{"Tokyo", 35.68, 139.69, "339", "JP"},
+		{"Sapporo", 43.06, 141.35, "339", "JP"},
+		{"Manila", 14.6, 120.98, "375", "PH"},
+		{"Hanoi", 21.03, 105.85, "293", "VN"},
+		{"Bangkok", 13.76, 100.5, "387", "TH"},
+		{"Kuala Lumpur", 3.14, 101.69, "299", "MY"},
+		{"Singapore", 1.35, 103.82, "381", "SG"},
+		{"Jakarta", -6.21, 106.85, "327", "ID"},
+		{"Delhi", 28.61, 77.21, "324", "IN"},
+		{"Mumbai", 19.08, 72.88, "324", "IN"},
+		{"Kathmandu", 27.72, 85.32, "369", "NP"},
+		{"Dhaka", 23.81, 90.41, "305", "BD"},
+		{"Karachi", 24.86, 67.01, "372", "PK"},
+		{"Tehran", 35.69, 51.39, "330", "IR"},
+		{"Baghdad", 33.31, 44.36, "333", "IQ"},
+		{"Riyadh", 24.71, 46.68, "378", "SA"},
+		{"Dubai", 25.2, 55.27, "391", "AE"},
+		{"Tel Aviv", 32.09, 34.78, "336", "IL"},
+		{"Amman", 31.95, 35.93, "342", "JO"},
+		{"Cairo", 30.04, 31.24, "478", "EG"},
+		{"Tunis", 36.81, 10.18, "474", "TN"},
+		{"Algiers", 36.75, 3.06, "400", "DZ"},
+		{"Rabat", 34.02, -6.83, "446", "MA"},
+		{"Dakar", 14.72, -17.47, "456", "SN"},
+		{"Lagos", 6.52, 3.38, "450", "NG"},
+		{"Accra", 5.6, -0.19, "424", "GH"},
+		{"Kinshasa", -4.44, 15.27, "414", "CD"},
+		{"Nairobi", -1.29, 36.82, "430", "KE"},
+		{"Kampala", 0.35, 32.58, "286", "UG"},
+		{"Addis Ababa", 9.03, 38.74, "53", "ET"},
+		{"Khartoum", 15.5, 32.56, "466", "SD"},
+		{"Dar es Salaam", -6.79, 39.21, "470", "TZ"},
+		{"Lilongwe", -13.96, 33.79, "440", "MW"},
+		{"Lusaka", -15.39, 28.32, "482", "ZM"},
+		{"Harare", -17.83, 31.05, "452", "ZW"},
+		{"Johannesburg", -26.2, 28.05, "462", "ZA"},
+		{"Cape Town", -33.92, 18.42, "462", "ZA"},
+		{"Maseru", -29.31, 27.48, "432", "LS"},
+		{"Antananarivo", -18.88, 47.51, "438", "MG"},
+		{"Sydney", -33.87, 151.21, "150", "AU"},
+		{"Perth", -31.95, 115.86, "150", "AU"},
+		{"Hobart", -42.88, 147.33, "150", "AU"},
+		{"Auckland", -36.85, 174.76, "170", "NZ"},
+		{"Christchurch", -43.53, 172.64, "170", "NZ"},
+		{"Suva", -18.14, 178.44, "176", "FJ"},
+		{"Port Moresby", -9.44, 147.18, "163", "PG"},
+		{"McMurdo", -77.85, 166.67, "13", "AQ"},
+	}
+	for _, c := range cases {
+		got, err := EntityForLatLong(LatLong{Latitude: c.lat, Longitude: c.lon})
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+			continue
+		}
+		if got.DXCC != c.dxcc || got.ISO2 != c.iso2 || got.Maritime {
+			t.Errorf("%s: got %+v want DXCC %s ISO2 %q", c.name, got, c.dxcc, c.iso2)
+		}
+	}
+}
+
+func TestEntityForLatLong_Maritime(t *testing.T) {
+	cases := []struct {
+		name     string
+		lat, lon float64
+	}{
+		{"Mid Atlantic", 35, -40},
+		{"South Pacific", -40, -130},
+		{"Indian Ocean", -30, 80},
+		{"North Sea", 56, 3},
+	}
+	for _, c := range cases {
+		got, err := EntityForLatLong(LatLong{Latitude: c.lat, Longitude: c.lon})
+		if !errors.Is(err, ErrNoEntity) {
+			t.Errorf("%s: expected ErrNoEntity, got %v (%+v)", c.name, err, got)
+		}
+		if !got.Maritime || got.DXCC != "" {
+			t.Errorf("%s: expected maritime result, got %+v", c.name, got)
+		}
+	}
+}
+
+func TestEntityForLatLong_Invalid(t *testing.T) {
+	if _, err := EntityForLatLong(LatLong{Latitude: 91, Longitude: 0}); !errors.Is(err, ErrLatitudeOutOfRange) {
+		t.Fatalf("expected ErrLatitudeOutOfRange, got %v", err)
+	}
+}
+
+func TestEntityForGridSquare(t *testing.T) {
+	cases := []struct {
+		grid string
+		dxcc string
+	}{
+		{"IO91wm", "223"},
+		{"FN31pr", "291"},
+		{"JN58td", "230"},
+		{"PM95vq", "339"},
+		{"QF56od", "150"},
+	}
+	for _, c := range cases {
+		got, err := EntityForGridSquare(c.grid)
+		if err != nil || got.DXCC != c.dxcc {
+			t.Errorf("%s: got %+v err %v want %s", c.grid, got, err, c.dxcc)
+		}
+	}
+	if _, err := EntityForGridSquare("ZZ99"); !errors.Is(err, ErrGridSquareInvalid) {
+		t.Fatalf("expected ErrGridSquareInvalid, got %v", err)
+	}
+}
+
+func TestDistanceAndBearing(t *testing.T) {
+	london := LatLong{Latitude: 51.5074, Longitude: -0.1278}
+	newYork := LatLong{Latitude: 40.7128, Longitude: -74.006}
+	if d := DistanceKm(london, newYork); d < 5560 || d > 5580 {
+		t.Fatalf("London-New York distance = %.1f km", d)
+	}
+	if b := Bearing(london, newYork); b < 287 || b > 289 {
+		t.Fatalf("London-New York bearing = %.1f", b)
+	}
+	p := Destination(london, Bearing(london, newYork), DistanceKm(london, newYork))
+	if DistanceKm(p, newYork) > 0.5 {
+		t.Fatalf("Destination = %+v, want close to %+v", p, newYork)
+	}
+	mid := Intermediate(london, newYork, 0.5)
+	if d1, d2 := DistanceKm(london, mid), DistanceKm(mid, newYork); d1-d2 > 0.5 || d2-d1 > 0.5 {
+		t.Fatalf("Intermediate midpoint not equidistant: %.1f vs %.1f", d1, d2)
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import "math"
+
+// EarthRadiusKm is the mean Earth radius used by the great-circle helpers.
+const EarthRadiusKm = 6371.0088
+
+const (
+	degToRad = math.Pi / 180
+	radToDeg = 180 / math.Pi
+)
+
+// DistanceKm returns the great-circle distance between a and b in kilometres (haversine formula).
+func DistanceKm(a, b LatLong) float64 {
+	return EarthRadiusKm * centralAngle(a, b)
+}
+
+// Bearing returns the initial great-circle bearing from a to b in degrees clockwise from true north (0–360).
+func Bearing(a, b LatLong) float64 {
+	lat1, lat2 := a.Latitude*degToRad, b.Latitude*degToRad
+	dLon := (b.Longitude - a.Longitude) * degToRad
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return normalizeDegrees(math.Atan2(y, x) * radToDeg)
+}
+
+// Destination returns the point reached by travelling distanceKm from p along the great circle
+// with the given initial bearing (degrees clockwise from true north).
+func Destination(p LatLong, bearing, distanceKm float64) LatLong {
+	lat1, lon1 := p.Latitude*degToRad, p.Longitude*degToRad
+	brg := bearing * degToRad
+	d := distanceKm / EarthRadiusKm
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(brg))
+	lon2 := lon1 + math.Atan2(math.Sin(brg)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return LatLong{Latitude: lat2 * radToDeg, Longitude: normalizeLongitude(lon2 * radToDeg)}
+}
+
+// Intermediate returns the point at fraction f (0 = a, 1 = b) along the great circle from a to b.
+func Intermediate(a, b LatLong, f float64) LatLong {
+	delta := centralAngle(a, b)
+	if delta == 0 {
+		return a
+	}
+	lat1, lon1 := a.Latitude*degToRad, a.Longitude*degToRad
+	lat2, lon2 := b.Latitude*degToRad, b.Longitude*degToRad
+
+	wa := math.Sin((1-f)*delta) / math.Sin(delta)
+	wb := math.Sin(f*delta) / math.Sin(delta)
+	x := wa*math.Cos(lat1)*math.Cos(lon1) + wb*math.Cos(lat2)*math.Cos(lon2)
+	y := wa*math.Cos(lat1)*math.Sin(lon1) + wb*math.Cos(lat2)*math.Sin(lon2)
+	z := wa*math.Sin(lat1) + wb*math.Sin(lat2)
+	return LatLong{
+		Latitude:  math.Atan2(z, math.Hypot(x, y)) * radToDeg,
+		Longitude: math.Atan2(y, x) * radToDeg,
+	}
+}
+
+// centralAngle returns the angle, in radians, subtended at the Earth's centre by a and b.
+func centralAngle(a, b LatLong) float64 {
+	lat1, lat2 := a.Latitude*degToRad, b.Latitude*degToRad
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * degToRad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// normalizeDegrees maps an angle into the range [0, 360).
+func normalizeDegrees(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// normalizeLongitude maps a longitude into the range [-180, 180).
+func normalizeLongitude(long float64) float64 {
+	return normalizeDegrees(long+180) - 180
+}
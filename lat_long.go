@@ -2,49 +2,32 @@ package utils
 
 import (
 	"fmt"
-	"math"
 	"regexp"
 	"strconv"
-	"strings"
 )
 
-// ConvertToXDDDMMM converts a latitude or longitude string to the XDDD°MMM.MMM'N/S/E/W' format and returns the result.
-// It parses the input, calculates degrees and minutes, determines the direction, and formats it accordingly.
-// Returns an error if the input cannot be parsed as a valid floating-point number.
-func ConvertToXDDDMMM(input string) (string, error) {
-	// Parse the input string to a float
+var xdddmmmRegex = regexp.MustCompile(`^[NSEW][0-9]{3} [0-9]{2}\.[0-9]{3}$`)
+
+// ConvertToXDDDMMM converts a decimal latitude or longitude string to the ADIF XDDD MM.MMM format and returns the result.
+// The optional axis selects the direction letters and range: N/S and ±90 for AxisLatitude, E/W and ±180 for
+// AxisLongitude, so "-71.5" with AxisLongitude becomes "W071 30.000". Without an axis the N/S letters are used and
+// any value within ±180 is accepted, as before axes were supported.
+// Returns an error if the input cannot be parsed as a valid floating-point number or is out of range.
+func ConvertToXDDDMMM(input string, axis ...Axis) (string, error) {
 	coord, err := strconv.ParseFloat(input, 64)
 	if err != nil {
 		return emptyString, err
 	}
 
-	// Determine the directional character
-	var direction string
-	if coord < 0 {
-		direction = "S"
-		coord = math.Abs(coord)
-	} else {
-		direction = "N"
+	if len(axis) > 0 {
+		return Coordinate{Axis: axis[0], Degrees: coord}.ADIFLocation()
 	}
-
-	// Extract degrees and minutes
-	degrees := int(coord)
-	minutes := (coord - float64(degrees)) * 60
-
-	// Normalize rounding so that 59.9995 -> 60.000 carries into degrees
-	minutes = math.Round(minutes*1000) / 1000
-	if minutes >= 60.0 {
-		degrees += 1
-		minutes = 0
+	if err := (Coordinate{Axis: AxisLongitude, Degrees: coord}).Validate(); err != nil {
+		return emptyString, err
 	}
-
-	// Format degrees and minutes
-	degreesStr := fmt.Sprintf("%03d", degrees)
-	minutesStr := fmt.Sprintf("%06.3f", minutes)
-
-	// Combine into the final format
-	result := strings.TrimSpace(fmt.Sprintf("%s%s %s", direction, degreesStr, minutesStr))
-	return result, nil
+	c := Coordinate{Axis: AxisLatitude, Degrees: coord}
+	deg, minutes := splitDegreesMinutes(c.Degrees, 3)
+	return fmt.Sprintf("%s%03d %06.3f", c.Hemisphere(), deg, minutes), nil
 }
 
 // IsXDDDMMM returns true if s matches the XDDD MMM.MMM latitude/longitude format.
@@ -52,42 +35,52 @@ func ConvertToXDDDMMM(input string) (string, error) {
 // Degrees must be zero-padded to 3 digits (000–180), minutes must be zero-padded with exactly
 // two digits before the decimal point and exactly three digits after (00.000–59.999).
 // Note: When degrees = 180, minutes must be 00.000 to be a valid coordinate; this function enforces that.
-func IsXDDDMMM(s string) bool {
-	// Quick structural check: one direction letter, three digits, space, two digits, dot, three digits
-	re := regexp.MustCompile(`^[NSEW][0-9]{3} [0-9]{2}\.[0-9]{3}$`)
-	if !re.MatchString(s) {
+// If an axis is given, the direction letter must belong to it (N/S or E/W) and latitudes are limited to 90 degrees.
+func IsXDDDMMM(s string, axis ...Axis) bool {
+	c, ok := parseXDDDMMM(s)
+	if !ok {
 		return false
 	}
+	if len(axis) == 0 {
+		return true
+	}
+	return axis[0] == c.Axis && c.Validate() == nil
+}
 
-	// Split into parts
-	dir := s[:1]
-	_ = dir // currently not used further, but kept for clarity and potential future rules
-	degStr := s[1:4]
-	minStr := s[5:]
+// parseXDDDMMM converts a strictly formatted XDDD MM.MMM string into a Coordinate, reporting false
+// when the structure or the degree/minute bounds are invalid.
+func parseXDDDMMM(s string) (Coordinate, bool) {
+	// Quick structural check: one direction letter, three digits, space, two digits, dot, three digits
+	if !xdddmmmRegex.MatchString(s) {
+		return Coordinate{}, false
+	}
 
-	deg, err := strconv.Atoi(degStr)
+	deg, err := strconv.Atoi(s[1:4])
 	if err != nil {
-		return false
+		return Coordinate{}, false
 	}
 	// minutes as float (with exactly three decimals by regex)
-	mi, err := strconv.ParseFloat(minStr, 64)
+	mi, err := strconv.ParseFloat(s[5:], 64)
 	if err != nil {
-		return false
+		return Coordinate{}, false
 	}
 
-	// Validate numeric bounds
-	if deg < 0 || deg > 180 {
-		return false
-	}
-	// Minutes must be in [0, 60); allow exactly 60.000 only when it would carry, but since
-	// canonical format from ConvertToXDDDMMM never outputs 60.000 we disallow it here.
-	if mi < 0.0 || mi >= 60.0 {
-		return false
+	// Minutes must be in [0, 60); canonical output from ConvertToXDDDMMM never contains 60.000.
+	if deg > 180 || mi >= 60.0 {
+		return Coordinate{}, false
 	}
 	// If degrees is 180, minutes must be 0
 	if deg == 180 && mi != 0.0 {
-		return false
+		return Coordinate{}, false
 	}
 
-	return true
+	c := Coordinate{Axis: AxisLatitude, Degrees: float64(deg) + mi/60}
+	switch s[0] {
+	case 'E', 'W':
+		c.Axis = AxisLongitude
+	}
+	if s[0] == 'S' || s[0] == 'W' {
+		c.Degrees = -c.Degrees
+	}
+	return c, true
 }
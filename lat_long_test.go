@@ -63,3 +63,48 @@ func TestConvertToXDDDMMM_RoundingCarry(t *testing.T) {
 		t.Fatalf("ConvertToXDDDMMM(%q) = %q; want %q", in, got, want)
 	}
 }
+
+func TestConvertToXDDDMMM_Longitude(t *testing.T) {
+	cases := map[string]string{
+		"-71.5":  "W071 30.000",
+		"2.25":   "E002 15.000",
+		"-180":   "W180 00.000",
+		"0":      "E000 00.000",
+		"179.99": "E179 59.400",
+	}
+	for in, want := range cases {
+		got, err := ConvertToXDDDMMM(in, AxisLongitude)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", in, err)
+		}
+		if got != want {
+			t.Fatalf("ConvertToXDDDMMM(%q, AxisLongitude) = %q; want %q", in, got, want)
+		}
+	}
+}
+
+func TestConvertToXDDDMMM_OutOfRange(t *testing.T) {
+	if _, err := ConvertToXDDDMMM("90.5", AxisLatitude); err != ErrLatitudeOutOfRange {
+		t.Fatalf("expected ErrLatitudeOutOfRange, got %v", err)
+	}
+	if _, err := ConvertToXDDDMMM("180.5"); err != ErrLongitudeOutOfRange {
+		t.Fatalf("expected ErrLongitudeOutOfRange, got %v", err)
+	}
+	if _, err := ConvertToXDDDMMM("-180.1", AxisLongitude); err != ErrLongitudeOutOfRange {
+		t.Fatalf("expected ErrLongitudeOutOfRange, got %v", err)
+	}
+}
+
+// Without an axis any value within ±180 is accepted and written with N/S, as before axes existed.
+func TestConvertToXDDDMMM_NoAxis(t *testing.T) {
+	cases := map[string]string{
+		"-120.5": "S120 30.000",
+		"90.5":   "N090 30.000",
+		"180":    "N180 00.000",
+	}
+	for in, want := range cases {
+		if got, err := ConvertToXDDDMMM(in); err != nil || got != want {
+			t.Fatalf("ConvertToXDDDMMM(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+}
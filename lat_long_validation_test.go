@@ -8,6 +8,7 @@ func TestIsXDDDMMM_Valid(t *testing.T) {
 		"S090 00.000",
 		"E180 00.000",
 		"W000 59.999",
+		"N091 00.000", // no axis given, so latitudes are not limited to 90
 	}
 	for _, c := range cases {
 		if !IsXDDDMMM(c) {
@@ -33,3 +34,24 @@ func TestIsXDDDMMM_Invalid(t *testing.T) {
 		}
 	}
 }
+
+func TestIsXDDDMMM_Axis(t *testing.T) {
+	cases := []struct {
+		in   string
+		axis Axis
+		want bool
+	}{
+		{"N051 28.633", AxisLatitude, true},
+		{"W071 30.000", AxisLongitude, true},
+		{"E180 00.000", AxisLongitude, true},
+		{"N051 28.633", AxisLongitude, false}, // wrong direction letter for axis
+		{"W071 30.000", AxisLatitude, false},
+		{"N091 00.000", AxisLatitude, false}, // latitude beyond 90
+		{"S090 00.000", AxisLatitude, true},
+	}
+	for _, c := range cases {
+		if got := IsXDDDMMM(c.in, c.axis); got != c.want {
+			t.Errorf("IsXDDDMMM(%q, %v) = %v; want %v", c.in, c.axis, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrGridSquareInvalid = errors.New("invalid Maidenhead grid square (must be 2, 4, 6 or 8 characters)")
+
+// LatLong is a geographic position in signed decimal degrees (north and east positive).
+type LatLong struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// NewLatLong returns a LatLong after checking both values are within range.
+func NewLatLong(lat, long float64) (LatLong, error) {
+	if _, err := NewLatitude(lat); err != nil {
+		return LatLong{}, err
+	}
+	if _, err := NewLongitude(long); err != nil {
+		return LatLong{}, err
+	}
+	return LatLong{Latitude: lat, Longitude: long}, nil
+}
+
+// Validate checks that both the latitude and longitude are within range.
+func (p LatLong) Validate() error {
+	_, err := NewLatLong(p.Latitude, p.Longitude)
+	return err
+}
+
+// LatitudeCoordinate returns the latitude as an axis-aware Coordinate.
+func (p LatLong) LatitudeCoordinate() Coordinate {
+	return Coordinate{Axis: AxisLatitude, Degrees: p.Latitude}
+}
+
+// LongitudeCoordinate returns the longitude as an axis-aware Coordinate.
+func (p LatLong) LongitudeCoordinate() Coordinate {
+	return Coordinate{Axis: AxisLongitude, Degrees: p.Longitude}
+}
+
+// GridSquareToLatLong returns the centre of a 2, 4, 6 or 8 character Maidenhead locator (case-insensitive).
+func GridSquareToLatLong(grid string) (LatLong, error) {
+	g := strings.ToUpper(strings.TrimSpace(grid))
+	if len(g) == 0 || len(g) > 8 || len(g)%2 != 0 {
+		return LatLong{}, ErrGridSquareInvalid
+	}
+
+	long, lat := -180.0, -90.0
+	lonStep, latStep := 20.0, 10.0
+	for i := 0; i < len(g); i += 2 {
+		var lo, la int
+		switch i {
+		case 0: // field: A-R
+			lo, la = int(g[i]-'A'), int(g[i+1]-'A')
+			if lo < 0 || lo > 17 || la < 0 || la > 17 {
+				return LatLong{}, ErrGridSquareInvalid
+			}
+		case 2, 6: // square and extended square: 0-9
+			lonStep, latStep = lonStep/10, latStep/10
+			lo, la = int(g[i]-'0'), int(g[i+1]-'0')
+			if lo < 0 || lo > 9 || la < 0 || la > 9 {
+				return LatLong{}, ErrGridSquareInvalid
+			}
+		case 4: // subsquare: A-X
+			lonStep, latStep = lonStep/24, latStep/24
+			lo, la = int(g[i]-'A'), int(g[i+1]-'A')
+			if lo < 0 || lo > 23 || la < 0 || la > 23 {
+				return LatLong{}, ErrGridSquareInvalid
+			}
+		}
+		long += float64(lo) * lonStep
+		lat += float64(la) * latStep
+	}
+	return LatLong{Latitude: lat + latStep/2, Longitude: long + lonStep/2}, nil
+}
+
+// LatLongToGridSquare returns the Maidenhead locator containing p with the requested precision
+// (2, 4, 6 or 8 characters). Subsquare letters are returned in lower case, e.g. "IO91wm".
+func LatLongToGridSquare(p LatLong, length int) (string, error) {
+	if err := p.Validate(); err != nil {
+		return emptyString, err
+	}
+	if length <= 0 || length > 8 || length%2 != 0 {
+		return emptyString, ErrGridSquareInvalid
+	}
+
+	// Shift to positive ranges; clamp the upper edges into the last cell.
+	long := p.Longitude + 180
+	lat := p.Latitude + 90
+	if long >= 360 {
+		long = 359.9999999
+	}
+	if lat >= 180 {
+		lat = 179.9999999
+	}
+
+	var sb strings.Builder
+	lonStep, latStep := 20.0, 10.0
+	for i := 0; i < length; i += 2 {
+		switch i {
+		case 2, 6:
+			lonStep, latStep = lonStep/10, latStep/10
+		case 4:
+			lonStep, latStep = lonStep/24, latStep/24
+		}
+		lo, la := int(long/lonStep), int(lat/latStep)
+		long -= float64(lo) * lonStep
+		lat -= float64(la) * latStep
+		switch i {
+		case 0:
+			sb.WriteByte(byte('A' + lo))
+			sb.WriteByte(byte('A' + la))
+		case 4:
+			sb.WriteByte(byte('a' + lo))
+			sb.WriteByte(byte('a' + la))
+		default:
+			sb.WriteByte(byte('0' + lo))
+			sb.WriteByte(byte('0' + la))
+		}
+	}
+	return sb.String(), nil
+}
+
+// IsValidGridSquare returns true if s is a syntactically valid 2, 4, 6 or 8 character Maidenhead locator.
+func IsValidGridSquare(s string) bool {
+	_, err := GridSquareToLatLong(s)
+	return err == nil
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGridSquareToLatLong(t *testing.T) {
+	cases := []struct {
+		grid string
+		lat  float64
+		long float64
+	}{
+		{"IO91", 51.5, -1.0},
+		{"io91wm", 51.520833, -0.125},
+		{"FN42", 42.5, -71.0},
+		{"JJ00", 0.5, 1.0},
+		{"IO91wm48", 51.535417, -0.129167},
+	}
+	for _, c := range cases {
+		p, err := GridSquareToLatLong(c.grid)
+		if err != nil {
+			t.Fatalf("GridSquareToLatLong(%q) unexpected error: %v", c.grid, err)
+		}
+		if math.Abs(p.Latitude-c.lat) > 1e-5 || math.Abs(p.Longitude-c.long) > 1e-5 {
+			t.Errorf("GridSquareToLatLong(%q) = %+v; want %f,%f", c.grid, p, c.lat, c.long)
+		}
+	}
+	for _, bad := range []string{"", "I", "IO9", "SA00", "IO9A", "IO91zz", "IO91wm4"} {
+		if IsValidGridSquare(bad) {
+			t.Errorf("expected %q to be invalid", bad)
+		}
+	}
+}
+
+func TestLatLongToGridSquare(t *testing.T) {
+	cases := []struct {
+		p      LatLong
+		length int
+		want   string
+	}{
+		{LatLong{51.5074, -0.1278}, 6, "IO91wm"},
+		{LatLong{42.36, -71.06}, 4, "FN42"},
+		{LatLong{-33.87, 151.2}, 6, "QF56od"},
+		{LatLong{90, 180}, 4, "RR99"},
+		{LatLong{51.5074, -0.1278}, 8, "IO91wm41"},
+	}
+	for _, c := range cases {
+		got, err := LatLongToGridSquare(c.p, c.length)
+		if err != nil {
+			t.Fatalf("LatLongToGridSquare(%+v) unexpected error: %v", c.p, err)
+		}
+		if got != c.want {
+			t.Errorf("LatLongToGridSquare(%+v, %d) = %q; want %q", c.p, c.length, got, c.want)
+		}
+	}
+	if _, err := LatLongToGridSquare(LatLong{}, 5); err != ErrGridSquareInvalid {
+		t.Fatalf("expected ErrGridSquareInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+var ErrInvalidTimeRange = errors.New("invalid time range (end must be after start and step positive)")
+
+const speedOfLightKmS = 299792.458
+
+// SkyPosition is the apparent position of the Sun or Moon as seen by a station.
+// Azimuth is in degrees clockwise from true north and Elevation in degrees above the horizon (no
+// refraction correction). RightAscension, Declination and HourAngle are topocentric, in degrees.
+// DistanceKm is the topocentric distance to the body.
+type SkyPosition struct {
+	Azimuth        float64
+	Elevation      float64
+	RightAscension float64
+	Declination    float64
+	HourAngle      float64
+	DistanceKm     float64
+}
+
+// MoonWindow is a period during which the Moon is above the minimum elevation at both stations.
+// MaxElevationA and MaxElevationB are the highest elevations seen at each station during the window.
+type MoonWindow struct {
+	Start         time.Time
+	End           time.Time
+	MaxElevationA float64
+	MaxElevationB float64
+}
+
+// Duration returns the length of the window.
+func (w MoonWindow) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// MoonPosition returns the Moon's position as seen from p at time t, using a low-precision lunar
+// theory (accurate to roughly 0.3° in position and 0.2% in distance), which is sufficient for
+// pointing EME antennas and planning skeds. Parallax is applied, so elevation is topocentric.
+func MoonPosition(p LatLong, t time.Time) SkyPosition {
+	return skyPosition(p, t, moonGeocentric(julianDate(t)))
+}
+
+// SunPosition returns the Sun's position as seen from p at time t (accurate to about 0.01°).
+func SunPosition(p LatLong, t time.Time) SkyPosition {
+	return skyPosition(p, t, sunGeocentric(julianDate(t)))
+}
+
+// MoonRangeRate returns the rate of change, in km/s, of the distance between p and the Moon.
+// It is negative while the Moon is approaching (typically after moonrise) and positive while it recedes.
+func MoonRangeRate(p LatLong, t time.Time) float64 {
+	const dt = 30 * time.Second
+	return (MoonPosition(p, t.Add(dt)).DistanceKm - MoonPosition(p, t.Add(-dt)).DistanceKm) / (2 * dt.Seconds())
+}
+
+// MoonDoppler returns the Doppler shift in Hz of an EME signal transmitted from tx at freqMHz and
+// received at rx via the Moon. Pass the same position for tx and rx to obtain the self-echo shift.
+func MoonDoppler(tx, rx LatLong, t time.Time, freqMHz float64) float64 {
+	rate := MoonRangeRate(tx, t) + MoonRangeRate(rx, t)
+	return -freqMHz * 1e6 * rate / speedOfLightKmS
+}
+
+// MoonDopplerForFrequency is MoonDoppler with the frequency given as a string in any form accepted
+// by ParseFrequencyMHz, such as "144.050", "1296.050.000" or "144".
+func MoonDopplerForFrequency(tx, rx LatLong, t time.Time, freq string) (float64, error) {
+	mhz, err := ParseFrequencyMHz(freq)
+	if err != nil {
+		return 0, err
+	}
+	return MoonDoppler(tx, rx, t, mhz), nil
+}
+
+// CommonMoonWindows returns the periods between start and end during which the Moon is at or above
+// minElevation degrees at both stations, sampled every step. Window boundaries are therefore accurate
+// to within one step; a step of one to five minutes is typical for sked planning.
+func CommonMoonWindows(a, b LatLong, start, end time.Time, step time.Duration, minElevation float64) ([]MoonWindow, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	if step <= 0 || !end.After(start) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	var windows []MoonWindow
+	var cur *MoonWindow
+	for t := start; !t.After(end); t = t.Add(step) {
+		ea := MoonPosition(a, t).Elevation
+		eb := MoonPosition(b, t).Elevation
+		if ea < minElevation || eb < minElevation {
+			if cur != nil {
+				windows = append(windows, *cur)
+				cur = nil
+			}
+			continue
+		}
+		if cur == nil {
+			cur = &MoonWindow{Start: t, MaxElevationA: ea, MaxElevationB: eb}
+		}
+		cur.End = t
+		cur.MaxElevationA = math.Max(cur.MaxElevationA, ea)
+		cur.MaxElevationB = math.Max(cur.MaxElevationB, eb)
+	}
+	if cur != nil {
+		windows = append(windows, *cur)
+	}
+	return windows, nil
+}
+
+// equatorialVector is a geocentric position in the equatorial frame, in kilometres.
+type equatorialVector struct {
+	x, y, z float64
+}
+
+// julianDate returns the Julian date of t. UTC is used in place of Terrestrial Time; the ~70 s
+// difference is well below the precision of the series used here.
+func julianDate(t time.Time) float64 {
+	return float64(t.UnixNano())/86400e9 + 2440587.5
+}
+
+// greenwichSiderealDegrees returns Greenwich mean sidereal time, in degrees, for a Julian date.
+func greenwichSiderealDegrees(jd float64) float64 {
+	d := jd - 2451545.0
+	tc := d / 36525
+	return normalizeDegrees(280.46061837 + 360.98564736629*d + 0.000387933*tc*tc)
+}
+
+// moonGeocentric returns the Moon's geocentric equatorial position for a Julian date, using the
+// low-precision series from the Astronomical Almanac.
+func moonGeocentric(jd float64) equatorialVector {
+	tc := (jd - 2451545.0) / 36525
+	sin := func(deg float64) float64 { return math.Sin(deg * degToRad) }
+	cos := func(deg float64) float64 { return math.Cos(deg * degToRad) }
+
+	lambda := 218.32 + 481267.881*tc +
+		6.29*sin(135.0+477198.87*tc) - 1.27*sin(259.3-413335.36*tc) +
+		0.66*sin(235.7+890534.22*tc) + 0.21*sin(269.9+954397.74*tc) -
+		0.19*sin(357.5+35999.05*tc) - 0.11*sin(186.5+966404.03*tc)
+	beta := 5.13*sin(93.3+483202.02*tc) + 0.28*sin(228.2+960400.89*tc) -
+		0.28*sin(318.3+6003.15*tc) - 0.17*sin(217.6-407332.21*tc)
+	parallax := 0.9508 + 0.0518*cos(135.0+477198.87*tc) + 0.0095*cos(259.3-413335.36*tc) +
+		0.0078*cos(235.7+890534.22*tc) + 0.0028*cos(269.9+954397.74*tc)
+
+	r := 6378.14 / sin(parallax)
+	return eclipticToEquatorial(lambda, beta, r, obliquity(jd))
+}
+
+// sunGeocentric returns the Sun's geocentric equatorial position for a Julian date.
+func sunGeocentric(jd float64) equatorialVector {
+	n := jd - 2451545.0
+	g := (357.528 + 0.9856003*n) * degToRad
+	lambda := 280.460 + 0.9856474*n + 1.915*math.Sin(g) + 0.020*math.Sin(2*g)
+	au := 1.00014 - 0.01671*math.Cos(g) - 0.00014*math.Cos(2*g)
+	return eclipticToEquatorial(lambda, 0, au*149597870.7, obliquity(jd))
+}
+
+// obliquity returns the mean obliquity of the ecliptic in degrees.
+func obliquity(jd float64) float64 {
+	return 23.439 - 0.0000004*(jd-2451545.0)
+}
+
+// eclipticToEquatorial converts ecliptic longitude and latitude (degrees) at distance r to a
+// geocentric equatorial vector.
+func eclipticToEquatorial(lambda, beta, r, eps float64) equatorialVector {
+	l, b, e := lambda*degToRad, beta*degToRad, eps*degToRad
+	xe := r * math.Cos(b) * math.Cos(l)
+	ye := r * math.Cos(b) * math.Sin(l)
+	ze := r * math.Sin(b)
+	return equatorialVector{
+		x: xe,
+		y: ye*math.Cos(e) - ze*math.Sin(e),
+		z: ye*math.Sin(e) + ze*math.Cos(e),
+	}
+}
+
+// skyPosition converts a geocentric equatorial position to topocentric coordinates for an observer
+// at p (on a spherical Earth) at time t.
+func skyPosition(p LatLong, t time.Time, body equatorialVector) SkyPosition {
+	lst := normalizeDegrees(greenwichSiderealDegrees(julianDate(t)) + p.Longitude)
+	phi, theta := p.Latitude*degToRad, lst*degToRad
+
+	x := body.x - EarthRadiusKm*math.Cos(phi)*math.Cos(theta)
+	y := body.y - EarthRadiusKm*math.Cos(phi)*math.Sin(theta)
+	z := body.z - EarthRadiusKm*math.Sin(phi)
+	dist := math.Sqrt(x*x + y*y + z*z)
+
+	ra := normalizeDegrees(math.Atan2(y, x) * radToDeg)
+	dec := math.Asin(z/dist) * radToDeg
+	ha := normalizeDegrees(lst - ra)
+
+	h, d := ha*degToRad, dec*degToRad
+	el := math.Asin(math.Sin(phi)*math.Sin(d) + math.Cos(phi)*math.Cos(d)*math.Cos(h))
+	az := math.Atan2(-math.Cos(d)*math.Sin(h), math.Sin(d)*math.Cos(phi)-math.Cos(d)*math.Sin(phi)*math.Cos(h))
+
+	return SkyPosition{
+		Azimuth:        normalizeDegrees(az * radToDeg),
+		Elevation:      el * radToDeg,
+		RightAscension: ra,
+		Declination:    dec,
+		HourAngle:      ha,
+		DistanceKm:     dist,
+	}
+}
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// angularSeparation returns the angle in degrees between two sky positions.
+func angularSeparation(a, b SkyPosition) float64 {
+	return DistanceKm(
+		LatLong{Latitude: a.Elevation, Longitude: a.Azimuth},
+		LatLong{Latitude: b.Elevation, Longitude: b.Azimuth},
+	) / EarthRadiusKm * radToDeg
+}
+
+func TestSunPosition_Solstice(t *testing.T) {
+	// June solstice 2024-06-20 20:51 UTC: declination at its maximum.
+	ts := time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC)
+	s := SunPosition(LatLong{}, ts)
+	if math.Abs(s.Declination-23.44) > 0.05 {
+		t.Fatalf("solstice declination = %.3f", s.Declination)
+	}
+	// March equinox 2024-03-20 03:06 UTC.
+	s = SunPosition(LatLong{}, time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC))
+	if math.Abs(s.Declination) > 0.05 {
+		t.Fatalf("equinox declination = %.3f", s.Declination)
+	}
+}
+
+func TestSunPosition_AzimuthElevation(t *testing.T) {
+	// Greenwich near local noon at the equinox: Sun due south at about 90-51.5 degrees.
+	s := SunPosition(LatLong{Latitude: 51.48, Longitude: 0}, time.Date(2024, 3, 20, 12, 7, 0, 0, time.UTC))
+	if math.Abs(s.Azimuth-180) > 1 || math.Abs(s.Elevation-38.5) > 0.5 {
+		t.Fatalf("noon Sun az=%.2f el=%.2f", s.Azimuth, s.Elevation)
+	}
+	// Sydney at the same instant is in darkness.
+	if s := SunPosition(LatLong{Latitude: -33.87, Longitude: 151.21}, time.Date(2024, 3, 20, 12, 7, 0, 0, time.UTC)); s.Elevation > 0 {
+		t.Fatalf("Sun above horizon at Sydney midnight: %.2f", s.Elevation)
+	}
+}
+
+func TestMoonPosition_TotalEclipse(t *testing.T) {
+	// Greatest eclipse of 2024-04-08 near Nazas, Mexico: Sun and Moon coincide topocentrically.
+	p := LatLong{Latitude: 25.29, Longitude: -104.14}
+	ts := time.Date(2024, 4, 8, 18, 17, 0, 0, time.UTC)
+	sun, moon := SunPosition(p, ts), MoonPosition(p, ts)
+	if sep := angularSeparation(sun, moon); sep > 0.4 {
+		t.Fatalf("Sun/Moon separation at totality = %.3f degrees", sep)
+	}
+	if math.Abs(moon.Elevation-69.8) > 1 {
+		t.Fatalf("Moon elevation = %.2f", moon.Elevation)
+	}
+}
+
+func TestMoonPosition_DistanceAndPhase(t *testing.T) {
+	// Perigee of 2024-04-07 17:50 UTC: 358,850 km from the Earth's centre.
+	v := moonGeocentric(julianDate(time.Date(2024, 4, 7, 17, 50, 0, 0, time.UTC)))
+	if r := math.Sqrt(v.x*v.x + v.y*v.y + v.z*v.z); math.Abs(r-358850) > 1500 {
+		t.Fatalf("perigee distance = %.0f km", r)
+	}
+
+	// Full Moon of 2024-04-23 23:49 UTC: Moon roughly opposite the Sun.
+	ts := time.Date(2024, 4, 23, 23, 49, 0, 0, time.UTC)
+	sun, moon := SunPosition(LatLong{}, ts), MoonPosition(LatLong{}, ts)
+	if sep := angularSeparation(sun, moon); sep < 173 {
+		t.Fatalf("full Moon elongation = %.2f", sep)
+	}
+	if moon.DistanceKm < 350000 || moon.DistanceKm > 410000 {
+		t.Fatalf("Moon distance = %.0f km", moon.DistanceKm)
+	}
+	if math.Abs(moon.Declination) > 29 {
+		t.Fatalf("Moon declination = %.2f", moon.Declination)
+	}
+}
+
+func TestMoonDoppler(t *testing.T) {
+	p := LatLong{Latitude: 51.5, Longitude: -0.13}
+	start := time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	var rising, setting bool
+	for ts := start; ts.Before(start.Add(26 * time.Hour)); ts = ts.Add(15 * time.Minute) {
+		pos := MoonPosition(p, ts)
+		if pos.Elevation < 5 {
+			continue
+		}
+		d := MoonDoppler(p, p, ts, 144.1)
+		if math.Abs(d) > 500 {
+			t.Fatalf("self-echo Doppler at 144 MHz = %.1f Hz", d)
+		}
+		// East of the meridian the Moon approaches the station (positive Doppler); west of it, it recedes.
+		if pos.Azimuth < 150 && d > 0 {
+			rising = true
+		}
+		if pos.Azimuth > 210 && d < 0 {
+			setting = true
+		}
+	}
+	if !rising || !setting {
+		t.Fatalf("expected positive Doppler at moonrise and negative at moonset (rising=%v setting=%v)", rising, setting)
+	}
+
+	ts := start.Add(6 * time.Hour)
+	ny := LatLong{Latitude: 40.71, Longitude: -74.0}
+	want := MoonDoppler(p, ny, ts, 1296)
+	got, err := MoonDopplerForFrequency(p, ny, ts, "1296.000.000")
+	if err != nil || math.Abs(got-want) > 1e-6 {
+		t.Fatalf("MoonDopplerForFrequency = %v, %v want %v", got, err, want)
+	}
+	if _, err := MoonDopplerForFrequency(p, ny, ts, "abc"); !errors.Is(err, ErrFrequencySyntax) {
+		t.Fatalf("expected ErrFrequencySyntax, got %v", err)
+	}
+}
+
+func TestCommonMoonWindows(t *testing.T) {
+	london := LatLong{Latitude: 51.5, Longitude: -0.13}
+	newYork := LatLong{Latitude: 40.71, Longitude: -74.0}
+	start := time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+
+	windows, err := CommonMoonWindows(london, newYork, start, end, 5*time.Minute, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(windows) < 2 {
+		t.Fatalf("expected a common window on each day, got %d", len(windows))
+	}
+	for _, w := range windows {
+		if w.Duration() <= 0 || w.Duration() > 12*time.Hour {
+			t.Fatalf("unexpected window duration %v", w.Duration())
+		}
+		mid := w.Start.Add(w.Duration() / 2)
+		if MoonPosition(london, mid).Elevation < 10 || MoonPosition(newYork, mid).Elevation < 10 {
+			t.Fatalf("Moon below minimum elevation inside window %v–%v", w.Start, w.End)
+		}
+		if w.MaxElevationA < 10 || w.MaxElevationB < 10 {
+			t.Fatalf("max elevations %.1f/%.1f below minimum", w.MaxElevationA, w.MaxElevationB)
+		}
+	}
+
+	// Stations on opposite sides of the Earth never share the Moon above 10 degrees.
+	antipodes := LatLong{Latitude: -51.5, Longitude: 179.87}
+	if w, _ := CommonMoonWindows(london, antipodes, start, end, 5*time.Minute, 10); len(w) != 0 {
+		t.Fatalf("expected no common windows for antipodal stations, got %d", len(w))
+	}
+
+	if _, err := CommonMoonWindows(london, newYork, end, start, time.Minute, 0); !errors.Is(err, ErrInvalidTimeRange) {
+		t.Fatalf("expected ErrInvalidTimeRange, got %v", err)
+	}
+	if _, err := CommonMoonWindows(london, newYork, start, end, 0, 0); !errors.Is(err, ErrInvalidTimeRange) {
+		t.Fatalf("expected ErrInvalidTimeRange for zero step, got %v", err)
+	}
+}
+
+func TestParseFrequencyMHz(t *testing.T) {
+	cases := []struct {
+		in   string
+		want float64
+	}{
+		{"144.050", 144.05},
+		{"1296.050.000", 1296.05},
+		{"014.074.000", 14.074},
+		{"14074000", 14.074},
+		{"14074", 14.074},
+		{"144", 144},
+		{"14", 14},
+		{"50", 50},
+		{" 432.100 ", 432.1},
+	}
+	for _, c := range cases {
+		got, err := ParseFrequencyMHz(c.in)
+		if err != nil || math.Abs(got-c.want) > 1e-9 {
+			t.Fatalf("ParseFrequencyMHz(%q) = %v, %v want %v", c.in, got, err, c.want)
+		}
+	}
+	for _, in := range []string{"", "abc", "14.07.4", "1.2.3.4", "0", "-14.074", "1e3.0", "999"} {
+		if _, err := ParseFrequencyMHz(in); !errors.Is(err, ErrFrequencySyntax) {
+			t.Fatalf("ParseFrequencyMHz(%q) expected ErrFrequencySyntax, got %v", in, err)
+		}
+	}
+}
@@ -0,0 +1,406 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMergePlan    = errors.New("merge plan cannot be applied")
+	ErrConfirmation = errors.New("unknown confirmation service")
+)
+
+// ReconcileOptions controls how Reconcile pairs and compares QSOs. Match decides which QSOs may be
+// the same contact and defaults to DupeRulesImportMerge, which suits most logs. Keys lists further
+// fields that must be equal for a match, such as STATION_CALLSIGN when both logs hold several
+// station callsigns. Ignore lists fields that are never compared.
+type ReconcileOptions struct {
+	Match  DupeRules
+	Keys   []string
+	Ignore []string
+}
+
+// FieldDiff is a field whose value differs between a matched pair of QSOs. Left or Right is empty
+// when only the other QSO has the field.
+type FieldDiff struct {
+	Field string
+	Left  string
+	Right string
+}
+
+// Conflict reports whether both QSOs have a value for the field.
+func (d FieldDiff) Conflict() bool {
+	return d.Left != emptyString && d.Right != emptyString
+}
+
+// ReconcileMatch pairs a QSO of the left log with one of the right log by their indexes.
+type ReconcileMatch struct {
+	Left  int
+	Right int
+	Diffs []FieldDiff
+}
+
+// Reconciliation is the result of Reconcile. Matched holds the pairs that agree on every field both
+// have, Conflicts the pairs that do not, and OnlyLeft and OnlyRight the indexes of unpaired QSOs,
+// including any that lack the fields Match needs.
+type Reconciliation struct {
+	Matched   []ReconcileMatch
+	Conflicts []ReconcileMatch
+	OnlyLeft  []int
+	OnlyRight []int
+
+	right []ADIFRecord
+}
+
+// Reconcile compares two logs, such as the local log and a LoTW or QRZ download. Each QSO is paired
+// with at most one QSO of the other log. Pairs are chosen by smallest time gap across both logs, so
+// a QSO is not taken by an earlier right QSO when a later one is nearer in time; equal gaps go to
+// the earlier right QSO, then the earlier left QSO. Matched and Conflicts are in right log order.
+func Reconcile(left, right []ADIFRecord, opts ReconcileOptions) Reconciliation {
+	if opts.Match == (DupeRules{}) {
+		opts.Match = DupeRulesImportMerge
+	}
+	res := Reconciliation{right: right}
+	checker := NewDupeChecker(opts.Match)
+	indexed := make([]bool, len(left))
+	for i, rec := range left {
+		if _, err := checker.Add(rec); err == nil {
+			indexed[i] = true
+		}
+	}
+	// The checker numbers only the QSOs it accepted.
+	seqLeft := make([]int, 0, checker.Len())
+	for i, ok := range indexed {
+		if ok {
+			seqLeft = append(seqLeft, i)
+		}
+	}
+
+	type pair struct {
+		left, right int
+		gap         time.Duration
+	}
+	var pairs []pair
+	for r, rec := range right {
+		candidates, err := checker.Dupes(rec)
+		if err != nil {
+			continue
+		}
+		for _, seqn := range candidates {
+			if l := seqLeft[seqn]; reconcileKeysEqual(left[l], rec, opts.Keys) {
+				pairs = append(pairs, pair{l, r, reconcileTimeGap(left[l], rec, opts.Match.Window)})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		if pairs[i].gap != pairs[j].gap {
+			return pairs[i].gap < pairs[j].gap
+		}
+		if pairs[i].right != pairs[j].right {
+			return pairs[i].right < pairs[j].right
+		}
+		return pairs[i].left < pairs[j].left
+	})
+
+	leftOf := make([]int, len(right))
+	for r := range leftOf {
+		leftOf[r] = -1
+	}
+	paired := make([]bool, len(left))
+	for _, p := range pairs {
+		if !paired[p.left] && leftOf[p.right] < 0 {
+			paired[p.left] = true
+			leftOf[p.right] = p.left
+		}
+	}
+	for r, l := range leftOf {
+		if l < 0 {
+			res.OnlyRight = append(res.OnlyRight, r)
+			continue
+		}
+		m := ReconcileMatch{Left: l, Right: r, Diffs: diffADIFRecords(left[l], right[r], opts.Ignore, opts.Match)}
+		if m.hasConflict() {
+			res.Conflicts = append(res.Conflicts, m)
+		} else {
+			res.Matched = append(res.Matched, m)
+		}
+	}
+	for l, ok := range paired {
+		if !ok {
+			res.OnlyLeft = append(res.OnlyLeft, l)
+		}
+	}
+	return res
+}
+
+func (m ReconcileMatch) hasConflict() bool {
+	for _, d := range m.Diffs {
+		if d.Conflict() {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileKeysEqual reports whether a and b agree on every key field.
+func reconcileKeysEqual(a, b ADIFRecord, keys []string) bool {
+	for _, k := range keys {
+		if !adifValuesEqual(a.Value(k), b.Value(k)) {
+			return false
+		}
+	}
+	return true
+}
+
+// reconcileTimeGap returns how far apart the two QSOs started, or zero with no time window.
+func reconcileTimeGap(a, b ADIFRecord, window time.Duration) time.Duration {
+	if window <= 0 {
+		return 0
+	}
+	ta, _ := a.TimeOn()
+	tb, _ := b.TimeOn()
+	if gap := ta.Sub(tb); gap >= 0 {
+		return gap
+	}
+	return tb.Sub(ta)
+}
+
+// diffADIFRecords lists the fields that differ between a and b, in a's field order followed by the
+// fields only b has.
+func diffADIFRecords(a, b ADIFRecord, ignore []string, rules DupeRules) []FieldDiff {
+	skip := make(map[string]bool, len(ignore))
+	for _, name := range ignore {
+		skip[strings.ToUpper(name)] = true
+	}
+	var diffs []FieldDiff
+	for _, f := range a.Fields {
+		name := strings.ToUpper(f.Name)
+		if skip[name] {
+			continue
+		}
+		skip[name] = true
+		if other := b.Value(name); !reconcileFieldEqual(name, a, b, rules) {
+			diffs = append(diffs, FieldDiff{Field: name, Left: f.Value, Right: other})
+		}
+	}
+	for _, f := range b.Fields {
+		name := strings.ToUpper(f.Name)
+		if skip[name] {
+			continue
+		}
+		skip[name] = true
+		if f.Value != emptyString {
+			diffs = append(diffs, FieldDiff{Field: name, Right: f.Value})
+		}
+	}
+	return diffs
+}
+
+// reconcileFieldEqual reports whether a and b agree on a field, allowing the differences that rules
+// allow in a match: QSO_DATE and TIME_ON are compared as timestamps within the time window
+// ("1200" equals "120000", and any time equals only itself when the window is zero), MODE and SUBMODE after NormalizeModeSubmode ("USB" equals "SSB"), and
+// FREQ and FREQ_RX within the frequency tolerance.
+func reconcileFieldEqual(name string, a, b ADIFRecord, rules DupeRules) bool {
+	x, y := a.Value(name), b.Value(name)
+	if adifValuesEqual(x, y) {
+		return true
+	}
+	switch name {
+	case "QSO_DATE", "TIME_ON":
+		ta, err1 := a.TimeOn()
+		tb, err2 := b.TimeOn()
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		gap := ta.Sub(tb)
+		return gap <= rules.Window && -gap <= rules.Window
+	case "MODE", "SUBMODE":
+		ma, sa, err1 := NormalizeModeSubmode(a.Value("MODE"), a.Value("SUBMODE"))
+		mb, sb, err2 := NormalizeModeSubmode(b.Value("MODE"), b.Value("SUBMODE"))
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		if name == "MODE" {
+			return ma == mb
+		}
+		return strings.EqualFold(sa, sb)
+	case "FREQ", "FREQ_RX":
+		fa, err1 := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		fb, err2 := strconv.ParseFloat(strings.TrimSpace(y), 64)
+		return err1 == nil && err2 == nil && math.Abs(fa*1000-fb*1000) <= rules.FreqToleranceKHz
+	}
+	return false
+}
+
+// adifValuesEqual compares two field values ignoring case and surrounding whitespace, and numbers by
+// value ("14.074" equals "14.07400").
+func adifValuesEqual(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	x, err1 := strconv.ParseFloat(a, 64)
+	y, err2 := strconv.ParseFloat(b, 64)
+	return err1 == nil && err2 == nil && x == y
+}
+
+// MergePolicy selects what a MergePlan takes from the right log. Fields the left QSO lacks are
+// always filled in; OverwriteConflicts also replaces differing left values, and AddOnlyRight adds
+// the QSOs found only in the right log.
+type MergePolicy struct {
+	OverwriteConflicts bool
+	AddOnlyRight       bool
+}
+
+// MergeStep is one change to the left log: with Left set to the index of a QSO, the fields in Set
+// are written to it; with Left of -1, Add is appended to the log.
+type MergeStep struct {
+	Left int
+	Set  []ADIFField
+	Add  ADIFRecord
+}
+
+// MergePlan is a list of changes to the left log, which can be reviewed before Apply.
+type MergePlan struct {
+	Steps []MergeStep
+}
+
+// Plan returns the changes that merge the right log into the left one under policy.
+func (r Reconciliation) Plan(policy MergePolicy) MergePlan {
+	var plan MergePlan
+	for _, list := range [][]ReconcileMatch{r.Matched, r.Conflicts} {
+		for _, m := range list {
+			var set []ADIFField
+			for _, d := range m.Diffs {
+				if d.Right != emptyString && (d.Left == emptyString || policy.OverwriteConflicts) {
+					set = append(set, ADIFField{Name: d.Field, Value: d.Right})
+				}
+			}
+			if set != nil {
+				plan.Steps = append(plan.Steps, MergeStep{Left: m.Left, Set: set})
+			}
+		}
+	}
+	if policy.AddOnlyRight {
+		for _, i := range r.OnlyRight {
+			plan.Steps = append(plan.Steps, MergeStep{Left: -1, Add: r.right[i]})
+		}
+	}
+	return plan
+}
+
+// Apply returns a copy of the left log with the plan's changes made. left is not modified.
+func (p MergePlan) Apply(left []ADIFRecord) ([]ADIFRecord, error) {
+	out := make([]ADIFRecord, len(left), len(left)+len(p.Steps))
+	copy(out, left)
+	copied := make(map[int]bool)
+	for _, s := range p.Steps {
+		if s.Left < 0 {
+			out = append(out, ADIFRecord{Fields: append([]ADIFField(nil), s.Add.Fields...)})
+			continue
+		}
+		if s.Left >= len(left) {
+			return nil, fmt.Errorf("%w: no QSO %d in a log of %d", ErrMergePlan, s.Left, len(left))
+		}
+		if !copied[s.Left] {
+			out[s.Left] = ADIFRecord{Fields: append([]ADIFField(nil), left[s.Left].Fields...)}
+			copied[s.Left] = true
+		}
+		for _, f := range s.Set {
+			out[s.Left].Set(f.Name, f.Value)
+		}
+	}
+	return out, nil
+}
+
+// ConfirmationService is an online QSL service whose confirmations can be matched to a local log.
+type ConfirmationService string
+
+const (
+	ConfirmationLoTW ConfirmationService = "LOTW"
+	ConfirmationEQSL ConfirmationService = "EQSL"
+	ConfirmationQRZ  ConfirmationService = "QRZCOM"
+)
+
+// confirmationFields describes how a service marks a downloaded record as a confirmation and which
+// local fields record it.
+type confirmationFields struct {
+	confirmed      func(ADIFRecord) bool
+	dateField      string // date of the confirmation in the download
+	rcvdField      string // local field set to rcvdValue
+	rcvdValue      string
+	localDateField string
+}
+
+var confirmationServices = map[ConfirmationService]confirmationFields{
+	ConfirmationLoTW: {
+		confirmed:      func(rec ADIFRecord) bool { return strings.EqualFold(rec.Value("QSL_RCVD"), "Y") },
+		dateField:      "QSLRDATE",
+		rcvdField:      "LOTW_QSL_RCVD",
+		rcvdValue:      "Y",
+		localDateField: "LOTW_QSLRDATE",
+	},
+	// The eQSL inbox holds only QSLs received.
+	ConfirmationEQSL: {
+		confirmed:      func(ADIFRecord) bool { return true },
+		dateField:      "QSLRDATE",
+		rcvdField:      "EQSL_QSL_RCVD",
+		rcvdValue:      "Y",
+		localDateField: "EQSL_QSLRDATE",
+	},
+	// ADIF has no QRZ confirmation fields, so QRZ's own application fields are kept.
+	ConfirmationQRZ: {
+		confirmed:      func(rec ADIFRecord) bool { return strings.EqualFold(rec.Value("APP_QRZLOG_STATUS"), "C") },
+		dateField:      "APP_QRZLOG_QSLDATE",
+		rcvdField:      "APP_QRZLOG_STATUS",
+		rcvdValue:      "C",
+		localDateField: "APP_QRZLOG_QSLDATE",
+	},
+}
+
+// ConfirmationPlan matches the confirmations in a download from service, such as a LoTW report, to
+// the local log and returns a plan marking the local QSOs confirmed (LOTW_QSL_RCVD and
+// LOTW_QSLRDATE for LoTW). QSOs already marked are left out, so an applied plan is not repeated. It
+// also returns the indexes of the confirmations that match no local QSO.
+func ConfirmationPlan(local, download []ADIFRecord, service ConfirmationService, opts ReconcileOptions) (MergePlan, []int, error) {
+	cf, ok := confirmationServices[service]
+	if !ok {
+		return MergePlan{}, nil, fmt.Errorf("%w: %q", ErrConfirmation, service)
+	}
+	var confirmations []ADIFRecord
+	var from []int
+	for i, rec := range download {
+		if cf.confirmed(rec) {
+			confirmations = append(confirmations, rec)
+			from = append(from, i)
+		}
+	}
+
+	res := Reconcile(local, confirmations, opts)
+	var plan MergePlan
+	for _, list := range [][]ReconcileMatch{res.Matched, res.Conflicts} {
+		for _, m := range list {
+			rec, dl := local[m.Left], confirmations[m.Right]
+			var set []ADIFField
+			if rec.Value(cf.rcvdField) != cf.rcvdValue {
+				set = append(set, ADIFField{Name: cf.rcvdField, Value: cf.rcvdValue})
+			}
+			if date := dl.Value(cf.dateField); date != emptyString && rec.Value(cf.localDateField) != date {
+				set = append(set, ADIFField{Name: cf.localDateField, Value: date})
+			}
+			if set != nil {
+				plan.Steps = append(plan.Steps, MergeStep{Left: m.Left, Set: set})
+			}
+		}
+	}
+	var unmatched []int
+	for _, r := range res.OnlyRight {
+		unmatched = append(unmatched, from[r])
+	}
+	return plan, unmatched, nil
+}
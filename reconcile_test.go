@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	left := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1200", "FREQ", "14.074", "MODE", "FT8", "RST_SENT", "-10"),
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240101", "TIME_ON", "1210", "BAND", "40m", "MODE", "CW", "NAME", "Bob"),
+		testADIFRecord("CALL", "G4ABC", "QSO_DATE", "20240101", "TIME_ON", "1300", "BAND", "20m", "MODE", "SSB"),
+		testADIFRecord("CALL", "NOTIME", "BAND", "20m", "MODE", "SSB"),
+	}
+	right := []ADIFRecord{
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240101", "TIME_ON", "121200", "BAND", "40M", "MODE", "CW", "NAME", "Robert"),
+		testADIFRecord("CALL", "w1aw", "QSO_DATE", "20240101", "TIME_ON", "1201", "FREQ", "14.07400", "MODE", "ft8", "GRIDSQUARE", "FN31"),
+		testADIFRecord("CALL", "DL1ABC", "QSO_DATE", "20240101", "TIME_ON", "1400", "BAND", "20m", "MODE", "SSB"),
+		testADIFRecord("CALL", "G4ABC", "QSO_DATE", "20240101", "TIME_ON", "1330", "BAND", "20m", "MODE", "SSB"),
+	}
+	res := Reconcile(left, right, ReconcileOptions{Match: DupeRulesImportMerge, Ignore: []string{"TIME_ON"}})
+
+	wantMatched := []ReconcileMatch{{Left: 0, Right: 1, Diffs: []FieldDiff{
+		{Field: "RST_SENT", Left: "-10"},
+		{Field: "GRIDSQUARE", Right: "FN31"},
+	}}}
+	wantConflicts := []ReconcileMatch{{Left: 1, Right: 0, Diffs: []FieldDiff{{Field: "NAME", Left: "Bob", Right: "Robert"}}}}
+	if !reflect.DeepEqual(res.Matched, wantMatched) {
+		t.Fatalf("unexpected matches %+v", res.Matched)
+	}
+	if !reflect.DeepEqual(res.Conflicts, wantConflicts) {
+		t.Fatalf("unexpected conflicts %+v", res.Conflicts)
+	}
+	if !reflect.DeepEqual(res.OnlyLeft, []int{2, 3}) || !reflect.DeepEqual(res.OnlyRight, []int{2, 3}) {
+		t.Fatalf("unexpected unmatched QSOs %v %v", res.OnlyLeft, res.OnlyRight)
+	}
+}
+
+func TestReconcile_NearestAndKeys(t *testing.T) {
+	left := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "STATION_CALLSIGN", "G4ABC", "QSO_DATE", "20240101", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW"),
+		testADIFRecord("CALL", "W1AW", "STATION_CALLSIGN", "G4ABC", "QSO_DATE", "20240101", "TIME_ON", "1204", "BAND", "20m", "MODE", "CW"),
+		testADIFRecord("CALL", "W1AW", "STATION_CALLSIGN", "M0XYZ", "QSO_DATE", "20240101", "TIME_ON", "1203", "BAND", "20m", "MODE", "CW"),
+	}
+	right := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "STATION_CALLSIGN", "G4ABC", "QSO_DATE", "20240101", "TIME_ON", "1203", "BAND", "20m", "MODE", "CW"),
+		testADIFRecord("CALL", "W1AW", "STATION_CALLSIGN", "G4ABC", "QSO_DATE", "20240101", "TIME_ON", "1203", "BAND", "20m", "MODE", "CW"),
+	}
+	res := Reconcile(left, right, ReconcileOptions{Match: DupeRulesImportMerge, Keys: []string{"STATION_CALLSIGN"}, Ignore: []string{"TIME_ON"}})
+	if len(res.Matched) != 2 || res.Matched[0].Left != 1 || res.Matched[1].Left != 0 {
+		t.Fatalf("unexpected matches %+v", res.Matched)
+	}
+	if !reflect.DeepEqual(res.OnlyLeft, []int{2}) || res.OnlyRight != nil || res.Conflicts != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+}
+
+func TestReconcile_NearestOverall(t *testing.T) {
+	left := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1200", "BAND", "20m", "MODE", "CW"),
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1210", "BAND", "20m", "MODE", "CW"),
+	}
+	right := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1205", "BAND", "20m", "MODE", "CW"),
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1201", "BAND", "20m", "MODE", "CW"),
+	}
+	// The zero Match means DupeRulesImportMerge. Taking the right QSOs in order would pair the first
+	// with the 1200 QSO and leave the second, 1201, without a partner within five minutes.
+	res := Reconcile(left, right, ReconcileOptions{Ignore: []string{"TIME_ON"}})
+	want := []ReconcileMatch{{Left: 1, Right: 0}, {Left: 0, Right: 1}}
+	if !reflect.DeepEqual(res.Matched, want) || res.OnlyLeft != nil || res.OnlyRight != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+}
+
+func TestReconcile_EquivalentValues(t *testing.T) {
+	left := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1200", "FREQ", "14.250", "MODE", "USB"),
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240101", "TIME_ON", "1300", "FREQ", "7.025", "MODE", "CW"),
+	}
+	right := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "120000", "FREQ", "14.252", "MODE", "SSB"),
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240101", "TIME_ON", "1304", "FREQ", "7.025", "MODE", "CW"),
+	}
+	res := Reconcile(left, right, ReconcileOptions{})
+	want := []ReconcileMatch{{Left: 0, Right: 0}, {Left: 1, Right: 1}}
+	if !reflect.DeepEqual(res.Matched, want) || res.Conflicts != nil {
+		t.Fatalf("unexpected result %+v", res)
+	}
+
+	// With no time window the times must agree, and outside the tolerance FREQ is a conflict.
+	res = Reconcile(left, right, ReconcileOptions{Match: DupeRulesContestBand})
+	wantConflicts := []ReconcileMatch{
+		{Left: 0, Right: 0, Diffs: []FieldDiff{{Field: "FREQ", Left: "14.250", Right: "14.252"}}},
+		{Left: 1, Right: 1, Diffs: []FieldDiff{{Field: "TIME_ON", Left: "1300", Right: "1304"}}},
+	}
+	if !reflect.DeepEqual(res.Conflicts, wantConflicts) {
+		t.Fatalf("unexpected conflicts %+v", res.Conflicts)
+	}
+}
+
+func TestReconciliation_Plan(t *testing.T) {
+	left := []ADIFRecord{
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240101", "TIME_ON", "1210", "BAND", "40m", "MODE", "CW", "NAME", "Bob"),
+	}
+	right := []ADIFRecord{
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240101", "TIME_ON", "1210", "BAND", "40m", "MODE", "CW", "NAME", "Robert", "STATE", "CT"),
+		testADIFRecord("CALL", "DL1ABC", "QSO_DATE", "20240101", "TIME_ON", "1400", "BAND", "20m", "MODE", "SSB"),
+	}
+	res := Reconcile(left, right, ReconcileOptions{Match: DupeRulesImportMerge})
+
+	plan := res.Plan(MergePolicy{})
+	if len(plan.Steps) != 1 || !reflect.DeepEqual(plan.Steps[0].Set, []ADIFField{{Name: "STATE", Value: "CT"}}) {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+	merged, err := plan.Apply(left)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged[0].Value("STATE") != "CT" || merged[0].Value("NAME") != "Bob" || len(merged) != 1 {
+		t.Fatalf("unexpected merge %+v", merged)
+	}
+	if _, ok := left[0].Get("STATE"); ok {
+		t.Fatal("Apply modified the left log")
+	}
+
+	plan = res.Plan(MergePolicy{OverwriteConflicts: true, AddOnlyRight: true})
+	merged, err = plan.Apply(left)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merged) != 2 || merged[0].Value("NAME") != "Robert" || merged[1].Value("CALL") != "DL1ABC" {
+		t.Fatalf("unexpected merge %+v", merged)
+	}
+
+	bad := MergePlan{Steps: []MergeStep{{Left: 5, Set: []ADIFField{{Name: "NAME", Value: "x"}}}}}
+	if _, err := bad.Apply(left); !errors.Is(err, ErrMergePlan) {
+		t.Fatalf("expected ErrMergePlan, got %v", err)
+	}
+}
+
+func TestConfirmationPlan(t *testing.T) {
+	local := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "1200", "BAND", "20m", "MODE", "FT8"),
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240102", "TIME_ON", "0800", "BAND", "40m", "MODE", "CW", "LOTW_QSL_RCVD", "Y", "LOTW_QSLRDATE", "20240110"),
+		testADIFRecord("CALL", "JA1XYZ", "QSO_DATE", "20240103", "TIME_ON", "0900", "BAND", "15m", "MODE", "SSB"),
+	}
+	lotw := []ADIFRecord{
+		testADIFRecord("CALL", "W1AW", "QSO_DATE", "20240101", "TIME_ON", "120015", "BAND", "20M", "MODE", "FT8", "QSL_RCVD", "Y", "QSLRDATE", "20240105"),
+		testADIFRecord("CALL", "K1ABC", "QSO_DATE", "20240102", "TIME_ON", "0801", "BAND", "40M", "MODE", "CW", "QSL_RCVD", "Y", "QSLRDATE", "20240110"),
+		testADIFRecord("CALL", "JA1XYZ", "QSO_DATE", "20240103", "TIME_ON", "0900", "BAND", "15M", "MODE", "SSB", "QSL_RCVD", "N"),
+		testADIFRecord("CALL", "VK2ABC", "QSO_DATE", "20240104", "TIME_ON", "0900", "BAND", "15M", "MODE", "SSB", "QSL_RCVD", "Y"),
+	}
+	plan, unmatched, err := ConfirmationPlan(local, lotw, ConfirmationLoTW, ReconcileOptions{Match: DupeRulesImportMerge})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []MergeStep{{Left: 0, Set: []ADIFField{{Name: "LOTW_QSL_RCVD", Value: "Y"}, {Name: "LOTW_QSLRDATE", Value: "20240105"}}}}
+	if !reflect.DeepEqual(plan.Steps, want) || !reflect.DeepEqual(unmatched, []int{3}) {
+		t.Fatalf("unexpected plan %+v, unmatched %v", plan.Steps, unmatched)
+	}
+
+	qrz := []ADIFRecord{testADIFRecord("CALL", "JA1XYZ", "QSO_DATE", "20240103", "TIME_ON", "0902", "BAND", "15m", "MODE", "SSB", "APP_QRZLOG_STATUS", "C", "APP_QRZLOG_QSLDATE", "20240201")}
+	plan, _, err = ConfirmationPlan(local, qrz, ConfirmationQRZ, ReconcileOptions{Match: DupeRulesImportMerge})
+	if err != nil || len(plan.Steps) != 1 || plan.Steps[0].Left != 2 || plan.Steps[0].Set[0] != (ADIFField{Name: "APP_QRZLOG_STATUS", Value: "C"}) {
+		t.Fatalf("unexpected plan %+v, %v", plan.Steps, err)
+	}
+
+	if _, _, err := ConfirmationPlan(local, lotw, "CLUBLOG", ReconcileOptions{}); !errors.Is(err, ErrConfirmation) {
+		t.Fatalf("expected ErrConfirmation, got %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+var ErrSCPFormat = errors.New("invalid Super Check Partial file format")
+
+// SCPDatabase is a Super Check Partial list of known-active callsigns, as distributed in the
+// MASTER.SCP file. It is safe for concurrent use once loaded.
+type SCPDatabase struct {
+	calls []string // sorted, unique
+	set   map[string]struct{}
+}
+
+// SCPCandidate is a known callsign suggested in place of a possibly busted one. Distance is the
+// CW-weighted edit distance from CallsignDistance.
+type SCPCandidate struct {
+	Call     string
+	Distance float64
+}
+
+// LoadSCPFile loads a MASTER.SCP (or compatible partial-check) file.
+func LoadSCPFile(path string) (*SCPDatabase, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadSCP(f)
+}
+
+// LoadSCP parses a partial-check list: one callsign per line, with blank lines and lines starting
+// with '#' ignored. Callsigns are upper-cased and duplicates dropped.
+func LoadSCP(r io.Reader) (*SCPDatabase, error) {
+	var calls []string
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == emptyString || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if strings.ContainsAny(text, " \t,") {
+			return nil, fmt.Errorf("line %d: %w: %q", line, ErrSCPFormat, text)
+		}
+		calls = append(calls, text)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return NewSCPDatabase(calls), nil
+}
+
+// NewSCPDatabase builds a database from a list of callsigns. Calls with a portable designator or
+// modifier are held under their home call.
+func NewSCPDatabase(calls []string) *SCPDatabase {
+	db := &SCPDatabase{set: make(map[string]struct{}, len(calls))}
+	for _, c := range calls {
+		c = scpKey(c)
+		if c == emptyString {
+			continue
+		}
+		if _, ok := db.set[c]; ok {
+			continue
+		}
+		db.set[c] = struct{}{}
+		db.calls = append(db.calls, c)
+	}
+	sort.Strings(db.calls)
+	return db
+}
+
+// Len returns the number of callsigns in the database.
+func (db *SCPDatabase) Len() int {
+	return len(db.calls)
+}
+
+// Contains reports whether a callsign is in the database. Portable designators and modifiers are
+// ignored when the call parses, so "EA8/G4ABC/P" matches an entry for G4ABC.
+func (db *SCPDatabase) Contains(call string) bool {
+	_, ok := db.set[scpKey(call)]
+	return ok
+}
+
+// Partial returns up to limit callsigns (all if limit <= 0) containing fragment, in sorted order.
+// A '?' in the fragment matches any single character. When the fragment contains '/', only its
+// longest part is used, so a partial portable call such as "G4AB/P" matches G4ABC.
+func (db *SCPDatabase) Partial(fragment string, limit int) []string {
+	fragment = strings.ToUpper(strings.TrimSpace(fragment))
+	if strings.Contains(fragment, "/") {
+		fragment = longestPart(fragment)
+	}
+	if fragment == emptyString {
+		return nil
+	}
+	var out []string
+	for _, c := range db.calls {
+		if scpContains(c, fragment) {
+			out = append(out, c)
+			if limit > 0 && len(out) == limit {
+				break
+			}
+		}
+	}
+	return out
+}
+
+// NPlusOne returns the callsigns one edit (a single added, dropped or changed character) away from
+// call, in sorted order. Contest loggers offer these when a call is not in the database.
+func (db *SCPDatabase) NPlusOne(call string) []string {
+	key := scpKey(call)
+	var out []string
+	for _, c := range db.calls {
+		if c == key || absInt(len(c)-len(key)) > 1 {
+			continue
+		}
+		if levenshtein(key, c) == 1 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// BustedCandidates returns the callsigns within maxDistance of call by CallsignDistance, closest
+// first and then in sorted order, excluding call itself. Because CW-confusable substitutions cost
+// less than other edits, a busted "W1AH" ranks W1A5 above W1AX.
+func (db *SCPDatabase) BustedCandidates(call string, maxDistance float64) []SCPCandidate {
+	key := scpKey(call)
+	var out []SCPCandidate
+	for _, c := range db.calls {
+		if c == key || float64(absInt(len(c)-len(key))) > maxDistance {
+			continue
+		}
+		if d := CallsignDistance(key, c); d <= maxDistance {
+			out = append(out, SCPCandidate{Call: c, Distance: d})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Distance < out[j].Distance })
+	return out
+}
+
+// cwConfusableCost is the substitution cost for two characters commonly mis-copied in CW.
+const cwConfusableCost = 0.5
+
+// CallsignDistance returns the edit distance between two callsigns, where inserting, deleting or
+// substituting a character costs 1 except for substituting a CW-confusable pair, which costs 0.5.
+// Characters are CW-confusable when their Morse codes differ by one element, such as 5/H
+// (..... and ....), B/6 (-... and -....), 4/V, S/H, T/M and J/1.
+func CallsignDistance(a, b string) float64 {
+	a, b = strings.ToUpper(a), strings.ToUpper(b)
+	prev := make([]float64, len(b)+1)
+	cur := make([]float64, len(b)+1)
+	for j := range prev {
+		prev[j] = float64(j)
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = float64(i)
+		for j := 1; j <= len(b); j++ {
+			cost := 1.0
+			switch {
+			case a[i-1] == b[j-1]:
+				cost = 0
+			case CWConfusable(a[i-1], b[j-1]):
+				cost = cwConfusableCost
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// CWConfusable reports whether two characters are easily mis-copied for each other in CW because
+// their Morse codes differ by a single dot or dash.
+func CWConfusable(a, b byte) bool {
+	ma, oka := morseCode[upperByte(a)]
+	mb, okb := morseCode[upperByte(b)]
+	return oka && okb && levenshtein(ma, mb) == 1
+}
+
+var morseCode = map[byte]string{
+	'A': ".-", 'B': "-...", 'C': "-.-.", 'D': "-..", 'E': ".", 'F': "..-.", 'G': "--.", 'H': "....",
+	'I': "..", 'J': ".---", 'K': "-.-", 'L': ".-..", 'M': "--", 'N': "-.", 'O': "---", 'P': ".--.",
+	'Q': "--.-", 'R': ".-.", 'S': "...", 'T': "-", 'U': "..-", 'V': "...-", 'W': ".--", 'X': "-..-",
+	'Y': "-.--", 'Z': "--..", '0': "-----", '1': ".----", '2': "..---", '3': "...--", '4': "....-",
+	'5': ".....", '6': "-....", '7': "--...", '8': "---..", '9': "----.", '/': "-..-.",
+}
+
+// scpKey returns the form of call held in the database: its home call when it parses, otherwise the
+// upper-cased input.
+func scpKey(call string) string {
+	if cs, err := ParseCallsign(call); err == nil {
+		return cs.HomeCall
+	}
+	return strings.ToUpper(strings.TrimSpace(call))
+}
+
+// scpContains reports whether s contains pattern, where '?' in pattern matches any character.
+func scpContains(s, pattern string) bool {
+	if !strings.Contains(pattern, "?") {
+		return strings.Contains(s, pattern)
+	}
+	for i := 0; i+len(pattern) <= len(s); i++ {
+		match := true
+		for j := 0; j < len(pattern); j++ {
+			if pattern[j] != '?' && pattern[j] != s[i+j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// longestPart returns the longest '/'-separated part of s, the first on a tie.
+func longestPart(s string) string {
+	var longest string
+	for _, p := range strings.Split(s, "/") {
+		if len(p) > len(longest) {
+			longest = p
+		}
+	}
+	return longest
+}
+
+func upperByte(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
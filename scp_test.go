@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSCP = `# MASTER.SCP test data
+G4ABC
+g4abd
+W1AW
+W1A5
+W1AX
+K1ABC
+K1ABD
+DL1ABC
+KH6/N8BJQ
+
+W1AW
+`
+
+func TestLoadSCP(t *testing.T) {
+	db, err := LoadSCP(strings.NewReader(testSCP))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Len() != 9 {
+		t.Fatalf("expected 9 calls, got %d", db.Len())
+	}
+	for _, c := range []string{"G4ABD", "w1aw", "EA8/G4ABC/P", "W1AW/7", "N8BJQ"} {
+		if !db.Contains(c) {
+			t.Fatalf("expected %s to be found", c)
+		}
+	}
+	if db.Contains("G4ABE") {
+		t.Fatal("G4ABE should not be found")
+	}
+
+	if _, err := LoadSCP(strings.NewReader("G4ABC\nW1AW K1ABC\n")); !errors.Is(err, ErrSCPFormat) {
+		t.Fatalf("expected ErrSCPFormat, got %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "MASTER.SCP")
+	if err := os.WriteFile(path, []byte(testSCP), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if db, err := LoadSCPFile(path); err != nil || db.Len() != 9 {
+		t.Fatalf("LoadSCPFile: %v", err)
+	}
+}
+
+func TestSCPPartial(t *testing.T) {
+	db := NewSCPDatabase(strings.Fields(testSCP)[3:])
+	cases := []struct {
+		fragment string
+		limit    int
+		want     []string
+	}{
+		{"1AB", 0, []string{"DL1ABC", "K1ABC", "K1ABD"}},
+		{"1AB", 2, []string{"DL1ABC", "K1ABC"}},
+		{"abc", 0, []string{"DL1ABC", "G4ABC", "K1ABC"}},
+		{"G4AB/P", 0, []string{"G4ABC", "G4ABD"}},
+		{"W1A?", 0, []string{"W1A5", "W1AW", "W1AX"}},
+		{"K?AB?", 0, []string{"K1ABC", "K1ABD"}},
+		{"ZZZ", 0, nil},
+		{"", 0, nil},
+	}
+	for _, c := range cases {
+		if got := db.Partial(c.fragment, c.limit); !reflect.DeepEqual(got, c.want) {
+			t.Fatalf("Partial(%q, %d) = %v, want %v", c.fragment, c.limit, got, c.want)
+		}
+	}
+}
+
+func TestSCPNPlusOne(t *testing.T) {
+	db := NewSCPDatabase(strings.Fields(testSCP)[3:])
+	if got := db.NPlusOne("K1AB"); !reflect.DeepEqual(got, []string{"K1ABC", "K1ABD"}) {
+		t.Fatalf("unexpected %v", got)
+	}
+	if got := db.NPlusOne("W1AW"); !reflect.DeepEqual(got, []string{"W1A5", "W1AX"}) {
+		t.Fatalf("unexpected %v", got)
+	}
+	if got := db.NPlusOne("G4ABC/P"); !reflect.DeepEqual(got, []string{"G4ABD"}) {
+		t.Fatalf("unexpected %v", got)
+	}
+}
+
+func TestSCPBustedCandidates(t *testing.T) {
+	db := NewSCPDatabase(strings.Fields(testSCP)[3:])
+	got := db.BustedCandidates("W1AH", 1)
+	want := []SCPCandidate{{"W1A5", 0.5}, {"W1AW", 1}, {"W1AX", 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BustedCandidates(W1AH) = %v, want %v", got, want)
+	}
+	if got := db.BustedCandidates("W1AH", 0.5); len(got) != 1 || got[0].Call != "W1A5" {
+		t.Fatalf("unexpected %v", got)
+	}
+	if got := db.BustedCandidates("W1AW", 0.5); len(got) != 0 {
+		t.Fatalf("expected no candidates, got %v", got)
+	}
+}
+
+func TestCallsignDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want float64
+	}{
+		{"W1AW", "W1AW", 0},
+		{"W1AW", "w1aw", 0},
+		{"DL5ABC", "DLHABC", 0.5},
+		{"K6ABC", "KBABC", 0.5},
+		{"UA4ABC", "UAVABC", 0.5},
+		{"G4ABC", "G4ABX", 1},
+		{"G4ABC", "G4AB", 1},
+		{"", "K1A", 3},
+	}
+	for _, c := range cases {
+		if got := CallsignDistance(c.a, c.b); got != c.want {
+			t.Fatalf("CallsignDistance(%q, %q) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestCWConfusable(t *testing.T) {
+	for _, p := range []string{"5H", "B6", "4V", "SH", "IS", "TM", "J1", "h5"} {
+		if !CWConfusable(p[0], p[1]) || !CWConfusable(p[1], p[0]) {
+			t.Fatalf("expected %c/%c to be confusable", p[0], p[1])
+		}
+	}
+	for _, p := range []string{"AB", "55", "K1", "E0", "#H"} {
+		if CWConfusable(p[0], p[1]) {
+			t.Fatalf("expected %c/%c not to be confusable", p[0], p[1])
+		}
+	}
+}
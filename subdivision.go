@@ -0,0 +1,252 @@
+package utils
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrSubdivisionUnknown = errors.New("unknown administrative subdivision")
+	ErrSubdivisionNoTable = errors.New("no administrative subdivision table for DXCC entity")
+)
+
+// Subdivision is a primary (ADIF STATE) or secondary (ADIF CNTY) administrative subdivision of a
+// DXCC entity. Code is the value stored in the ADIF field: "NY" for a US state, "NY,Albany" for a
+// US county. Parent is the primary subdivision code of a secondary subdivision and empty otherwise.
+type Subdivision struct {
+	DXCC   int
+	Code   string
+	Name   string
+	Parent string
+}
+
+type subdivisionTable struct {
+	list   []Subdivision
+	byCode map[string]int // upper-case code -> index into list
+	byName map[string]int // normalised name -> index into list
+}
+
+var (
+	primaryTables   map[int]*subdivisionTable
+	secondaryTables map[int]*subdivisionTable
+)
+
+func init() {
+	primaryTables = make(map[int]*subdivisionTable, len(primarySubdivisionData))
+	for dxcc, entries := range primarySubdivisionData {
+		list := make([]Subdivision, len(entries))
+		for i, e := range entries {
+			list[i] = Subdivision{DXCC: dxcc, Code: e[0], Name: e[1]}
+		}
+		primaryTables[dxcc] = newSubdivisionTable(list)
+	}
+
+	secondaryTables = make(map[int]*subdivisionTable, len(secondarySubdivisionData))
+	for dxcc, byParent := range secondarySubdivisionData {
+		parents := make([]string, 0, len(byParent))
+		for p := range byParent {
+			parents = append(parents, p)
+		}
+		sort.Strings(parents)
+		var list []Subdivision
+		for _, p := range parents {
+			for _, name := range byParent[p] {
+				list = append(list, Subdivision{DXCC: dxcc, Code: p + "," + name, Name: name, Parent: p})
+			}
+		}
+		secondaryTables[dxcc] = newSubdivisionTable(list)
+	}
+}
+
+func newSubdivisionTable(list []Subdivision) *subdivisionTable {
+	t := &subdivisionTable{list: list, byCode: make(map[string]int, len(list)), byName: make(map[string]int, len(list))}
+	for i, s := range list {
+		t.byCode[strings.ToUpper(s.Code)] = i
+		name := normalizeEntityName(s.Name)
+		if s.Parent != emptyString {
+			name = strings.ToLower(s.Parent) + "," + name
+		}
+		t.byName[name] = i
+	}
+	return t
+}
+
+// PrimarySubdivisions returns the primary subdivisions of a DXCC entity in table order, or nil if
+// none are held.
+func PrimarySubdivisions(dxcc int) []Subdivision {
+	t, ok := primaryTables[dxcc]
+	if !ok {
+		return nil
+	}
+	out := make([]Subdivision, len(t.list))
+	copy(out, t.list)
+	return out
+}
+
+// LookupPrimarySubdivision finds a primary subdivision by its code or, failing that, its name.
+// Matching is case-insensitive, e.g. "ny", "New York" and "new york" all give NY for DXCC 291.
+func LookupPrimarySubdivision(dxcc int, codeOrName string) (Subdivision, bool) {
+	return primaryTables[dxcc].lookup(codeOrName)
+}
+
+// ValidatePrimarySubdivision checks an ADIF STATE value against the entity's table. It returns
+// ErrSubdivisionNoTable for an entity without one, so callers can decide whether to accept the
+// value unchecked.
+func ValidatePrimarySubdivision(dxcc int, code string) error {
+	t, ok := primaryTables[dxcc]
+	if !ok {
+		return ErrSubdivisionNoTable
+	}
+	if _, ok := t.byCode[strings.ToUpper(strings.TrimSpace(code))]; !ok {
+		return ErrSubdivisionUnknown
+	}
+	return nil
+}
+
+// SuggestPrimarySubdivisions returns up to limit primary subdivisions whose code or name is close
+// to input, best match first, for "did you mean" prompts.
+func SuggestPrimarySubdivisions(dxcc int, input string, limit int) []Subdivision {
+	return primaryTables[dxcc].suggest(input, emptyString, limit)
+}
+
+// SecondarySubdivisions returns the secondary subdivisions of a DXCC entity within a primary
+// subdivision (e.g. the counties of NY), or nil if none are held.
+//
+// County coverage is partial: tables are held only for Hawaii and for the US states CT, DE, MA,
+// ME, NH, NJ, NY, RI and VT. The other US states, and every other entity, have no county table, so
+// CNTY values there cannot be checked and ValidateSecondarySubdivision returns
+// ErrSubdivisionNoTable.
+func SecondarySubdivisions(dxcc int, primary string) []Subdivision {
+	t, ok := secondaryTables[dxcc]
+	if !ok {
+		return nil
+	}
+	primary = strings.ToUpper(strings.TrimSpace(primary))
+	var out []Subdivision
+	for _, s := range t.list {
+		if s.Parent == primary {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// LookupSecondarySubdivision finds a secondary subdivision by its ADIF CNTY value ("NY,Albany"),
+// matching the county name case-insensitively and ignoring punctuation ("ny,st lawrence" gives
+// "NY,St. Lawrence").
+func LookupSecondarySubdivision(dxcc int, cnty string) (Subdivision, bool) {
+	return secondaryTables[dxcc].lookup(cnty)
+}
+
+// ValidateSecondarySubdivision checks an ADIF CNTY value against the entity's table. It returns
+// ErrSubdivisionNoTable when no table is held for the entity or for the value's primary
+// subdivision, which is the case for most US states (see SecondarySubdivisions); callers should
+// then accept the value unchecked rather than reject it.
+func ValidateSecondarySubdivision(dxcc int, cnty string) error {
+	t, ok := secondaryTables[dxcc]
+	if !ok {
+		return ErrSubdivisionNoTable
+	}
+	parent, _, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(cnty)), ",")
+	if len(SecondarySubdivisions(dxcc, parent)) == 0 {
+		return ErrSubdivisionNoTable
+	}
+	if _, ok := t.byCode[strings.ToUpper(strings.TrimSpace(cnty))]; !ok {
+		return ErrSubdivisionUnknown
+	}
+	return nil
+}
+
+// SuggestSecondarySubdivisions returns up to limit secondary subdivisions close to an ADIF CNTY
+// value, best match first. When the value has a primary subdivision ("NY,Albny"), only that
+// subdivision's entries are considered.
+func SuggestSecondarySubdivisions(dxcc int, cnty string, limit int) []Subdivision {
+	parent, name, found := strings.Cut(strings.TrimSpace(cnty), ",")
+	if !found {
+		return secondaryTables[dxcc].suggest(parent, emptyString, limit)
+	}
+	return secondaryTables[dxcc].suggest(name, strings.ToUpper(strings.TrimSpace(parent)), limit)
+}
+
+func (t *subdivisionTable) lookup(s string) (Subdivision, bool) {
+	if t == nil {
+		return Subdivision{}, false
+	}
+	s = strings.TrimSpace(s)
+	if i, ok := t.byCode[strings.ToUpper(s)]; ok {
+		return t.list[i], true
+	}
+	key := normalizeEntityName(s)
+	if parent, name, found := strings.Cut(s, ","); found {
+		key = strings.ToLower(strings.TrimSpace(parent)) + "," + normalizeEntityName(name)
+	}
+	if i, ok := t.byName[key]; ok {
+		return t.list[i], true
+	}
+	return Subdivision{}, false
+}
+
+// suggest ranks entries by edit distance between input and their code or name, keeping those within
+// a third of the input's length. A name that starts with the input ranks as an exact match.
+func (t *subdivisionTable) suggest(input, parent string, limit int) []Subdivision {
+	if t == nil || limit <= 0 {
+		return nil
+	}
+	in := normalizeEntityName(input)
+	if in == emptyString {
+		return nil
+	}
+	maxDist := max(1, len(in)/3)
+
+	type scored struct {
+		s    Subdivision
+		dist int
+	}
+	var matches []scored
+	for _, s := range t.list {
+		if parent != emptyString && s.Parent != parent {
+			continue
+		}
+		name := normalizeEntityName(s.Name)
+		d := levenshtein(in, name)
+		if strings.HasPrefix(name, in) {
+			d = 0
+		}
+		if s.Parent == emptyString {
+			d = min(d, levenshtein(in, strings.ToLower(s.Code)))
+		}
+		if d <= maxDist {
+			matches = append(matches, scored{s, d})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].dist < matches[j].dist })
+
+	out := make([]Subdivision, 0, min(limit, len(matches)))
+	for _, m := range matches[:min(limit, len(matches))] {
+		out = append(out, m.s)
+	}
+	return out
+}
+
+// levenshtein returns the edit distance between a and b, counting insertions, deletions and
+// substitutions of bytes.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
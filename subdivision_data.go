@@ -0,0 +1,136 @@
+package utils
+
+// primarySubdivisionData holds ADIF Primary Administrative Subdivision codes and names keyed by
+// DXCC entity code.
+var primarySubdivisionData = map[int][][2]string{
+	// Canada
+	1: {
+		{"NS", "Nova Scotia"}, {"QC", "Quebec"}, {"ON", "Ontario"}, {"MB", "Manitoba"},
+		{"SK", "Saskatchewan"}, {"AB", "Alberta"}, {"BC", "British Columbia"},
+		{"NT", "Northwest Territories"}, {"NB", "New Brunswick"}, {"NL", "Newfoundland and Labrador"},
+		{"YT", "Yukon"}, {"PE", "Prince Edward Island"}, {"NU", "Nunavut"},
+	},
+	// Alaska
+	6: {{"AK", "Alaska"}},
+	// Asiatic Russia
+	15: {
+		{"SV", "Sverdlovskaya oblast"}, {"CB", "Chelyabinskaya oblast"}, {"KN", "Kurganskaya oblast"},
+		{"TN", "Tyumenskaya oblast"}, {"HM", "Khanty-Mansyisky AO"},
+		{"YN", "Yamalo-Nenetsky AO"}, {"OM", "Omskaya oblast"}, {"NS", "Novosibirskaya oblast"},
+		{"TO", "Tomskaya oblast"}, {"KE", "Kemerovskaya oblast"}, {"AL", "Altaysky Kraj"},
+		{"GA", "Respublika Altay"}, {"KK", "Krasnoyarsky Kraj"}, {"TU", "Respublika Tuva"},
+		{"HK", "Respublika Khakassia"}, {"IR", "Irkutskaya oblast"}, {"BU", "Respublika Buryatia"},
+		{"CT", "Zabaykalsky Kraj"}, {"YA", "Respublika Sakha (Yakutia)"}, {"AM", "Amurskaya oblast"},
+		{"EA", "Yevreyskaya AO"}, {"HA", "Khabarovsky Kraj"}, {"PK", "Primorsky Kraj"},
+		{"SL", "Sakhalinskaya oblast"}, {"MG", "Magadanskaya oblast"}, {"KT", "Kamchatsky Kraj"},
+		{"CK", "Chukotsky AO"},
+	},
+	// European Russia
+	54: {
+		{"SP", "Sankt-Peterburg"}, {"LO", "Leningradskaya oblast"}, {"KL", "Respublika Karelia"},
+		{"AR", "Arkhangelskaya oblast"}, {"NO", "Nenetsky AO"}, {"VO", "Vologodskaya oblast"},
+		{"NV", "Novgorodskaya oblast"}, {"PS", "Pskovskaya oblast"}, {"MU", "Murmanskaya oblast"},
+		{"MA", "Moskva"}, {"MO", "Moskovskaya oblast"}, {"OR", "Orlovskaya oblast"},
+		{"LP", "Lipetskaya oblast"}, {"TV", "Tverskaya oblast"}, {"SM", "Smolenskaya oblast"},
+		{"YR", "Yaroslavskaya oblast"}, {"KS", "Kostromskaya oblast"}, {"TL", "Tulskaya oblast"},
+		{"VR", "Voronezhskaya oblast"}, {"TB", "Tambovskaya oblast"}, {"RA", "Ryazanskaya oblast"},
+		{"NN", "Nizhegorodskaya oblast"}, {"IV", "Ivanovskaya oblast"}, {"VL", "Vladimirskaya oblast"},
+		{"KU", "Kurskaya oblast"}, {"KG", "Kaluzhskaya oblast"}, {"BR", "Bryanskaya oblast"},
+		{"BO", "Belgorodskaya oblast"}, {"VG", "Volgogradskaya oblast"}, {"SA", "Saratovskaya oblast"},
+		{"PE", "Penzenskaya oblast"}, {"SR", "Samarskaya oblast"}, {"UL", "Ulyanovskaya oblast"},
+		{"KI", "Kirovskaya oblast"}, {"TA", "Respublika Tataria"}, {"MR", "Respublika Mariy-El"},
+		{"MD", "Respublika Mordovia"}, {"UD", "Udmurtskaya Respublika"}, {"CU", "Chuvashskaya Respublika"},
+		{"KR", "Krasnodarsky Kraj"}, {"KC", "Karachaevo-Cherkesskaya Respublika"},
+		{"ST", "Stavropolsky Kraj"}, {"KM", "Respublika Kalmykia"}, {"SO", "Respublika Severnaya Osetia"},
+		{"RO", "Rostovskaya oblast"}, {"CN", "Chechenskaya Respublika"}, {"IN", "Respublika Ingushetia"},
+		{"AO", "Astrakhanskaya oblast"}, {"DA", "Respublika Dagestan"},
+		{"KB", "Kabardino-Balkarskaya Respublika"}, {"AD", "Respublika Adygeya"},
+		{"KO", "Respublika Komi"}, {"PM", "Permsky Kraj"}, {"OB", "Orenburgskaya oblast"},
+		{"BA", "Respublika Bashkortostan"},
+	},
+	// Hawaii
+	110: {{"HI", "Hawaii"}},
+	// Kaliningrad
+	126: {{"KA", "Kaliningradskaya oblast"}},
+	// Australia
+	150: {
+		{"ACT", "Australian Capital Territory"}, {"NSW", "New South Wales"}, {"VIC", "Victoria"},
+		{"QLD", "Queensland"}, {"SA", "South Australia"}, {"WA", "Western Australia"},
+		{"TAS", "Tasmania"}, {"NT", "Northern Territory"},
+	},
+	// United States of America (Alaska and Hawaii are separate entities)
+	291: {
+		{"AL", "Alabama"}, {"AZ", "Arizona"}, {"AR", "Arkansas"}, {"CA", "California"},
+		{"CO", "Colorado"}, {"CT", "Connecticut"}, {"DE", "Delaware"}, {"DC", "District of Columbia"},
+		{"FL", "Florida"}, {"GA", "Georgia"}, {"ID", "Idaho"}, {"IL", "Illinois"}, {"IN", "Indiana"},
+		{"IA", "Iowa"}, {"KS", "Kansas"}, {"KY", "Kentucky"}, {"LA", "Louisiana"}, {"ME", "Maine"},
+		{"MD", "Maryland"}, {"MA", "Massachusetts"}, {"MI", "Michigan"}, {"MN", "Minnesota"},
+		{"MS", "Mississippi"}, {"MO", "Missouri"}, {"MT", "Montana"}, {"NE", "Nebraska"},
+		{"NV", "Nevada"}, {"NH", "New Hampshire"}, {"NJ", "New Jersey"}, {"NM", "New Mexico"},
+		{"NY", "New York"}, {"NC", "North Carolina"}, {"ND", "North Dakota"}, {"OH", "Ohio"},
+		{"OK", "Oklahoma"}, {"OR", "Oregon"}, {"PA", "Pennsylvania"}, {"RI", "Rhode Island"},
+		{"SC", "South Carolina"}, {"SD", "South Dakota"}, {"TN", "Tennessee"}, {"TX", "Texas"},
+		{"UT", "Utah"}, {"VT", "Vermont"}, {"VA", "Virginia"}, {"WA", "Washington"},
+		{"WV", "West Virginia"}, {"WI", "Wisconsin"}, {"WY", "Wyoming"},
+	},
+	// Japan (JARL prefecture numbers)
+	339: {
+		{"01", "Hokkaido"}, {"02", "Aomori"}, {"03", "Iwate"}, {"04", "Akita"}, {"05", "Yamagata"},
+		{"06", "Miyagi"}, {"07", "Fukushima"}, {"08", "Niigata"}, {"09", "Nagano"}, {"10", "Tokyo"},
+		{"11", "Kanagawa"}, {"12", "Chiba"}, {"13", "Saitama"}, {"14", "Ibaraki"}, {"15", "Tochigi"},
+		{"16", "Gunma"}, {"17", "Yamanashi"}, {"18", "Shizuoka"}, {"19", "Gifu"}, {"20", "Aichi"},
+		{"21", "Mie"}, {"22", "Kyoto"}, {"23", "Shiga"}, {"24", "Nara"}, {"25", "Osaka"},
+		{"26", "Wakayama"}, {"27", "Hyogo"}, {"28", "Toyama"}, {"29", "Fukui"}, {"30", "Ishikawa"},
+		{"31", "Okayama"}, {"32", "Shimane"}, {"33", "Yamaguchi"}, {"34", "Tottori"}, {"35", "Hiroshima"},
+		{"36", "Kagawa"}, {"37", "Tokushima"}, {"38", "Ehime"}, {"39", "Kochi"}, {"40", "Fukuoka"},
+		{"41", "Saga"}, {"42", "Nagasaki"}, {"43", "Kumamoto"}, {"44", "Oita"}, {"45", "Miyazaki"},
+		{"46", "Kagoshima"}, {"47", "Okinawa"},
+	},
+}
+
+// secondarySubdivisionData holds ADIF Secondary Administrative Subdivision (county) names keyed by
+// DXCC entity code and then primary subdivision code. The ADIF CNTY value is "<primary>,<name>".
+// It is a partial table, not the full ADIF list: only Hawaii and the US states below are held.
+var secondarySubdivisionData = map[int]map[string][]string{
+	// Hawaii
+	110: {
+		"HI": {"Hawaii", "Honolulu", "Kalawao", "Kauai", "Maui"},
+	},
+	// United States of America
+	291: {
+		"CT": {"Fairfield", "Hartford", "Litchfield", "Middlesex", "New Haven", "New London", "Tolland", "Windham"},
+		"DE": {"Kent", "New Castle", "Sussex"},
+		"MA": {
+			"Barnstable", "Berkshire", "Bristol", "Dukes", "Essex", "Franklin", "Hampden", "Hampshire",
+			"Middlesex", "Nantucket", "Norfolk", "Plymouth", "Suffolk", "Worcester",
+		},
+		"ME": {
+			"Androscoggin", "Aroostook", "Cumberland", "Franklin", "Hancock", "Kennebec", "Knox", "Lincoln",
+			"Oxford", "Penobscot", "Piscataquis", "Sagadahoc", "Somerset", "Waldo", "Washington", "York",
+		},
+		"NH": {
+			"Belknap", "Carroll", "Cheshire", "Coos", "Grafton", "Hillsborough", "Merrimack", "Rockingham",
+			"Strafford", "Sullivan",
+		},
+		"NJ": {
+			"Atlantic", "Bergen", "Burlington", "Camden", "Cape May", "Cumberland", "Essex", "Gloucester",
+			"Hudson", "Hunterdon", "Mercer", "Middlesex", "Monmouth", "Morris", "Ocean", "Passaic", "Salem",
+			"Somerset", "Sussex", "Union", "Warren",
+		},
+		"NY": {
+			"Albany", "Allegany", "Bronx", "Broome", "Cattaraugus", "Cayuga", "Chautauqua", "Chemung",
+			"Chenango", "Clinton", "Columbia", "Cortland", "Delaware", "Dutchess", "Erie", "Essex", "Franklin",
+			"Fulton", "Genesee", "Greene", "Hamilton", "Herkimer", "Jefferson", "Kings", "Lewis", "Livingston",
+			"Madison", "Monroe", "Montgomery", "Nassau", "New York", "Niagara", "Oneida", "Onondaga", "Ontario",
+			"Orange", "Orleans", "Oswego", "Otsego", "Putnam", "Queens", "Rensselaer", "Richmond", "Rockland",
+			"St. Lawrence", "Saratoga", "Schenectady", "Schoharie", "Schuyler", "Seneca", "Steuben", "Suffolk",
+			"Sullivan", "Tioga", "Tompkins", "Ulster", "Warren", "Washington", "Wayne", "Westchester",
+			"Wyoming", "Yates",
+		},
+		"RI": {"Bristol", "Kent", "Newport", "Providence", "Washington"},
+		"VT": {
+			"Addison", "Bennington", "Caledonia", "Chittenden", "Essex", "Franklin", "Grand Isle", "Lamoille",
+			"Orange", "Orleans", "Rutland", "Washington", "Windham", "Windsor",
+		},
+	},
+}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPrimarySubdivisions(t *testing.T) {
+	if got := len(PrimarySubdivisions(291)); got != 49 {
+		t.Fatalf("expected 49 primary subdivisions for 291, got %d", got)
+	}
+	if got := len(PrimarySubdivisions(339)); got != 47 {
+		t.Fatalf("expected 47 prefectures, got %d", got)
+	}
+	if PrimarySubdivisions(230) != nil {
+		t.Fatal("expected no table for 230")
+	}
+
+	cases := []struct {
+		dxcc int
+		in   string
+		code string
+	}{
+		{291, "ny", "NY"},
+		{291, "New York", "NY"},
+		{291, "district of columbia", "DC"},
+		{1, "Newfoundland & Labrador", "NL"},
+		{339, "tokyo", "10"},
+		{54, "MO", "MO"},
+		{150, "Tasmania", "TAS"},
+	}
+	for _, c := range cases {
+		s, ok := LookupPrimarySubdivision(c.dxcc, c.in)
+		if !ok || s.Code != c.code || s.DXCC != c.dxcc {
+			t.Fatalf("LookupPrimarySubdivision(%d, %q) = %+v, %v", c.dxcc, c.in, s, ok)
+		}
+	}
+	if _, ok := LookupPrimarySubdivision(291, "AK"); ok {
+		t.Fatal("AK belongs to DXCC 6, not 291")
+	}
+}
+
+func TestValidatePrimarySubdivision(t *testing.T) {
+	if err := ValidatePrimarySubdivision(291, "ct"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := ValidatePrimarySubdivision(6, "AK"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := ValidatePrimarySubdivision(291, "XX"); !errors.Is(err, ErrSubdivisionUnknown) {
+		t.Fatalf("expected ErrSubdivisionUnknown, got %v", err)
+	}
+	if err := ValidatePrimarySubdivision(291, "Connecticut"); !errors.Is(err, ErrSubdivisionUnknown) {
+		t.Fatalf("expected names to be rejected as codes, got %v", err)
+	}
+	if err := ValidatePrimarySubdivision(230, "NW"); !errors.Is(err, ErrSubdivisionNoTable) {
+		t.Fatalf("expected ErrSubdivisionNoTable, got %v", err)
+	}
+}
+
+func TestSuggestPrimarySubdivisions(t *testing.T) {
+	got := SuggestPrimarySubdivisions(291, "Conecticut", 3)
+	if len(got) == 0 || got[0].Code != "CT" {
+		t.Fatalf("unexpected suggestions %+v", got)
+	}
+	got = SuggestPrimarySubdivisions(291, "new", 4)
+	for _, s := range got {
+		if s.Name[:4] != "New " {
+			t.Fatalf("expected the New states first, got %+v", got)
+		}
+	}
+	got = SuggestPrimarySubdivisions(339, "Hokaido", 1)
+	if len(got) != 1 || got[0].Code != "01" {
+		t.Fatalf("unexpected suggestions %+v", got)
+	}
+	if got := SuggestPrimarySubdivisions(291, "zzzzzzzz", 3); len(got) != 0 {
+		t.Fatalf("expected no suggestions, got %+v", got)
+	}
+	if got := SuggestPrimarySubdivisions(230, "x", 3); got != nil {
+		t.Fatalf("expected nil, got %+v", got)
+	}
+}
+
+func TestSecondarySubdivisions(t *testing.T) {
+	if got := len(SecondarySubdivisions(291, "ny")); got != 62 {
+		t.Fatalf("expected 62 NY counties, got %d", got)
+	}
+	if got := SecondarySubdivisions(291, "TX"); got != nil {
+		t.Fatalf("expected no TX table, got %d entries", len(got))
+	}
+
+	s, ok := LookupSecondarySubdivision(291, "ny,st lawrence")
+	if !ok || s.Code != "NY,St. Lawrence" || s.Parent != "NY" || s.Name != "St. Lawrence" {
+		t.Fatalf("unexpected %+v, %v", s, ok)
+	}
+
+	if err := ValidateSecondarySubdivision(291, "MA,Barnstable"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := ValidateSecondarySubdivision(110, "HI,Maui"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := ValidateSecondarySubdivision(291, "MA,Springfield"); !errors.Is(err, ErrSubdivisionUnknown) {
+		t.Fatalf("expected ErrSubdivisionUnknown, got %v", err)
+	}
+	if err := ValidateSecondarySubdivision(291, "TX,Harris"); !errors.Is(err, ErrSubdivisionNoTable) {
+		t.Fatalf("expected ErrSubdivisionNoTable, got %v", err)
+	}
+	if err := ValidateSecondarySubdivision(339, "10,Chiyoda"); !errors.Is(err, ErrSubdivisionNoTable) {
+		t.Fatalf("expected ErrSubdivisionNoTable, got %v", err)
+	}
+
+	got := SuggestSecondarySubdivisions(291, "NY,Albny", 3)
+	if len(got) == 0 || got[0].Code != "NY,Albany" {
+		t.Fatalf("unexpected suggestions %+v", got)
+	}
+	for _, s := range SuggestSecondarySubdivisions(291, "VT,Washingtn", 5) {
+		if s.Parent != "VT" {
+			t.Fatalf("suggestion outside VT: %+v", s)
+		}
+	}
+	if got := SuggestSecondarySubdivisions(291, "Washington", 10); len(got) != 4 {
+		t.Fatalf("expected Washington in ME, NY, RI and VT, got %+v", got)
+	}
+}
+
+func TestSubdivisionData(t *testing.T) {
+	for dxcc, entries := range primarySubdivisionData {
+		e, ok := LookupDXCC(dxcc)
+		if !ok || e.Deleted {
+			t.Fatalf("subdivision table for %d is not a current entity", dxcc)
+		}
+		if len(primaryTables[dxcc].byCode) != len(entries) {
+			t.Fatalf("duplicate primary subdivision code for %d", dxcc)
+		}
+	}
+	for dxcc, byParent := range secondarySubdivisionData {
+		for parent := range byParent {
+			if err := ValidatePrimarySubdivision(dxcc, parent); err != nil {
+				t.Fatalf("county table for %d %s has no primary subdivision: %v", dxcc, parent, err)
+			}
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"albny", "albany", 1},
+	}
+	for _, c := range cases {
+		if got := levenshtein(c.a, c.b); got != c.want {
+			t.Fatalf("levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
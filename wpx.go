@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+// WPXPrefix returns the prefix of a callsign as counted by the CQ WPX contest and award rules:
+//   - the prefix is the letter/numeral combination forming the first part of the call, so W8,
+//     WB8 and N8 are all different prefixes ("A61ABC" gives "A61", "SX2004A" gives "SX2004");
+//   - a portable designator becomes the prefix ("N8BJQ/KH9" and "KH9/N8BJQ" give "KH9"), and a
+//     designator without a numeral has "0" added after its second letter ("PA/N8BJQ" gives "PA0");
+//   - a call-area digit replaces the call's own digit ("W1AW/7" gives "W7");
+//   - a call without numerals has "0" added after its first two letters ("RAEM" gives "RA0");
+//   - /P, /M, /MM, /AM, /QRP and licence class identifiers (/A, /E, /J) do not count.
+func WPXPrefix(call string) (string, error) {
+	call = strings.ToUpper(strings.TrimSpace(call))
+	parts := strings.Split(call, "/")
+	kept := parts[:0]
+	for _, p := range parts {
+		if p != "E" && p != "J" {
+			kept = append(kept, p)
+		}
+	}
+	call = strings.Join(kept, "/")
+
+	cs, err := ParseCallsign(call)
+	if err != nil {
+		if errors.Is(err, ErrCallsignInvalid) && len(kept) == 1 && len(call) >= 3 && isLettersOnly(call) && ValidITUPrefix(call) {
+			return call[:2] + "0", nil
+		}
+		return emptyString, err
+	}
+	switch {
+	case cs.Portable == emptyString:
+		return cs.Prefix, nil
+	case len(cs.Portable) == 1 && isDigit(cs.Portable[0]):
+		return cs.Prefix, nil
+	default:
+		return wpxDesignator(cs.Portable), nil
+	}
+}
+
+// wpxDesignator returns the WPX prefix of a portable designator: everything up to and including its
+// last numeral, or its first two letters followed by "0" if it has none.
+func wpxDesignator(d string) string {
+	if i := strings.LastIndexAny(d, "0123456789"); i >= 0 {
+		return d[:i+1]
+	}
+	if len(d) > 2 {
+		d = d[:2]
+	}
+	return d + "0"
+}
+
+func isLettersOnly(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isUpperLetter(s[i]) {
+			return false
+		}
+	}
+	return s != emptyString
+}
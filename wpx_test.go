@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWPXPrefix(t *testing.T) {
+	cases := []struct {
+		call string
+		want string
+	}{
+		{"N8BJQ", "N8"},
+		{"WB8ABC", "WB8"},
+		{"K8AA", "K8"},
+		{"A61ABC", "A61"},
+		{"3DA0XY", "3DA0"},
+		{"SX2004A", "SX2004"},
+		{"N8BJQ/KH9", "KH9"},
+		{"KH9/N8BJQ", "KH9"},
+		{"PA/N8BJQ", "PA0"},
+		{"F/G4ABC/P", "F0"},
+		{"VP2E/K1XX", "VP2"},
+		{"W1AW/7", "W7"},
+		{"JA1ABC/6", "JA6"},
+		{"RAEM", "RA0"},
+		{"xeftjw", "XE0"},
+		{"N8BJQ/P", "N8"},
+		{"N8BJQ/MM", "N8"},
+		{"N8BJQ/QRP", "N8"},
+		{"N8BJQ/A", "N8"},
+		{"N8BJQ/E", "N8"},
+		{"N8BJQ/J", "N8"},
+	}
+	for _, c := range cases {
+		got, err := WPXPrefix(c.call)
+		if err != nil || got != c.want {
+			t.Fatalf("WPXPrefix(%q) = %q, %v want %q", c.call, got, err, c.want)
+		}
+	}
+	for _, call := range []string{"", "AB", "Q/N8BJQ/X/Y", "QAEM"} {
+		if _, err := WPXPrefix(call); err == nil {
+			t.Fatalf("WPXPrefix(%q) expected error", call)
+		}
+	}
+	if _, err := WPXPrefix("QA1ABC"); !errors.Is(err, ErrCallsignUnallocated) {
+		t.Fatalf("expected ErrCallsignUnallocated, got %v", err)
+	}
+}
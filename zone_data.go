@@ -0,0 +1,449 @@
+package utils
+
+// Simplified CQ (WAZ) and ITU zone boundaries.
+//
+// Boundaries are coarse outlines that follow the main national and administrative borders used by
+// the zone definitions; they are intended for locating a station, not for drawing maps. Positions
+// within a few tens of kilometres of a boundary may resolve to the neighbouring zone. Polygons are
+// searched in order, so smaller or more specific areas are listed before the larger areas that
+// surround them. Vertices are {longitude, latitude}.
+
+// Outlines shared between the CQ and ITU tables.
+var (
+	outlineMexico = [][2]float64{
+		{-117.1, 32.5}, {-114.8, 32.5}, {-111, 31.33}, {-108.2, 31.33}, {-106.5, 31.8}, {-104.5, 29.6},
+		{-103, 29}, {-101.4, 29.8}, {-99.5, 27.5}, {-97.1, 25.9}, {-96, 22}, {-94, 18.5}, {-91, 19},
+		{-87, 21.6}, {-86.7, 21}, {-87.5, 18.3}, {-88.3, 18.5}, {-89.15, 17.8}, {-91.4, 17.25},
+		{-90.5, 16}, {-92.2, 15.2}, {-92.3, 14.5}, {-100, 10}, {-120, 15}, {-120, 30},
+	}
+	outlineCentralAmerica = [][2]float64{
+		{-92.3, 14.5}, {-92.2, 15.2}, {-90.5, 16}, {-91.4, 17.25}, {-89.15, 17.8}, {-88.3, 18.5},
+		{-87.5, 18.3}, {-83, 17}, {-80, 14}, {-79, 10}, {-77, 9}, {-77.9, 7.2}, {-80, 5}, {-88, 3},
+		{-112, 8}, {-100, 10},
+	}
+	outlineWestIndies = [][2]float64{
+		{-80, 25}, {-79.5, 27.5}, {-76, 30}, {-65, 30}, {-55, 20}, {-59, 12}, {-61, 11.8}, {-65, 11.8},
+		{-67, 13}, {-72, 13}, {-76, 11}, {-79, 10}, {-80, 14}, {-83, 17}, {-87.5, 18.3}, {-86.7, 21},
+		{-87, 21.6}, {-84, 22.5}, {-82, 24},
+	}
+	outlineNorthernSouthAmerica = [][2]float64{
+		{-79, 10}, {-76, 11}, {-72, 13}, {-67, 13}, {-65, 11.8}, {-61, 11.8}, {-59, 12}, {-50, 6},
+		{-51.6, 4.2}, {-60, 1.5}, {-67, 1}, {-69.9, -4.2}, {-73, -2.5}, {-75.5, -0.1}, {-78.8, 1.4},
+		{-84, 2}, {-80, 5}, {-77.9, 7.2}, {-77, 9},
+	}
+	outlineWesternSouthAmerica = [][2]float64{
+		{-78.8, 1.4}, {-75.5, -0.1}, {-73, -2.5}, {-69.9, -4.2}, {-73, -7.3}, {-70.6, -11}, {-69, -11},
+		{-65.4, -9.7}, {-60, -13.5}, {-58.2, -16.3}, {-58, -20}, {-62.6, -22.2}, {-67.7, -22.8},
+		{-69.5, -17.5}, {-70.4, -18.35}, {-76, -20}, {-93, -5}, {-93, 2}, {-84, 2},
+	}
+	outlineCentralSouthAmerica = [][2]float64{
+		{-51.6, 4.2}, {-50, 6}, {-30, 2}, {-27, -21}, {-35, -27}, {-49.7, -29.3}, {-53.8, -27.2},
+		{-54.6, -25.6}, {-58, -27.3}, {-62.6, -22.2}, {-58, -20}, {-58.2, -16.3}, {-60, -13.5},
+		{-65.4, -9.7}, {-69, -11}, {-70.6, -11}, {-73, -7.3}, {-69.9, -4.2}, {-67, 1}, {-60, 1.5},
+	}
+	outlineSouthwestSouthAmerica = [][2]float64{
+		{-70.4, -18.35}, {-69.5, -17.5}, {-67.7, -22.8}, {-66, -22}, {-66, -52}, {-68.6, -52.3},
+		{-68.6, -55}, {-67, -56}, {-75, -60}, {-115, -60}, {-115, -20}, {-76, -20},
+	}
+	outlineSoutheastSouthAmerica = [][2]float64{
+		{-49.7, -29.3}, {-53.8, -27.2}, {-54.6, -25.6}, {-58, -27.3}, {-62.6, -22.2}, {-66, -22},
+		{-66, -52}, {-68.6, -52.3}, {-68.6, -55}, {-67, -56}, {-75, -60}, {-20, -60}, {-20, -45},
+		{-35, -27},
+	}
+	outlineScandinavia = [][2]float64{
+		{4, 57}, {8, 54.8}, {12.5, 54.4}, {15, 55}, {19, 57.5}, {22, 59.7}, {27.8, 60.3}, {31.5, 62.9},
+		{30, 64}, {29.5, 66}, {30, 67}, {28.9, 69}, {30.8, 69.8}, {31, 71.5}, {10, 71.5}, {-4, 65}, {-10, 63}, {-4, 60},
+	}
+	outlineEuropeanRussia = [][2]float64{
+		{30.8, 69.8}, {31, 71.5}, {30, 72}, {60, 77}, {70, 73}, {66, 69}, {60, 68}, {59.5, 60},
+		{59, 55.5}, {61, 54}, {60, 51}, {55, 50.5}, {50.5, 51.5}, {48.5, 49.8}, {46.6, 48.5}, {47.5, 46},
+		{49, 46.3}, {48, 44.5}, {47.5, 42}, {46.5, 41.9}, {43.5, 42.9}, {40, 43.4}, {36.6, 45.3},
+		{33, 44.4}, {30.2, 45.8}, {29.7, 45.2}, {28.2, 45.5}, {26.6, 48.3}, {24.9, 47.9}, {22.1, 48.4},
+		{22.9, 49.1}, {23.2, 52.2}, {23.5, 53.9}, {26.6, 55.7}, {28.2, 56.1}, {27.4, 57.5}, {28, 59.4},
+		{27.8, 60.5}, {31.5, 62.9}, {30, 64}, {29.5, 66}, {30, 67}, {28.9, 69},
+	}
+	outlineWesternChina = [][2]float64{
+		{87.3, 49.1}, {90.7, 45.5}, {96.4, 42.7}, {98, 40}, {100, 33}, {98.5, 26}, {97.3, 28.2},
+		{92, 27.9}, {88, 27.9}, {80.5, 30.3}, {79, 32.5}, {79, 34.3}, {77.8, 35.5}, {74.9, 37.2},
+		{75, 38.4}, {73.6, 39.5}, {75, 40.5}, {80.2, 42.2}, {82.5, 45.5}, {85.5, 47},
+	}
+	outlineMongoliaTuva = [][2]float64{
+		{87.3, 49.1}, {89, 51.5}, {96, 53.7}, {98.5, 52}, {99, 50.5}, {107, 50.3}, {116, 49.9},
+		{119.9, 46.7}, {111.9, 43.6}, {105, 41.6}, {96.4, 42.7}, {90.7, 45.5},
+	}
+	outlineEasternChina = [][2]float64{
+		{111.9, 43.6}, {119.9, 46.7}, {116, 49.9}, {120, 53.3}, {121, 53.3}, {127.5, 49.8},
+		{135, 48.4}, {131.2, 43.4}, {130.6, 42.4}, {124.4, 40}, {122, 37}, {123, 31}, {122.5, 26},
+		{122.5, 21.5}, {118, 19.5}, {115, 19.5}, {110, 17}, {108, 21.5}, {106.7, 22.8}, {105.3, 23.3},
+		{102, 22.4}, {101.2, 21.2}, {99.2, 22.1}, {97.5, 24}, {98.5, 26}, {100, 33}, {98, 40},
+		{96.4, 42.7}, {105, 41.6},
+	}
+	outlineJapanKorea = [][2]float64{
+		{124.4, 40}, {130.6, 42.4}, {131, 42.5}, {138, 45}, {141.5, 45.7}, {146, 43.5}, {146, 40},
+		{142, 33}, {132, 29}, {124, 23.5}, {122.5, 26}, {123, 31}, {122, 37},
+	}
+	outlineSoutheastAsia = [][2]float64{
+		{92.3, 20.7}, {92.7, 22}, {93.3, 24}, {94.5, 26.6}, {97.3, 28.2}, {98.5, 26}, {97.5, 24},
+		{99.2, 22.1}, {101.2, 21.2}, {102, 22.4}, {105.3, 23.3}, {106.7, 22.8}, {108, 21.5}, {110, 17},
+		{115, 19.5}, {117, 9}, {110, 4}, {104, 6}, {100.1, 6.5}, {98, 7}, {94, 5.5}, {92, 6}, {91.5, 14},
+	}
+	outlinePhilippineZone = [][2]float64{
+		{117, 9}, {115, 19.5}, {118, 19.5}, {122.5, 21.5}, {124, 23.5}, {132, 29}, {150, 30},
+		{164, 25}, {164, 0}, {130, 0}, {127, 5}, {120, 5}, {117, 7},
+	}
+	outlineIndonesianZone = [][2]float64{
+		{92, 6}, {94, 5.5}, {98, 7}, {100.1, 6.5}, {104, 6}, {110, 4}, {117, 7}, {120, 5}, {127, 5},
+		{130, 0}, {145, 0}, {155, -3}, {163, -8}, {163, -12}, {150, -12}, {142, -9.5}, {141, -9.2},
+		{133, -9}, {130, -10}, {120, -11}, {106, -9}, {95, -6},
+	}
+	outlineWesternAustralia = [][2]float64{
+		{106, -9}, {120, -11}, {130, -10}, {133, -9}, {138, -10}, {138, -26}, {129, -26}, {129, -31.7},
+		{125, -40}, {90, -40}, {90, -5}, {95, -6},
+	}
+	outlineEasternAustralia = [][2]float64{
+		{138, -10}, {141, -9.2}, {142, -9.5}, {150, -12}, {163, -12}, {162, -28}, {160, -45},
+		{150, -45}, {125, -40}, {129, -31.7}, {129, -26}, {138, -26},
+	}
+	outlineSouthernAsia = [][2]float64{
+		{68.2, 23.7}, {71, 24.3}, {70.5, 27.9}, {73.5, 29.9}, {74.5, 31}, {75, 32.5}, {77.8, 35.5},
+		{79, 34.3}, {79, 32.5}, {80.5, 30.3}, {88, 27.9}, {92, 27.9}, {97.3, 28.2}, {94.5, 26.6},
+		{93.3, 24}, {92.7, 22}, {92.3, 20.7}, {91.5, 14}, {92, 6}, {82, 5}, {80, -3}, {70, -2}, {62, 10},
+		{60, 20},
+	}
+	outlineSouthwestAsia = [][2]float64{
+		{40, 43.4}, {43.5, 42.9}, {46.5, 41.9}, {47.5, 42}, {50.5, 40.5}, {53, 42}, {53.9, 37.3},
+		{61.2, 36.6}, {62.5, 35.3}, {64.8, 37.1}, {67.8, 37.2}, {70.9, 38.4}, {74.9, 37.2}, {77.8, 35.5},
+		{75, 32.5}, {74.5, 31}, {73.5, 29.9}, {70.5, 27.9}, {71, 24.3}, {68.2, 23.7}, {60, 20},
+		{60, 16}, {53, 12}, {43.5, 12.6}, {38, 20}, {35, 28}, {35, 29.5}, {36, 29.2}, {37, 31.5},
+		{39.2, 32.2}, {38.8, 33.4}, {41.2, 34.4}, {42.4, 37.1}, {44.3, 37.2}, {44.8, 39.7},
+		{42.8, 41.6}, {41.5, 41.5},
+	}
+	outlineBalkanMiddleEast = [][2]float64{
+		{21, 46.2}, {22.1, 48.4}, {24.9, 47.9}, {26.6, 48.3}, {28.2, 45.5}, {29.7, 45.2}, {32, 42},
+		{41.5, 41.5}, {42.8, 41.6}, {44.8, 39.7}, {44.3, 37.2}, {42.4, 37.1}, {41.2, 34.4},
+		{38.8, 33.4}, {39.2, 32.2}, {37, 31.5}, {36, 29.2}, {35, 29.5}, {34.9, 29.5}, {34.3, 31.3},
+		{32, 32}, {25, 34}, {23, 35}, {20, 39.6}, {20.9, 40.9}, {22.9, 41.3}, {22.4, 42.3}, {22.5, 44.5},
+	}
+	outlineWesternEurope = [][2]float64{
+		{-31, 47}, {-31, 36}, {-15, 36}, {-9.5, 36}, {-5.6, 36}, {-2, 35.9}, {1, 37}, {4, 39.5},
+		{7.5, 43.8}, {7, 45.9}, {10.5, 46.5}, {10.5, 47.5}, {13, 47.5}, {13.8, 48.8}, {12.1, 50.3},
+		{14.8, 50.9}, {14.2, 53.9}, {15, 55}, {19, 57.5}, {19, 59.5}, {21, 63}, {24.1, 65.8},
+		{23.5, 68}, {20.5, 69.1}, {25, 68.6}, {28.9, 69}, {30.8, 69.8}, {31, 71.5}, {10, 71.5},
+		{-4, 65}, {-10, 63}, {-15, 60}, {-20, 55},
+	}
+	outlineCentralEurope = [][2]float64{
+		{7.5, 43.8}, {7, 45.9}, {10.5, 46.5}, {10.5, 47.5}, {13, 47.5}, {13.8, 48.8}, {12.1, 50.3},
+		{14.8, 50.9}, {14.2, 53.9}, {15, 55}, {19, 57.5}, {19, 59.5}, {21, 63}, {24.1, 65.8},
+		{23.5, 68}, {20.5, 69.1}, {25, 68.6}, {28.9, 69}, {30, 67}, {29.5, 66}, {30, 64}, {31.5, 62.9},
+		{27.8, 60.5}, {28, 59.4}, {27.4, 57.5}, {28.2, 56.1}, {26.6, 55.7}, {23.5, 53.9}, {23.2, 52.2},
+		{22.9, 49.1}, {22.1, 48.4}, {21, 46.2}, {22.5, 44.5}, {22.4, 42.3}, {22.9, 41.3}, {20.9, 40.9},
+		{20, 39.6}, {19, 38.5}, {18.5, 35}, {15, 35}, {12, 36.5}, {8, 38}, {8, 41.5},
+	}
+	outlineNorthwestAfrica = [][2]float64{
+		{-20, 36}, {-9.5, 36}, {-5.6, 36}, {-2, 35.9}, {1, 37}, {8, 38}, {12, 36.5}, {11.5, 33.2},
+		{10, 30.2}, {9.5, 26.4}, {12, 23.5}, {4, 19.1}, {-4.8, 25}, {-8.7, 27.3}, {-13.1, 21.3},
+		{-17, 21}, {-20, 21},
+	}
+	outlineNortheastAfrica = [][2]float64{
+		{11.5, 33.2}, {12, 36.5}, {15, 35}, {18.5, 35}, {23, 35}, {25, 34}, {32, 32}, {34.3, 31.3},
+		{34.9, 29.5}, {35, 28}, {38, 20}, {38.6, 18}, {36.4, 14.3}, {34, 8.6}, {35.9, 4.6}, {34, 4.2},
+		{30.8, 3.5}, {27.4, 5}, {24, 8.7}, {22.9, 10.9}, {24, 19.5}, {16, 23.5}, {12, 23.5}, {9.5, 26.4},
+		{10, 30.2},
+	}
+	outlineWestAfrica = [][2]float64{
+		{-30, 21}, {-17, 21}, {-13.1, 21.3}, {-8.7, 27.3}, {-4.8, 25}, {4, 19.1}, {12, 23.5}, {16, 23.5},
+		{15.5, 20.9}, {13.6, 13.7}, {14, 12}, {12.5, 8.6}, {8.6, 4.6}, {4, 3}, {-30, 3},
+	}
+	outlineCentralAfrica = [][2]float64{
+		{-30, 3}, {4, 3}, {8.6, 4.6}, {12.5, 8.6}, {14, 12}, {13.6, 13.7}, {15.5, 20.9}, {16, 23.5},
+		{24, 19.5}, {22.9, 10.9}, {24, 8.7}, {27.4, 5}, {30.8, 3.5}, {31.2, 2.2}, {29.9, 0.5},
+		{29.6, -1.4}, {30.5, -1}, {30.8, -2.4}, {30.4, -3.5}, {29.5, -5}, {30.5, -8.3}, {32.9, -9.4},
+		{33, -12.5}, {33, -14}, {30.4, -15.6}, {28, -16.8}, {25.2, -17.8}, {23.4, -17.6}, {20.5, -17.8},
+		{11.8, -17.25}, {-20, -20}, {-30, -20},
+	}
+	outlineEastAfrica = [][2]float64{
+		{38.6, 18}, {43.5, 12.6}, {51.3, 12}, {52, 10}, {48, 0}, {41, -10.5}, {41, -15}, {35.5, -24},
+		{32.9, -26.8}, {31.9, -25.4}, {31.3, -22.4}, {32.5, -20}, {33, -17}, {30.4, -15.6}, {33, -14},
+		{33, -12.5}, {32.9, -9.4}, {30.5, -8.3}, {29.5, -5}, {30.4, -3.5}, {30.8, -2.4}, {30.5, -1},
+		{29.6, -1.4}, {29.9, 0.5}, {31.2, 2.2}, {30.8, 3.5}, {34, 4.2}, {35.9, 4.6}, {34, 8.6},
+		{36.4, 14.3},
+	}
+	outlineSouthernAfrica = [][2]float64{
+		{-20, -20}, {11.8, -17.25}, {20.5, -17.8}, {23.4, -17.6}, {25.2, -17.8}, {28, -16.8},
+		{30.4, -15.6}, {33, -17}, {32.5, -20}, {31.3, -22.4}, {31.9, -25.4}, {32.9, -26.8}, {40, -35},
+		{40, -60}, {-20, -60},
+	}
+	outlineMadagascarZone = [][2]float64{
+		{41, -10.5}, {48, 0}, {50, -2}, {60, 0}, {80, -3}, {90, -5}, {90, -60}, {40, -60}, {40, -35},
+		{35.5, -24}, {41, -15},
+	}
+)
+
+// cqZonePolygons holds the simplified CQ (WAZ) zone boundaries.
+var cqZonePolygons = []zonePolygon{
+	// North America
+	{1, [][2]float64{
+		{-169, 90}, {-102, 90}, {-102, 60}, {-133.5, 60}, {-130, 56}, {-130, 54.6}, {-135, 54},
+		{-150, 50}, {-180, 50}, {-180, 63}, {-169, 66},
+	}},
+	{1, box(172, 50, 180, 56)},
+	{3, [][2]float64{
+		{-135, 60}, {-120, 60}, {-120, 53.8}, {-114.05, 49}, {-116.05, 49}, {-116.05, 47.5},
+		{-114, 46}, {-111.05, 44.5}, {-111.05, 41}, {-109.05, 41}, {-109.05, 31.33}, {-111, 31.33},
+		{-114.8, 32.5}, {-117.1, 32.5}, {-135, 30},
+	}},
+	{4, [][2]float64{
+		{-120, 60}, {-102, 60}, {-94.8, 60}, {-88, 56.8}, {-82.5, 55}, {-79.5, 51.5}, {-79.5, 46.5},
+		{-74.5, 45}, {-76, 44}, {-79, 43.3}, {-79.76, 42.27}, {-80.52, 42}, {-80.52, 39.72},
+		{-79.5, 39.72}, {-80, 38}, {-82.6, 37.2}, {-81.7, 36.6}, {-84.3, 35}, {-85.6, 35}, {-85, 31},
+		{-87.6, 31}, {-87.5, 29}, {-97, 26}, {-97.1, 25.9}, {-99.5, 27.5}, {-101.4, 29.8}, {-103, 29},
+		{-104.5, 29.6}, {-106.5, 31.8}, {-108.2, 31.33}, {-109.05, 31.33}, {-109.05, 41},
+		{-111.05, 41}, {-111.05, 44.5}, {-114, 46}, {-116.05, 47.5}, {-116.05, 49}, {-114.05, 49},
+		{-120, 53.8},
+	}},
+	{2, [][2]float64{
+		{-102, 90}, {-102, 60}, {-94.8, 60}, {-88, 56.8}, {-82.5, 55}, {-79.5, 51.5}, {-79.5, 50},
+		{-66, 50}, {-60, 50.2}, {-57.1, 51.4}, {-55.5, 52}, {-50, 55}, {-60, 66}, {-68, 75}, {-70, 79},
+		{-62, 82.5}, {-60, 90},
+	}},
+	{5, [][2]float64{
+		{-79.5, 50}, {-79.5, 46.5}, {-74.5, 45}, {-76, 44}, {-79, 43.3}, {-79.76, 42.27}, {-80.52, 42},
+		{-80.52, 39.72}, {-79.5, 39.72}, {-80, 38}, {-82.6, 37.2}, {-81.7, 36.6}, {-84.3, 35},
+		{-85.6, 35}, {-85, 31}, {-87.6, 31}, {-87.5, 29}, {-82, 24}, {-80, 25}, {-79.5, 27.5}, {-76, 30},
+		{-65, 30}, {-55, 40}, {-45, 50}, {-50, 55}, {-55.5, 52}, {-57.1, 51.4}, {-60, 50.2}, {-66, 50},
+	}},
+	{6, outlineMexico},
+	{7, outlineCentralAmerica},
+	{8, outlineWestIndies},
+
+	// South America
+	{9, outlineNorthernSouthAmerica},
+	{10, outlineWesternSouthAmerica},
+	{11, outlineCentralSouthAmerica},
+	{12, outlineSouthwestSouthAmerica},
+	{13, outlineSoutheastSouthAmerica},
+
+	// Antarctica, split by meridians
+	{32, box(-180, -90, -130, -60)},
+	{12, box(-130, -90, -75, -60)},
+	{13, box(-75, -90, -20, -60)},
+	{38, box(-20, -90, 50, -60)},
+	{39, box(50, -90, 90, -60)},
+	{29, box(90, -90, 130, -60)},
+	{30, box(130, -90, 170, -60)},
+	{32, box(170, -90, 180, -60)},
+
+	// Europe
+	{14, outlineWesternEurope},
+	{15, outlineCentralEurope},
+	{16, outlineEuropeanRussia},
+	{20, outlineBalkanMiddleEast},
+
+	// Asia
+	{17, [][2]float64{
+		{60, 68}, {66, 69}, {70, 73}, {78, 75}, {78, 55}, {76.8, 54}, {81, 50.8}, {87.3, 49.1},
+		{85.5, 47}, {82.5, 45.5}, {80.2, 42.2}, {75, 40.5}, {73.6, 39.5}, {75, 38.4}, {74.9, 37.2},
+		{70.9, 38.4}, {67.8, 37.2}, {64.8, 37.1}, {62.5, 35.3}, {61.2, 36.6}, {53.9, 37.3}, {53, 42},
+		{49, 46.3}, {46.6, 48.5}, {48.5, 49.8}, {50.5, 51.5}, {55, 50.5}, {60, 51}, {61, 54}, {59, 55.5},
+		{59.5, 60},
+	}},
+	{18, [][2]float64{
+		{78, 75}, {78, 82}, {110, 82}, {110, 60}, {118, 56}, {120, 53.3}, {116, 49.9}, {107, 50.3},
+		{99, 50.5}, {98.5, 52}, {96, 53.7}, {89, 51.5}, {87.3, 49.1}, {81, 50.8}, {76.8, 54}, {78, 55},
+	}},
+	{19, [][2]float64{
+		{110, 82}, {180, 82}, {180, 62}, {170, 55}, {160, 50}, {148, 43.5}, {146, 43.5}, {141.5, 45.7},
+		{138, 45}, {131, 42.5}, {130.6, 42.4}, {131.2, 43.4}, {135, 48.4}, {127.5, 49.8}, {121, 53.3},
+		{120, 53.3}, {118, 56}, {110, 60},
+	}},
+	{19, [][2]float64{{-180, 62}, {-180, 73}, {-169, 73}, {-169, 66}, {-172, 64}}},
+	{21, outlineSouthwestAsia},
+	{22, outlineSouthernAsia},
+	{23, outlineWesternChina},
+	{23, outlineMongoliaTuva},
+	{25, outlineJapanKorea},
+	{24, outlineEasternChina},
+	{26, outlineSoutheastAsia},
+	{27, outlinePhilippineZone},
+	{28, outlineIndonesianZone},
+
+	// Oceania
+	{29, outlineWesternAustralia},
+	{30, outlineEasternAustralia},
+	{31, box(164, -10.5, 180, 30)},
+	{31, box(-180, -11, -130, 30)},
+	{32, box(162, -60, 180, -10.5)},
+	{32, [][2]float64{{-180, -11}, {-130, -11}, {-115, -20}, {-115, -60}, {-180, -60}}},
+
+	// Africa
+	{33, outlineNorthwestAfrica},
+	{34, outlineNortheastAfrica},
+	{35, outlineWestAfrica},
+	{36, outlineCentralAfrica},
+	{37, outlineEastAfrica},
+	{38, outlineSouthernAfrica},
+	{39, outlineMadagascarZone},
+
+	// North Atlantic and Arctic
+	{40, [][2]float64{
+		{-60, 90}, {180, 90}, {180, 82}, {78, 82}, {70, 80}, {60, 77}, {30, 72}, {31, 71.5}, {10, 71.5},
+		{-4, 65}, {-10, 63}, {-15, 60}, {-20, 55}, {-31, 47}, {-45, 50}, {-50, 55}, {-60, 66},
+		{-68, 75}, {-70, 79}, {-62, 82.5},
+	}},
+}
+
+// ituZonePolygons holds the simplified ITU zone boundaries. Many ITU zones are bounded by meridians
+// and parallels, so most entries are boxes; shared outlines are reused where the ITU and CQ zones
+// follow the same borders.
+var ituZonePolygons = []zonePolygon{
+	// Small islands and enclaves first
+	{36, box(-32, 36.5, -24, 40)},   // Azores
+	{36, box(-18, 32, -15.5, 33.5)}, // Madeira
+	{36, box(-19, 27, -13, 29.5)},   // Canary Islands
+	{11, box(-65.5, 31.5, -64, 33)}, // Bermuda
+	{46, box(-26, 14, -22, 18)},     // Cape Verde
+	{66, box(-16, -17, -5, -7)},     // St Helena, Ascension
+	{66, box(-13, -41, -9, -37)},    // Tristan da Cunha, Gough
+	{67, box(2, -56, 5, -53)},       // Bouvet
+	{57, box(37, -47.5, 38.5, -46.3)},
+	{73, box(-42, -60, -20, -53)}, // South Georgia, South Sandwich
+	{41, box(70, -8, 73, -4.5)},   // Chagos
+	{17, box(-25, 62, -12, 67)},   // Iceland
+	{18, box(10, 74, 35, 81)},     // Svalbard
+	{18, box(-10, 70, -7, 72)},    // Jan Mayen
+	{18, box(-8, 61, -6, 63)},     // Faroe Islands
+	{75, box(44, 79.5, 65, 82)},   // Franz Josef Land
+
+	// North America
+	{75, box(-170, 73, -10, 90)},
+	{75, box(-10, 81, 180, 90)},
+	{8, [][2]float64{
+		{-90, 25}, {-90, 48}, {-84.5, 46.5}, {-82.5, 45.3}, {-82.5, 42}, {-79, 43.3}, {-76.5, 43.8},
+		{-75, 45}, {-71.5, 45}, {-70, 46.5}, {-69, 47.4}, {-67.8, 47}, {-67.8, 45.2}, {-66.9, 44.8},
+		{-65, 40}, {-75, 25},
+	}},
+	{7, box(-110, 25, -90, 49)},
+	{6, box(-125, 30, -110, 49)},
+	{1, box(-180, 50, -141, 73)},
+	{1, box(172, 50, 180, 56)},
+	{2, box(-141, 49, -110, 73)},
+	{3, box(-110, 49, -90, 73)},
+	{4, box(-90, 42, -70, 73)},
+	{9, box(-70, 40, -50, 60)},
+	{5, box(-75, 60, -40, 73)},
+	{75, box(-40, 59, -10, 73)},
+	{10, outlineMexico},
+	{11, outlineCentralAmerica},
+	{11, outlineWestIndies},
+
+	// South America
+	{14, box(-62.6, -27.6, -54.3, -19.3)}, // Paraguay
+	{12, outlineNorthernSouthAmerica},
+	{12, outlineWesternSouthAmerica},
+	{12, box(-74, -14, -60, 5)},
+	{13, box(-60, -14, -28, 6)},
+	{15, outlineCentralSouthAmerica},
+	{14, box(-80, -40, -48, -18)},
+	{16, box(-80, -60, -20, -40)},
+	{63, box(-115, -30, -105, -20)}, // Easter Island, Sala y Gomez
+
+	// Antarctica, split by meridians
+	{72, box(-180, -90, -80, -60)},
+	{73, box(-80, -90, -45, -60)},
+	{74, box(-45, -90, -10, -60)},
+	{67, box(-10, -90, 40, -60)},
+	{69, box(40, -90, 80, -60)},
+	{70, box(80, -90, 120, -60)},
+	{71, box(120, -90, 160, -60)},
+	{72, box(160, -90, 180, -60)},
+
+	// Europe
+	{18, outlineScandinavia},
+	{27, [][2]float64{
+		{-15, 61}, {2, 61}, {4, 54}, {7.2, 53.3}, {6.8, 52}, {6, 50.8}, {6.1, 50.1}, {6.5, 49.5},
+		{8.2, 49}, {7.6, 47.6}, {6, 46.2}, {7, 45.9}, {7.5, 43.8}, {3.2, 42.4}, {-1.8, 43.4}, {-10, 44},
+		{-15, 48},
+	}},
+	{37, box(-10, 35.9, 4.5, 43.8)}, // Iberia and the Balearics
+	{28, [][2]float64{
+		{7.2, 53.3}, {8, 55}, {15, 55}, {19, 54.5}, {23.5, 53.9}, {23.2, 52.2}, {22.9, 49.1},
+		{22.1, 48.4}, {26.6, 48.3}, {28.2, 45.5}, {29.7, 45.2}, {28, 41.5}, {26.6, 41.7}, {26, 40},
+		{26.5, 39}, {27, 37.5}, {28.5, 36}, {26, 34.5}, {15, 33.5}, {11.5, 35}, {8, 38}, {8, 41.5},
+		{7.5, 43.8}, {7, 45.9}, {6, 46.2}, {7.6, 47.6}, {8.2, 49}, {6.5, 49.5}, {6.1, 50.1}, {6, 50.8},
+		{6.8, 52},
+	}},
+	{29, [][2]float64{
+		{19, 54.5}, {21, 55.3}, {21, 57.5}, {23, 59}, {27.8, 60.5}, {30, 61}, {50, 61}, {50, 40},
+		{44.8, 39.7}, {42.8, 41.6}, {41.5, 41.5}, {32, 42}, {29.7, 45.2}, {28.2, 45.5}, {26.6, 48.3},
+		{22.1, 48.4}, {22.9, 49.1}, {23.2, 52.2}, {23.5, 53.9},
+	}},
+	{19, box(30, 61, 50, 73)},
+
+	// Asia
+	{41, [][2]float64{
+		{61, 25}, {66.5, 25}, {68.2, 23.7}, {71, 24.3}, {70.5, 27.9}, {73.5, 29.9}, {74.5, 31}, {75, 32.5},
+		{77.8, 35.5}, {74.9, 37.2}, {71.5, 36.5}, {70, 34}, {69, 31}, {66.5, 29.5}, {61, 29.8},
+	}},
+	{40, [][2]float64{
+		{44, 39.5}, {48, 39.7}, {49, 37.5}, {53.9, 37.3}, {61.2, 36.6}, {62.5, 35.3}, {64.8, 37.1},
+		{67.8, 37.2}, {70.9, 38.4}, {74.9, 37.2}, {71.5, 36.5}, {70, 34}, {69, 31}, {66.5, 29.5},
+		{61, 29.8}, {61, 25}, {57, 25.5}, {56, 26.8}, {52, 27.5}, {50, 29.5}, {48.5, 30}, {46, 33},
+		{45.5, 35.5}, {44.3, 37.2},
+	}},
+	{39, outlineSouthwestAsia},
+	{39, outlineBalkanMiddleEast},
+	{41, outlineSouthernAsia},
+	{44, box(124, 33, 130.5, 43)}, // Korea
+	{45, outlineJapanKorea},
+	{33, box(119.5, 41, 130, 53.5)},
+	{44, outlineEasternChina},
+	{42, box(73, 35, 90, 49.5)},
+	{43, outlineWesternChina},
+	{33, box(110, 41, 120, 50)},
+	{32, outlineMongoliaTuva},
+	{20, box(50, 60, 70, 73)},
+	{21, box(70, 60, 90, 80)},
+	{22, box(90, 60, 110, 80)},
+	{23, box(110, 60, 130, 80)},
+	{24, box(130, 60, 150, 80)},
+	{25, box(150, 60, 170, 80)},
+	{26, box(170, 60, 180, 80)},
+	{26, box(-180, 60, -169, 73)},
+	{30, box(50, 37, 70, 60)},
+	{31, box(70, 37, 90, 60)},
+	{32, box(90, 40, 110, 60)},
+	{33, box(110, 40, 130, 60)},
+	{34, box(130, 40, 150, 60)},
+	{35, box(150, 40, 180, 60)},
+	{50, box(116, 4.5, 127, 21.5)}, // Philippines
+	{49, outlineSoutheastAsia},
+	{54, box(90, -12, 110, 8)},
+	{51, outlineIndonesianZone},
+	{64, box(130, 0, 150, 25)},
+	{90, box(150, 20, 160, 30)},
+
+	// Oceania
+	{58, box(112, -36, 129, -13)},
+	{55, [][2]float64{{129, -9}, {129, -26}, {138, -26}, {141, -29}, {154, -28.2}, {154, -9}}},
+	{59, box(129, -45, 155, -26)},
+	{60, box(155, -56, 180, -28)},
+	{60, box(-180, -45, -175, -43)}, // Chatham Islands
+	{56, box(158, -28, 180, -12)},
+	{65, box(150, -11, 180, 20)},
+	{62, box(-180, -28, -155, -5)},
+	{63, box(-155, -30, -105, -5)},
+	{61, box(-180, -5, -130, 30)},
+
+	// Africa
+	{37, outlineNorthwestAfrica},
+	{48, box(22, 3, 39, 22)},
+	{38, outlineNortheastAfrica},
+	{46, outlineWestAfrica},
+	{52, box(8, -18, 30, -0.5)},
+	{47, outlineCentralAfrica},
+	{53, [][2]float64{{29, -27}, {41, -27}, {41, -4.7}, {39.2, -4.7}, {37.6, -3}, {33.9, -1}, {29, -1}}},
+	{48, outlineEastAfrica},
+	{57, outlineSouthernAfrica},
+	{68, box(35, -60, 90, -36)},
+	{53, outlineMadagascarZone},
+}
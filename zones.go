@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrZoneNotFound = errors.New("no zone found for position")
+
+// Zones holds the CQ (WAZ) zone and ITU zone for a position.
+// Approximate is true when the position fell outside every zone polygon (typically at sea or on a
+// simplified boundary) and the nearest polygon was used instead.
+type Zones struct {
+	CQ          int
+	ITU         int
+	Approximate bool
+}
+
+// zonePolygon is one simplified zone boundary, with vertices given as {longitude, latitude}.
+// A zone may be made up of several polygons; polygons never cross the antimeridian.
+type zonePolygon struct {
+	zone   int
+	points [][2]float64
+}
+
+// CQZone returns the CQ zone (1–40) for the given position.
+func CQZone(p LatLong) (int, error) {
+	z, _, err := lookupZone(p, cqZonePolygons, nil)
+	return z, err
+}
+
+// ITUZone returns the ITU zone (1–90) for the given position.
+func ITUZone(p LatLong) (int, error) {
+	z, _, err := lookupZone(p, ituZonePolygons, nil)
+	return z, err
+}
+
+// ZonesForLatLong returns both the CQ and ITU zones for the given position.
+func ZonesForLatLong(p LatLong) (Zones, error) {
+	return ZonesForDXCC(emptyString, p)
+}
+
+// ZonesForGridSquare returns the CQ and ITU zones for the centre of a Maidenhead locator.
+func ZonesForGridSquare(grid string) (Zones, error) {
+	p, err := GridSquareToLatLong(grid)
+	if err != nil {
+		return Zones{}, err
+	}
+	return ZonesForLatLong(p)
+}
+
+// ZonesForDXCC returns the CQ and ITU zones for a station at p within the given ADIF DXCC entity
+// (as returned by DXCCFromISO2). For entities spanning several zones, such as the USA, Canada,
+// Russia or Australia, only that entity's zones are considered, so a station near a border is not
+// placed in a zone its entity does not use. An empty or unknown dxcc searches every zone.
+func ZonesForDXCC(dxcc string, p LatLong) (Zones, error) {
+	if err := p.Validate(); err != nil {
+		return Zones{}, err
+	}
+	// Only entities spanning several zones restrict the search; the polygons are simplified, so a
+	// single-zone entity is still located by position.
+	var cq, itu []int
+	if e, ok := LookupDXCCString(dxcc); ok {
+		if len(e.CQZones) > 1 {
+			cq = e.CQZones
+		}
+		if len(e.ITUZones) > 1 {
+			itu = e.ITUZones
+		}
+	}
+
+	var z Zones
+	var cqExact, ituExact bool
+	var err error
+	if z.CQ, cqExact, err = lookupZone(p, cqZonePolygons, cq); err != nil {
+		return Zones{}, err
+	}
+	if z.ITU, ituExact, err = lookupZone(p, ituZonePolygons, itu); err != nil {
+		return Zones{}, err
+	}
+	z.Approximate = !cqExact || !ituExact
+	return z, nil
+}
+
+// lookupZone returns the zone of the first polygon containing p, restricted to allowed zones when
+// given. If no polygon contains p, the zone of the nearest polygon is returned with exact == false.
+func lookupZone(p LatLong, polygons []zonePolygon, allowed []int) (zone int, exact bool, err error) {
+	if err = p.Validate(); err != nil {
+		return 0, false, err
+	}
+	isAllowed := func(z int) bool {
+		if len(allowed) == 0 {
+			return true
+		}
+		for _, a := range allowed {
+			if a == z {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, poly := range polygons {
+		if isAllowed(poly.zone) && pointInPolygon(p, poly.points) {
+			return poly.zone, true, nil
+		}
+	}
+
+	best := math.Inf(1)
+	for _, poly := range polygons {
+		if !isAllowed(poly.zone) {
+			continue
+		}
+		if d := distanceToPolygon(p, poly.points); d < best {
+			best = d
+			zone = poly.zone
+		}
+	}
+	if zone == 0 {
+		return 0, false, ErrZoneNotFound
+	}
+	return zone, false, nil
+}
+
+// pointInPolygon reports whether p lies inside the polygon using the even-odd ray casting rule.
+func pointInPolygon(p LatLong, points [][2]float64) bool {
+	inside := false
+	x, y := p.Longitude, p.Latitude
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		xi, yi := points[i][0], points[i][1]
+		xj, yj := points[j][0], points[j][1]
+		if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// distanceToPolygon returns an approximate distance, in degrees of latitude, from p to the nearest
+// edge of the polygon. Longitudes are scaled by cos(latitude) so the measure is roughly isotropic.
+func distanceToPolygon(p LatLong, points [][2]float64) float64 {
+	scale := math.Cos(p.Latitude * math.Pi / 180)
+	px, py := p.Longitude*scale, p.Latitude
+	best := math.Inf(1)
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		ax, ay := points[j][0]*scale, points[j][1]
+		bx, by := points[i][0]*scale, points[i][1]
+		dx, dy := bx-ax, by-ay
+		t := 0.0
+		if l := dx*dx + dy*dy; l > 0 {
+			t = math.Max(0, math.Min(1, ((px-ax)*dx+(py-ay)*dy)/l))
+		}
+		if d := math.Hypot(px-(ax+t*dx), py-(ay+t*dy)); d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+// box returns a rectangular polygon bounded by the given meridians and parallels.
+func box(west, south, east, north float64) [][2]float64 {
+	return [][2]float64{{west, south}, {east, south}, {east, north}, {west, north}}
+}
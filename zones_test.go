@@ -0,0 +1,86 @@
+package utils
+
+import "testing"
+
+func TestZonesForLatLong_Cities(t *testing.T) {
+	cases := []struct {
+		name string
+		p    LatLong
+		cq   int
+		itu  int
+	}{
+		{"Boston", LatLong{42.36, -71.06}, 5, 8},
+		{"Denver", LatLong{39.74, -104.99}, 4, 7},
+		{"Seattle", LatLong{47.6, -122.3}, 3, 6},
+		{"Anchorage", LatLong{61.2, -149.9}, 1, 1},
+		{"Toronto", LatLong{43.65, -79.38}, 4, 4},
+		{"Mexico City", LatLong{19.43, -99.13}, 6, 10},
+		{"London", LatLong{51.5, -0.12}, 14, 27},
+		{"Berlin", LatLong{52.5, 13.4}, 14, 28},
+		{"Rome", LatLong{41.9, 12.5}, 15, 28},
+		{"Madrid", LatLong{40.4, -3.7}, 14, 37},
+		{"Stockholm", LatLong{59.33, 18.07}, 14, 18},
+		{"Moscow", LatLong{55.75, 37.6}, 16, 29},
+		{"Novosibirsk", LatLong{55.0, 82.9}, 18, 31},
+		{"Vladivostok", LatLong{43.1, 131.9}, 19, 34},
+		{"Tokyo", LatLong{35.7, 139.7}, 25, 45},
+		{"Beijing", LatLong{39.9, 116.4}, 24, 44},
+		{"New Delhi", LatLong{28.6, 77.2}, 22, 41},
+		{"Tel Aviv", LatLong{32.08, 34.78}, 20, 39},
+		{"Sydney", LatLong{-33.87, 151.2}, 30, 59},
+		{"Perth", LatLong{-31.95, 115.86}, 29, 58},
+		{"Auckland", LatLong{-36.85, 174.76}, 32, 60},
+		{"Honolulu", LatLong{21.3, -157.8}, 31, 61},
+		{"Johannesburg", LatLong{-26.2, 28.0}, 38, 57},
+		{"Nairobi", LatLong{-1.29, 36.8}, 37, 48},
+		{"Lagos", LatLong{6.45, 3.4}, 35, 46},
+		{"Cairo", LatLong{30.04, 31.24}, 34, 38},
+		{"Rio de Janeiro", LatLong{-22.9, -43.2}, 11, 15},
+		{"Buenos Aires", LatLong{-34.6, -58.4}, 13, 14},
+		{"Reykjavik", LatLong{64.15, -21.94}, 40, 17},
+	}
+	for _, c := range cases {
+		z, err := ZonesForLatLong(c.p)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if z.CQ != c.cq || z.ITU != c.itu {
+			t.Errorf("%s: got CQ %d ITU %d; want CQ %d ITU %d", c.name, z.CQ, z.ITU, c.cq, c.itu)
+		}
+	}
+}
+
+func TestZonesForDXCC_RestrictsToEntityZones(t *testing.T) {
+	// A position just over the Mexican border is still reported in a US zone for a US station.
+	z, err := ZonesForDXCC("291", LatLong{Latitude: 31.2, Longitude: -106.4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if z.CQ != 4 || z.ITU != 7 {
+		t.Fatalf("got CQ %d ITU %d; want CQ 4 ITU 7", z.CQ, z.ITU)
+	}
+	// Asiatic Russia near Vladivostok is CQ 19 even though the polygon search is restricted.
+	z, err = ZonesForDXCC("15", LatLong{Latitude: 43.1, Longitude: 131.9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if z.CQ != 19 {
+		t.Fatalf("got CQ %d; want 19", z.CQ)
+	}
+}
+
+func TestZonesForGridSquare(t *testing.T) {
+	z, err := ZonesForGridSquare("FN42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if z.CQ != 5 || z.ITU != 8 {
+		t.Fatalf("FN42: got CQ %d ITU %d; want CQ 5 ITU 8", z.CQ, z.ITU)
+	}
+	if _, err := ZonesForGridSquare("ZZ99"); err == nil {
+		t.Fatal("expected error for invalid grid square")
+	}
+	if _, err := CQZone(LatLong{Latitude: 95}); err == nil {
+		t.Fatal("expected error for invalid latitude")
+	}
+}